@@ -0,0 +1,146 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+)
+
+// APIKeyHeader carries personal API keys, the apiKeyAuth security scheme.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyPrefix starts every API key, so leaked keys are easy to recognize.
+const APIKeyPrefix = "gp_"
+
+// API key scopes. Each scope includes the ones before it.
+const (
+	// ScopeRead allows every GET operation
+	ScopeRead = "read"
+	// ScopeResultsWrite also allows saving matches and their custom values
+	ScopeResultsWrite = "results:write"
+	// ScopeWrite allows everything but managing the account
+	ScopeWrite = "write"
+)
+
+// impliedScopes lists the scopes granted by each scope.
+var impliedScopes = map[string][]string{
+	ScopeRead:         {ScopeRead},
+	ScopeResultsWrite: {ScopeRead, ScopeResultsWrite},
+	ScopeWrite:        {ScopeRead, ScopeResultsWrite, ScopeWrite},
+}
+
+// resultsOperations are the writes allowed by ScopeResultsWrite.
+var resultsOperations = map[string]bool{
+	"PutMatchesMatchId":              true,
+	"PutMatchesBatches":              true,
+	"PutMatchesMatchIdCustomColumns": true,
+}
+
+// sessionOnlyOperations manage the account and its credentials, which no API
+// key can do whatever its scopes.
+var sessionOnlyOperations = map[string]bool{
+	"GetApiKeys":              true,
+	"PostApiKeys":             true,
+	"DeleteApiKeysKeyId":      true,
+	"GetSessions":             true,
+	"DeleteSessions":          true,
+	"DeleteSessionsSessionId": true,
+	"DeleteUsersUserId":       true,
+	"PostUsersUserIdResetCurrentUserPassword":  true,
+	"DeleteUsersUserIdSubscription":            true,
+	"PostSubscriptionsHandleSuccessUpgrade":    true,
+	"PostSubscriptionsInitUpdatePaymentMethod": true,
+	"PostSubscriptionsUpgradeUserSubscription": true,
+}
+
+// IsValidScope reports whether scope is one of the API key scopes.
+func IsValidScope(scope string) bool {
+	_, ok := impliedScopes[scope]
+	return ok
+}
+
+// HashAPIKey returns the hex SHA-256 of a key, which is what gets stored. Keys
+// are random enough that an unsalted hash cannot be reversed.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// authenticateAPIKey resolves the principal of an API key that is neither
+// revoked nor expired, and records that the key was used.
+func authenticateAPIKey(c echo.Context, queries *db.Queries, key string) (Principal, error) {
+	ctx := c.Request().Context()
+	if !strings.HasPrefix(key, APIKeyPrefix) {
+		return Principal{}, apierror.Unauthenticated("Invalid API key")
+	}
+
+	apiKey, err := queries.GetApiKeyByHash(ctx, HashAPIKey(key))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return Principal{}, apierror.Unauthenticated("Invalid, expired or revoked API key")
+	}
+	if err != nil {
+		return Principal{}, fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	// At most once a minute per key, see TouchApiKey
+	if err := queries.TouchApiKey(ctx, apiKey.ID); err != nil {
+		c.Logger().Errorf("Failed to record API key use: %v", err)
+	}
+	return Principal{
+		UserID:       apiKey.Userid,
+		StytchUserID: apiKey.Stytchid,
+		APIKeyID:     apiKey.ID,
+		Scopes:       apiKey.Scopes,
+	}, nil
+}
+
+// hasScope reports whether scopes grant required, directly or implied.
+func hasScope(scopes []string, required string) bool {
+	for _, scope := range scopes {
+		for _, granted := range impliedScopes[scope] {
+			if granted == required {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ResultsOnly reports whether the principal is an API key whose scopes stop at
+// ScopeResultsWrite. Like a scorekeeper, such a key may only enter the points
+// of matches, whatever the role of its user.
+func ResultsOnly(principal Principal) bool {
+	return principal.APIKeyID != 0 && !hasScope(principal.Scopes, ScopeWrite)
+}
+
+// authorizeScopes checks that the scopes of an API key allow an operation.
+// Sessions are not restricted.
+func authorizeScopes(principal Principal, operationID string, method string) error {
+	if principal.APIKeyID == 0 {
+		return nil
+	}
+	if sessionOnlyOperations[operationID] {
+		return apierror.New(http.StatusForbidden, apierror.CodeInsufficientScope, "API keys cannot manage the account, log in instead")
+	}
+
+	required := ScopeWrite
+	if method == http.MethodGet {
+		required = ScopeRead
+	} else if resultsOperations[operationID] {
+		required = ScopeResultsWrite
+	}
+	if hasScope(principal.Scopes, required) {
+		return nil
+	}
+	return apierror.New(http.StatusForbidden, apierror.CodeInsufficientScope, "This API key needs the "+required+" scope").WithDetails(map[string]interface{}{
+		"requiredScope": required,
+	})
+}
@@ -2,39 +2,171 @@ package api
 
 import (
 	"context"
-	"net/http"
+	"encoding/json"
+	"strconv"
+	"strings"
 
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/labstack/echo/v4"
 	"github.com/stytchauth/stytch-go/v16/stytch/consumer/sessions"
 	"github.com/stytchauth/stytch-go/v16/stytch/consumer/stytchapi"
 )
 
-// AuthMiddleware authenticates requests using the Authorization header.
-func AuthMiddleware(stytchClient *stytchapi.API) echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			if c.Get(BearerAuthScopes) == nil {
-				// Public route, skip authentication
-				return next(c)
-			}
+// Principal is the authenticated caller of a request, through either a Stytch
+// session or a personal API key.
+type Principal struct {
+	UserID       int32
+	StytchUserID string
+	// SessionID is empty for API keys
+	SessionID string
+	// APIKeyID is zero for sessions, which are not restricted by Scopes
+	APIKeyID int32
+	Scopes   []string
+}
+
+type principalContextKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the given principal.
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+
+// PrincipalFromContext returns the principal resolved by AuthMiddleware, if any.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalContextKey{}).(Principal)
+	return principal, ok
+}
 
-			authHeader := c.Request().Header.Get("Authorization")
-			if authHeader == "" {
-				return echo.NewHTTPError(http.StatusUnauthorized, "Missing Authorization header")
+// UserIDFromContext returns the users.id of the authenticated caller.
+func UserIDFromContext(ctx context.Context) (int32, error) {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return 0, apierror.ErrUnauthenticated
+	}
+	return principal.UserID, nil
+}
+
+// AuthMiddleware authenticates requests using the X-API-Key header when set,
+// and the Authorization header otherwise.
+// It runs as a strict middleware so the per-operation security scopes set by
+// the generated wrappers are visible, and it attaches the resolved Principal
+// to the request context handed to the strict handlers. Tokens found in cache
+// skip Stytch; the others are authenticated, which extends their session.
+// API keys are checked against the scopes the operation requires.
+func AuthMiddleware(stytchClient *stytchapi.API, queries *db.Queries, cache *SessionCache) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(c echo.Context, request interface{}) (interface{}, error) {
+			if c.Get(BearerAuthScopes) == nil && c.Get(ApiKeyAuthScopes) == nil {
+				// Public route, skip authentication
+				return f(c, request)
 			}
 
-			token := authHeader[len("Bearer "):]
-			session, err := stytchClient.Sessions.Authenticate(context.Background(), &sessions.AuthenticateParams{
-				SessionToken:           token,
-				SessionDurationMinutes: 60,
-			})
+			ctx := c.Request().Context()
+			principal, err := authenticateRequest(c, stytchClient, queries, cache)
 			if err != nil {
-				return echo.NewHTTPError(http.StatusUnauthorized, "Invalid session token")
+				return nil, err
+			}
+			if err := authorizeScopes(principal, operationID, c.Request().Method); err != nil {
+				return nil, err
 			}
 
-			c.Set("stytch_session", session)
 			c.Set("stytch_client", stytchClient) // Store stytchClient in context
-			return next(c)
+			c.SetRequest(c.Request().WithContext(WithPrincipal(ctx, principal)))
+			return f(c, request)
 		}
 	}
 }
+
+// authenticateRequest resolves the principal of the API key or the session
+// token of a request
+func authenticateRequest(c echo.Context, stytchClient *stytchapi.API, queries *db.Queries, cache *SessionCache) (Principal, error) {
+	if key := c.Request().Header.Get(APIKeyHeader); key != "" {
+		return authenticateAPIKey(c, queries, key)
+	}
+
+	authHeader := c.Request().Header.Get("Authorization")
+	if authHeader == "" {
+		return Principal{}, apierror.Unauthenticated("Missing Authorization or " + APIKeyHeader + " header")
+	}
+	if !strings.HasPrefix(authHeader, "Bearer ") {
+		return Principal{}, apierror.Unauthenticated("Invalid Authorization header")
+	}
+
+	token := authHeader[len("Bearer "):]
+	if principal, ok := cache.Get(token); ok {
+		return principal, nil
+	}
+	return authenticateSession(c, stytchClient, queries, cache, token)
+}
+
+// authenticateSession authenticates a token with Stytch, caches its principal
+// and records the device the session is used from
+func authenticateSession(c echo.Context, stytchClient *stytchapi.API, queries *db.Queries, cache *SessionCache, token string) (Principal, error) {
+	ctx := c.Request().Context()
+	session, err := stytchClient.Sessions.Authenticate(ctx, &sessions.AuthenticateParams{
+		SessionToken:           token,
+		SessionDurationMinutes: SessionDurationMinutes,
+	})
+	if err != nil {
+		return Principal{}, apierror.Unauthenticated("Invalid session token")
+	}
+
+	userID, err := resolveUserID(ctx, queries, session)
+	if err != nil {
+		return Principal{}, apierror.Unauthenticated("Unknown user")
+	}
+
+	principal := Principal{
+		UserID:       userID,
+		StytchUserID: session.User.UserID,
+		SessionID:    session.Session.SessionID,
+	}
+	cache.Put(token, principal, session.Session.ExpiresAt)
+
+	// Once per cache entry at most, which keeps lastSeenAt close enough
+	if err := queries.UpsertUserSession(ctx, db.UpsertUserSessionParams{
+		Sessionid: session.Session.SessionID,
+		Userid:    userID,
+		Ipaddress: pgtype.Text{String: c.RealIP(), Valid: c.RealIP() != ""},
+		Useragent: pgtype.Text{String: c.Request().UserAgent(), Valid: c.Request().UserAgent() != ""},
+	}); err != nil {
+		c.Logger().Errorf("Failed to record session: %v", err)
+	}
+	return principal, nil
+}
+
+// resolveUserID maps a Stytch session to our users.id, preferring the userId
+// trusted metadata written at sign up and falling back to a stytchId lookup.
+func resolveUserID(ctx context.Context, queries *db.Queries, session *sessions.AuthenticateResponse) (int32, error) {
+	if userID, ok := userIDFromMetadata(session.User.TrustedMetadata); ok {
+		return userID, nil
+	}
+
+	user, err := queries.GetUserByStytchId(ctx, session.User.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return user.ID, nil
+}
+
+// userIDFromMetadata reads the "userId" key of the Stytch trusted metadata,
+// which round-trips through JSON and may therefore come back as any number type.
+func userIDFromMetadata(metadata map[string]any) (int32, bool) {
+	switch v := metadata["userId"].(type) {
+	case float64:
+		return int32(v), v > 0
+	case int:
+		return int32(v), v > 0
+	case int32:
+		return v, v > 0
+	case json.Number:
+		n, err := v.Int64()
+		return int32(n), err == nil && n > 0
+	case string:
+		n, err := strconv.ParseInt(v, 10, 32)
+		return int32(n), err == nil && n > 0
+	}
+	return 0, false
+}
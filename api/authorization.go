@@ -0,0 +1,212 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
+)
+
+// Roles on a season. The owner is seasons.userId, the others are members
+// invited by the owner. Each role can do everything the roles after it can.
+const (
+	RoleOwner       = "owner"
+	RoleAdmin       = "admin"
+	RoleScorekeeper = "scorekeeper"
+	RoleViewer      = "viewer"
+)
+
+// roleRanks orders the roles, GetSeasonRole and GetMatchRole rank them the same
+// way to return the strongest role of a user
+var roleRanks = map[string]int{
+	RoleViewer:      1,
+	RoleScorekeeper: 2,
+	RoleAdmin:       3,
+	RoleOwner:       4,
+}
+
+// ownerOperations are reserved to the owner of the season, whatever the
+// roles of the members.
+var ownerOperations = map[string]bool{
+	"DeleteSeasonsSeasonId":             true,
+	"PostSeasonsSeasonIdMembers":        true,
+	"PutSeasonsSeasonIdMembersMemberId": true,
+}
+
+// RoleAtLeast reports whether role grants everything minimum grants.
+func RoleAtLeast(role string, minimum string) bool {
+	return roleRanks[role] > 0 && roleRanks[role] >= roleRanks[minimum]
+}
+
+// requiredSeasonRole returns the least role allowed to run an operation on a
+// season or on its matches: viewers read, scorekeepers also enter results
+// (the operations of ScopeResultsWrite) and admins do the rest but what
+// ownerOperations keeps for the owner. Members can leave a season by
+// themselves, which the handler checks.
+func requiredSeasonRole(operationID string, method string) string {
+	switch {
+	case ownerOperations[operationID]:
+		return RoleOwner
+	case method == http.MethodGet, operationID == "DeleteSeasonsSeasonIdMembersMemberId":
+		return RoleViewer
+	case resultsOperations[operationID]:
+		return RoleScorekeeper
+	}
+	return RoleAdmin
+}
+
+// AuthorizationMiddleware checks that the userId and playerId path parameters
+// of secured operations refer to the principal or to resources the principal
+// owns, and that the principal's role on the season of the seasonId and
+// matchId parameters allows the operation. It must run after AuthMiddleware.
+func AuthorizationMiddleware(queries *db.Queries) StrictMiddlewareFunc {
+	return func(f StrictHandlerFunc, operationID string) StrictHandlerFunc {
+		return func(c echo.Context, request interface{}) (interface{}, error) {
+			if c.Get(BearerAuthScopes) == nil {
+				// Public route, nothing to authorize
+				return f(c, request)
+			}
+
+			ctx := c.Request().Context()
+			role := requiredSeasonRole(operationID, c.Request().Method)
+			checks := []struct {
+				param     string
+				authorize func(context.Context, *db.Queries, int32) error
+			}{
+				{"userId", func(ctx context.Context, _ *db.Queries, id int32) error { return AuthorizeUser(ctx, id) }},
+				{"seasonId", func(ctx context.Context, queries *db.Queries, id int32) error {
+					return AuthorizeSeason(ctx, queries, id, role)
+				}},
+				{"matchId", func(ctx context.Context, queries *db.Queries, id int32) error {
+					return AuthorizeMatch(ctx, queries, id, role)
+				}},
+				{"playerId", AuthorizePlayer},
+			}
+			for _, check := range checks {
+				raw := c.Param(check.param)
+				if raw == "" {
+					continue
+				}
+				id, err := strconv.ParseInt(raw, 10, 32)
+				if err != nil {
+					return nil, apierror.BadRequest("Invalid format for parameter " + check.param)
+				}
+				if err := check.authorize(ctx, queries, int32(id)); err != nil {
+					return nil, err
+				}
+			}
+
+			return f(c, request)
+		}
+	}
+}
+
+// AuthorizeUser checks that userId is the authenticated principal.
+func AuthorizeUser(ctx context.Context, userId int32) error {
+	principalID, err := UserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if principalID != userId {
+		return apierror.ErrForbidden
+	}
+	return nil
+}
+
+// SeasonRole returns the role of the authenticated principal on a season,
+// empty when the principal has no access to it.
+func SeasonRole(ctx context.Context, queries *db.Queries, seasonId int32) (string, error) {
+	principalID, err := UserIDFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	role, err := queries.GetSeasonRole(ctx, db.GetSeasonRoleParams{
+		ID:     seasonId,
+		Userid: pgtype.Int4{Int32: principalID, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", apierror.ErrNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+	return role.String, nil
+}
+
+// AuthorizeSeason checks that the authenticated principal has at least
+// minRole on the season.
+func AuthorizeSeason(ctx context.Context, queries *db.Queries, seasonId int32, minRole string) error {
+	role, err := SeasonRole(ctx, queries, seasonId)
+	if err != nil {
+		return err
+	}
+	return CheckRole(role, minRole)
+}
+
+// AuthorizeMatch checks that the authenticated principal has at least minRole
+// on the season of the match.
+func AuthorizeMatch(ctx context.Context, queries *db.Queries, matchId int32, minRole string) error {
+	principalID, err := UserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	role, err := queries.GetMatchRole(ctx, db.GetMatchRoleParams{
+		ID:     matchId,
+		Userid: pgtype.Int4{Int32: principalID, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apierror.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return CheckRole(role.String, minRole)
+}
+
+// CheckRole checks that a role returned by SeasonRole grants minRole.
+func CheckRole(role string, minRole string) error {
+	if role == "" {
+		return apierror.ErrForbidden
+	}
+	if !RoleAtLeast(role, minRole) {
+		return apierror.Forbidden("Your role on this season does not allow this").WithDetails(map[string]interface{}{
+			"role":         role,
+			"requiredRole": minRole,
+		})
+	}
+	return nil
+}
+
+// AuthorizePlayer checks that the player belongs to the authenticated principal.
+// The players are the roster of their owner, members of a season only see them
+// through the season.
+func AuthorizePlayer(ctx context.Context, queries *db.Queries, playerId int32) error {
+	return authorizeOwner(ctx, func() (pgtype.Int4, error) {
+		return queries.GetPlayerOwner(ctx, playerId)
+	})
+}
+
+func authorizeOwner(ctx context.Context, lookup func() (pgtype.Int4, error)) error {
+	principalID, err := UserIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	owner, err := lookup()
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apierror.ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	if !owner.Valid || owner.Int32 != principalID {
+		return apierror.ErrForbidden
+	}
+	return nil
+}
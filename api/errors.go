@@ -0,0 +1,78 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gameplan-backend/apierror"
+	"github.com/labstack/echo/v4"
+)
+
+// HTTPErrorHandler renders the errors returned by handlers and middlewares as
+// an ApiResult with the status of the error. Errors that are not an
+// *apierror.Error are logged and reported as an internal error, or as not
+// found for a missing row.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	apiErr := errorFromHTTP(err)
+	if apiErr.Status >= http.StatusInternalServerError {
+		c.Logger().Error(err)
+	}
+
+	isSuccess := false
+	result := ApiResult{
+		Error: &ApiError{
+			Code:    apiErr.Code,
+			Message: apiErr.Message,
+		},
+		IsSuccess: &isSuccess,
+	}
+	if apiErr.Details != nil {
+		result.Data = &apiErr.Details
+	}
+
+	if c.Request().Method == http.MethodHead {
+		err = c.NoContent(apiErr.Status)
+	} else {
+		err = c.JSON(apiErr.Status, result)
+	}
+	if err != nil {
+		c.Logger().Error(err)
+	}
+}
+
+// errorFromHTTP also maps the echo errors raised by routing, binding and the
+// OpenAPI validator to the catalog
+func errorFromHTTP(err error) *apierror.Error {
+	var apiErr *apierror.Error
+	var httpErr *echo.HTTPError
+	if errors.As(err, &apiErr) || !errors.As(err, &httpErr) {
+		return apierror.From(err)
+	}
+
+	message := http.StatusText(httpErr.Code)
+	if text, ok := httpErr.Message.(string); ok {
+		message = text
+	} else if httpErr.Message != nil {
+		message = fmt.Sprint(httpErr.Message)
+	}
+
+	switch httpErr.Code {
+	case http.StatusBadRequest:
+		return apierror.BadRequest(message)
+	case http.StatusUnauthorized:
+		return apierror.Unauthenticated(message)
+	case http.StatusForbidden:
+		return apierror.Forbidden(message)
+	case http.StatusNotFound:
+		return apierror.NotFound(message)
+	}
+	if httpErr.Code >= http.StatusInternalServerError {
+		return apierror.Internal(err)
+	}
+	return apierror.New(httpErr.Code, apierror.CodeBadRequest, message)
+}
@@ -7,7 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/oapi-codegen/runtime"
@@ -16,16 +18,47 @@ import (
 )
 
 const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for CreatePlayerCustomColumnParamsFieldType.
+// Defines values for CreateApiKeyParamsScopes.
 const (
-	Boolean CreatePlayerCustomColumnParamsFieldType = "boolean"
-	Date    CreatePlayerCustomColumnParamsFieldType = "date"
-	Number  CreatePlayerCustomColumnParamsFieldType = "number"
-	Select  CreatePlayerCustomColumnParamsFieldType = "select"
-	Text    CreatePlayerCustomColumnParamsFieldType = "text"
+	Read         CreateApiKeyParamsScopes = "read"
+	ResultsWrite CreateApiKeyParamsScopes = "results:write"
+	Write        CreateApiKeyParamsScopes = "write"
+)
+
+// Defines values for CreateBracketParamsFormat.
+const (
+	DoubleElimination CreateBracketParamsFormat = "double_elimination"
+	SingleElimination CreateBracketParamsFormat = "single_elimination"
+)
+
+// Defines values for CustomFieldType.
+const (
+	Boolean      CustomFieldType = "boolean"
+	Date         CustomFieldType = "date"
+	Decimal      CustomFieldType = "decimal"
+	Email        CustomFieldType = "email"
+	Integer      CustomFieldType = "integer"
+	MultiSelect  CustomFieldType = "multi_select"
+	Phone        CustomFieldType = "phone"
+	SingleSelect CustomFieldType = "single_select"
+	Text         CustomFieldType = "text"
+)
+
+// Defines values for InviteSeasonMemberParamsRole.
+const (
+	InviteSeasonMemberParamsRoleAdmin       InviteSeasonMemberParamsRole = "admin"
+	InviteSeasonMemberParamsRoleScorekeeper InviteSeasonMemberParamsRole = "scorekeeper"
+	InviteSeasonMemberParamsRoleViewer      InviteSeasonMemberParamsRole = "viewer"
+)
+
+// Defines values for SendMagicLinkParamsPurpose.
+const (
+	Login        SendMagicLinkParamsPurpose = "login"
+	Verification SendMagicLinkParamsPurpose = "verification"
 )
 
 // Defines values for SignUpUserParamsLang.
@@ -34,42 +67,116 @@ const (
 	Fr SignUpUserParamsLang = "fr"
 )
 
+// Defines values for UpdateSeasonMemberParamsRole.
+const (
+	UpdateSeasonMemberParamsRoleAdmin       UpdateSeasonMemberParamsRole = "admin"
+	UpdateSeasonMemberParamsRoleScorekeeper UpdateSeasonMemberParamsRole = "scorekeeper"
+	UpdateSeasonMemberParamsRoleViewer      UpdateSeasonMemberParamsRole = "viewer"
+)
+
+// Defines values for PutMatchesBatchesParamsMode.
+const (
+	Atomic     PutMatchesBatchesParamsMode = "atomic"
+	BestEffort PutMatchesBatchesParamsMode = "best_effort"
+)
+
+// Defines values for GetPlayersRatingsParamsSystem.
+const (
+	GetPlayersRatingsParamsSystemElo     GetPlayersRatingsParamsSystem = "elo"
+	GetPlayersRatingsParamsSystemGlicko2 GetPlayersRatingsParamsSystem = "glicko2"
+)
+
+// Defines values for GetSeasonsSeasonIdScoreboardParamsRating.
+const (
+	GetSeasonsSeasonIdScoreboardParamsRatingElo     GetSeasonsSeasonIdScoreboardParamsRating = "elo"
+	GetSeasonsSeasonIdScoreboardParamsRatingGlicko2 GetSeasonsSeasonIdScoreboardParamsRating = "glicko2"
+)
+
+// Defines values for GetSeasonsSeasonIdScoreboardParamsRatingScope.
+const (
+	Overall GetSeasonsSeasonIdScoreboardParamsRatingScope = "overall"
+	Season  GetSeasonsSeasonIdScoreboardParamsRatingScope = "season"
+)
+
 // AddMatchParams defines model for AddMatchParams.
 type AddMatchParams struct {
-	Group     int                `json:"group"`
-	MatchDate openapi_types.Date `json:"matchDate"`
-	PlayerId1 int                `json:"playerId1"`
-	PlayerId2 int                `json:"playerId2"`
-	SeasonId  int                `json:"seasonId"`
+	AllowFutureResult *bool              `json:"allowFutureResult,omitempty"`
+	Group             int                `json:"group"`
+	MatchDate         openapi_types.Date `json:"matchDate"`
+	PlayerId1         int                `json:"playerId1"`
+	PlayerId1Points   *int               `json:"playerId1Points,omitempty"`
+	PlayerId2         int                `json:"playerId2"`
+	PlayerId2Points   *int               `json:"playerId2Points,omitempty"`
+	SeasonId          int                `json:"seasonId"`
+}
+
+// ApiError defines model for ApiError.
+type ApiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 // ApiResult defines model for ApiResult.
 type ApiResult struct {
-	Data  *map[string]interface{} `json:"data"`
-	Error *struct {
-		Code    *string `json:"code,omitempty"`
-		Message *string `json:"message,omitempty"`
-	} `json:"error"`
-	IsSuccess *bool `json:"isSuccess,omitempty"`
+	Data      *map[string]interface{} `json:"data"`
+	Error     *ApiError               `json:"error"`
+	IsSuccess *bool                   `json:"isSuccess,omitempty"`
+}
+
+// CreateApiKeyParams defines model for CreateApiKeyParams.
+type CreateApiKeyParams struct {
+	ExpiresAt *time.Time                 `json:"expiresAt,omitempty"`
+	Name      string                     `json:"name"`
+	Scopes    []CreateApiKeyParamsScopes `json:"scopes"`
+}
+
+// CreateApiKeyParamsScopes defines model for CreateApiKeyParams.Scopes.
+type CreateApiKeyParamsScopes string
+
+// CreateBracketParams defines model for CreateBracketParams.
+type CreateBracketParams struct {
+	DryRun          bool                      `json:"dryRun"`
+	Format          CreateBracketParamsFormat `json:"format"`
+	Players         *[]int                    `json:"players"`
+	ReplaceExisting *bool                     `json:"replaceExisting,omitempty"`
+	SeedingSeasonId *int                      `json:"seedingSeasonId"`
+	StartDate       *openapi_types.Date       `json:"startDate"`
+}
+
+// CreateBracketParamsFormat defines model for CreateBracketParams.Format.
+type CreateBracketParamsFormat string
+
+// CreateMatchCustomColumnParams defines model for CreateMatchCustomColumnParams.
+type CreateMatchCustomColumnParams struct {
+	Description  *string         `json:"description"`
+	DisplayOrder *int            `json:"displayOrder"`
+	FieldType    CustomFieldType `json:"fieldType"`
+	IsRequired   *bool           `json:"isRequired"`
+	Name         string          `json:"name"`
+	Options      *[]string       `json:"options"`
 }
 
 // CreatePlayerCustomColumnParams defines model for CreatePlayerCustomColumnParams.
 type CreatePlayerCustomColumnParams struct {
-	Description  *string                                 `json:"description"`
-	DisplayOrder *int                                    `json:"displayOrder"`
-	FieldType    CreatePlayerCustomColumnParamsFieldType `json:"fieldType"`
-	IsRequired   *bool                                   `json:"isRequired"`
-	Name         string                                  `json:"name"`
+	Description  *string         `json:"description"`
+	DisplayOrder *int            `json:"displayOrder"`
+	FieldType    CustomFieldType `json:"fieldType"`
+	IsRequired   *bool           `json:"isRequired"`
+	Name         string          `json:"name"`
+	Options      *[]string       `json:"options"`
 }
 
-// CreatePlayerCustomColumnParamsFieldType defines model for CreatePlayerCustomColumnParams.FieldType.
-type CreatePlayerCustomColumnParamsFieldType string
-
 // CreatePoolPlayerParams defines model for CreatePoolPlayerParams.
 type CreatePoolPlayerParams struct {
-	Email                     *string `json:"email"`
-	EmailNotificationsEnabled bool    `json:"emailNotificationsEnabled"`
-	Name                      string  `json:"name"`
+	CustomValues              *CustomValues `json:"customValues,omitempty"`
+	Email                     *string       `json:"email"`
+	EmailNotificationsEnabled bool          `json:"emailNotificationsEnabled"`
+	Name                      string        `json:"name"`
+}
+
+// CreatePublicScheduleLinkParams defines model for CreatePublicScheduleLinkParams.
+type CreatePublicScheduleLinkParams struct {
+	ExpiresAt *time.Time `json:"expiresAt"`
 }
 
 // CreateSeasonParams defines model for CreateSeasonParams.
@@ -81,6 +188,12 @@ type CreateSeasonParams struct {
 	StartDate      openapi_types.Date `json:"startDate"`
 }
 
+// CustomFieldType defines model for CustomFieldType.
+type CustomFieldType string
+
+// CustomValues defines model for CustomValues.
+type CustomValues map[string]interface{}
+
 // DbMatch defines model for DbMatch.
 type DbMatch struct {
 	Group           *int                `json:"group,omitempty"`
@@ -93,22 +206,52 @@ type DbMatch struct {
 	SeasonId        *int                `json:"seasonId,omitempty"`
 }
 
+// GenerateScheduleParams defines model for GenerateScheduleParams.
+type GenerateScheduleParams struct {
+	DoubleRoundRobin bool                `json:"doubleRoundRobin"`
+	DryRun           bool                `json:"dryRun"`
+	GroupCount       *int                `json:"groupCount"`
+	Players          *[]int              `json:"players"`
+	ReplaceExisting  *bool               `json:"replaceExisting,omitempty"`
+	StartDate        *openapi_types.Date `json:"startDate"`
+}
+
 // GetSeasonDetailsParams defines model for GetSeasonDetailsParams.
 type GetSeasonDetailsParams struct {
 	SeasonId int `json:"seasonId"`
 }
 
+// HandleSuccessUpgradeParams defines model for HandleSuccessUpgradeParams.
+type HandleSuccessUpgradeParams struct {
+	// SessionId The Checkout Session ID Stripe appends to the success URL
+	SessionId string `json:"sessionId"`
+}
+
+// InviteSeasonMemberParams defines model for InviteSeasonMemberParams.
+type InviteSeasonMemberParams struct {
+	Email string                       `json:"email"`
+	Role  InviteSeasonMemberParamsRole `json:"role"`
+}
+
+// InviteSeasonMemberParamsRole defines model for InviteSeasonMemberParams.Role.
+type InviteSeasonMemberParamsRole string
+
 // LoginUserParams defines model for LoginUserParams.
 type LoginUserParams struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
+// ReorderCustomColumnsParams defines model for ReorderCustomColumnsParams.
+type ReorderCustomColumnsParams struct {
+	ColumnIds []int `json:"columnIds"`
+}
+
 // ResetCurrentUserPasswordParams defines model for ResetCurrentUserPasswordParams.
 type ResetCurrentUserPasswordParams struct {
+	CurrentPassword    string `json:"currentPassword"`
 	NewPassword        string `json:"newPassword"`
 	NewPasswordConfirm string `json:"newPasswordConfirm"`
-	Token              string `json:"token"`
 }
 
 // SaveAppSettingsParams defines model for SaveAppSettingsParams.
@@ -116,24 +259,32 @@ type SaveAppSettingsParams struct {
 	Settings map[string]interface{} `json:"settings"`
 }
 
+// SaveMatchCustomValueParams defines model for SaveMatchCustomValueParams.
+type SaveMatchCustomValueParams struct {
+	ColumnId int          `json:"columnId"`
+	Value    *interface{} `json:"value"`
+}
+
 // SaveMatchDataParams defines model for SaveMatchDataParams.
 type SaveMatchDataParams struct {
-	Key   string                 `json:"key"`
-	Value map[string]interface{} `json:"value"`
+	AllowFutureResult *bool       `json:"allowFutureResult,omitempty"`
+	Key               string      `json:"key"`
+	Value             interface{} `json:"value"`
 }
 
 // SavePlayerCustomValueParams defines model for SavePlayerCustomValueParams.
 type SavePlayerCustomValueParams struct {
-	ColumnId int    `json:"columnId"`
-	PlayerId int    `json:"playerId"`
-	Value    string `json:"value"`
+	ColumnId int          `json:"columnId"`
+	PlayerId int          `json:"playerId"`
+	Value    *interface{} `json:"value"`
 }
 
 // SavePlayerDataParams defines model for SavePlayerDataParams.
 type SavePlayerDataParams struct {
-	Key      string                 `json:"key"`
-	PlayerId int                    `json:"playerId"`
-	Value    map[string]interface{} `json:"value"`
+	CustomValues *CustomValues          `json:"customValues,omitempty"`
+	Key          string                 `json:"key"`
+	PlayerId     int                    `json:"playerId"`
+	Value        map[string]interface{} `json:"value"`
 }
 
 // SaveUserSettingsParams defines model for SaveUserSettingsParams.
@@ -145,6 +296,15 @@ type SaveUserSettingsParams struct {
 	Phone    *string             `json:"phone"`
 }
 
+// SendMagicLinkParams defines model for SendMagicLinkParams.
+type SendMagicLinkParams struct {
+	Email   string                      `json:"email"`
+	Purpose *SendMagicLinkParamsPurpose `json:"purpose,omitempty"`
+}
+
+// SendMagicLinkParamsPurpose defines model for SendMagicLinkParams.Purpose.
+type SendMagicLinkParamsPurpose string
+
 // SendResetPasswordLinkParams defines model for SendResetPasswordLinkParams.
 type SendResetPasswordLinkParams struct {
 	Email string `json:"email"`
@@ -179,9 +339,43 @@ type UnassignPlayerFromMatchParams struct {
 	PlayerId int `json:"playerId"`
 }
 
+// UpdateMatchCustomColumnParams defines model for UpdateMatchCustomColumnParams.
+type UpdateMatchCustomColumnParams struct {
+	Description  *string          `json:"description"`
+	DisplayOrder *int             `json:"displayOrder"`
+	FieldType    *CustomFieldType `json:"fieldType,omitempty"`
+	IsActive     *bool            `json:"isActive,omitempty"`
+	IsRequired   *bool            `json:"isRequired,omitempty"`
+	Name         *string          `json:"name,omitempty"`
+	Options      *[]string        `json:"options"`
+}
+
+// UpdatePlayerCustomColumnParams defines model for UpdatePlayerCustomColumnParams.
+type UpdatePlayerCustomColumnParams struct {
+	Description  *string          `json:"description"`
+	DisplayOrder *int             `json:"displayOrder"`
+	FieldType    *CustomFieldType `json:"fieldType,omitempty"`
+	IsActive     *bool            `json:"isActive,omitempty"`
+	IsRequired   *bool            `json:"isRequired,omitempty"`
+	Name         *string          `json:"name,omitempty"`
+	Options      *[]string        `json:"options"`
+}
+
+// UpdateSeasonMemberParams defines model for UpdateSeasonMemberParams.
+type UpdateSeasonMemberParams struct {
+	Role UpdateSeasonMemberParamsRole `json:"role"`
+}
+
+// UpdateSeasonMemberParamsRole defines model for UpdateSeasonMemberParams.Role.
+type UpdateSeasonMemberParamsRole string
+
 // UpdateSeasonParams defines model for UpdateSeasonParams.
 type UpdateSeasonParams struct {
-	Name string `json:"name"`
+	Name          string    `json:"name"`
+	PointsPerDraw *int      `json:"pointsPerDraw"`
+	PointsPerLoss *int      `json:"pointsPerLoss"`
+	PointsPerWin  *int      `json:"pointsPerWin"`
+	Tiebreakers   *[]string `json:"tiebreakers"`
 }
 
 // UpdateUserPasswordParams defines model for UpdateUserPasswordParams.
@@ -194,6 +388,15 @@ type UpdateUserPasswordParams struct {
 // PutMatchesBatchesJSONBody defines parameters for PutMatchesBatches.
 type PutMatchesBatchesJSONBody = []DbMatch
 
+// PutMatchesBatchesParams defines parameters for PutMatchesBatches.
+type PutMatchesBatchesParams struct {
+	AllowFutureResults *bool                        `form:"allowFutureResults,omitempty" json:"allowFutureResults,omitempty"`
+	Mode               *PutMatchesBatchesParamsMode `form:"mode,omitempty" json:"mode,omitempty"`
+}
+
+// PutMatchesBatchesParamsMode defines parameters for PutMatchesBatches.
+type PutMatchesBatchesParamsMode string
+
 // GetPlayersParams defines parameters for GetPlayers.
 type GetPlayersParams struct {
 	// Limit The maximum number of players to return
@@ -203,11 +406,59 @@ type GetPlayersParams struct {
 	Offset int `form:"offset" json:"offset"`
 }
 
+// GetPlayersRatingsParams defines parameters for GetPlayersRatings.
+type GetPlayersRatingsParams struct {
+	System   *GetPlayersRatingsParamsSystem `form:"system,omitempty" json:"system,omitempty"`
+	KFactor  *float32                       `form:"kFactor,omitempty" json:"kFactor,omitempty"`
+	SeasonId *int                           `form:"seasonId,omitempty" json:"seasonId,omitempty"`
+	History  *bool                          `form:"history,omitempty" json:"history,omitempty"`
+}
+
+// GetPlayersRatingsParamsSystem defines parameters for GetPlayersRatings.
+type GetPlayersRatingsParamsSystem string
+
+// GetPublicSchedulesTokenScoreboardParams defines parameters for GetPublicSchedulesTokenScoreboard.
+type GetPublicSchedulesTokenScoreboardParams struct {
+	ByGroup *bool `form:"byGroup,omitempty" json:"byGroup,omitempty"`
+}
+
+// GetSeasonsSeasonIdScoreboardParams defines parameters for GetSeasonsSeasonIdScoreboard.
+type GetSeasonsSeasonIdScoreboardParams struct {
+	Rating      *GetSeasonsSeasonIdScoreboardParamsRating      `form:"rating,omitempty" json:"rating,omitempty"`
+	RatingScope *GetSeasonsSeasonIdScoreboardParamsRatingScope `form:"ratingScope,omitempty" json:"ratingScope,omitempty"`
+	KFactor     *float32                                       `form:"kFactor,omitempty" json:"kFactor,omitempty"`
+	ByGroup     *bool                                          `form:"byGroup,omitempty" json:"byGroup,omitempty"`
+}
+
+// GetSeasonsSeasonIdScoreboardParamsRating defines parameters for GetSeasonsSeasonIdScoreboard.
+type GetSeasonsSeasonIdScoreboardParamsRating string
+
+// GetSeasonsSeasonIdScoreboardParamsRatingScope defines parameters for GetSeasonsSeasonIdScoreboard.
+type GetSeasonsSeasonIdScoreboardParamsRatingScope string
+
+// DeleteSessionsParams defines parameters for DeleteSessions.
+type DeleteSessionsParams struct {
+	All *bool `form:"all,omitempty" json:"all,omitempty"`
+}
+
 // PostUsersVerifyMagicLinkTokenJSONBody defines parameters for PostUsersVerifyMagicLinkToken.
 type PostUsersVerifyMagicLinkTokenJSONBody struct {
 	Token string `json:"token"`
 }
 
+// DeleteUsersUserIdCustomPlayerColumnsColumnIdParams defines parameters for DeleteUsersUserIdCustomPlayerColumnsColumnId.
+type DeleteUsersUserIdCustomPlayerColumnsColumnIdParams struct {
+	Confirm *bool `form:"confirm,omitempty" json:"confirm,omitempty"`
+}
+
+// PostWebhooksStripeParams defines parameters for PostWebhooksStripe.
+type PostWebhooksStripeParams struct {
+	StripeSignature string `json:"Stripe-Signature"`
+}
+
+// PostApiKeysJSONRequestBody defines body for PostApiKeys for application/json ContentType.
+type PostApiKeysJSONRequestBody = CreateApiKeyParams
+
 // PostMatchesJSONRequestBody defines body for PostMatches for application/json ContentType.
 type PostMatchesJSONRequestBody = AddMatchParams
 
@@ -220,6 +471,9 @@ type PostMatchesUnassignPlayerFromMatchJSONRequestBody = UnassignPlayerFromMatch
 // PutMatchesMatchIdJSONRequestBody defines body for PutMatchesMatchId for application/json ContentType.
 type PutMatchesMatchIdJSONRequestBody = SaveMatchDataParams
 
+// PutMatchesMatchIdCustomColumnsJSONRequestBody defines body for PutMatchesMatchIdCustomColumns for application/json ContentType.
+type PutMatchesMatchIdCustomColumnsJSONRequestBody = SaveMatchCustomValueParams
+
 // PostPlayersJSONRequestBody defines body for PostPlayers for application/json ContentType.
 type PostPlayersJSONRequestBody = CreatePoolPlayerParams
 
@@ -238,15 +492,36 @@ type GetSeasonsSeasonIdJSONRequestBody = GetSeasonDetailsParams
 // PutSeasonsSeasonIdJSONRequestBody defines body for PutSeasonsSeasonId for application/json ContentType.
 type PutSeasonsSeasonIdJSONRequestBody = UpdateSeasonParams
 
+// PostSeasonsSeasonIdBracketJSONRequestBody defines body for PostSeasonsSeasonIdBracket for application/json ContentType.
+type PostSeasonsSeasonIdBracketJSONRequestBody = CreateBracketParams
+
+// PostSeasonsSeasonIdMembersJSONRequestBody defines body for PostSeasonsSeasonIdMembers for application/json ContentType.
+type PostSeasonsSeasonIdMembersJSONRequestBody = InviteSeasonMemberParams
+
+// PutSeasonsSeasonIdMembersMemberIdJSONRequestBody defines body for PutSeasonsSeasonIdMembersMemberId for application/json ContentType.
+type PutSeasonsSeasonIdMembersMemberIdJSONRequestBody = UpdateSeasonMemberParams
+
+// PostSeasonsSeasonIdPublicScheduleLinksJSONRequestBody defines body for PostSeasonsSeasonIdPublicScheduleLinks for application/json ContentType.
+type PostSeasonsSeasonIdPublicScheduleLinksJSONRequestBody = CreatePublicScheduleLinkParams
+
+// PostSeasonsSeasonIdScheduleGenerateJSONRequestBody defines body for PostSeasonsSeasonIdScheduleGenerate for application/json ContentType.
+type PostSeasonsSeasonIdScheduleGenerateJSONRequestBody = GenerateScheduleParams
+
 // PostSessionsJSONRequestBody defines body for PostSessions for application/json ContentType.
 type PostSessionsJSONRequestBody = LoginUserParams
 
+// PostSubscriptionsHandleSuccessUpgradeJSONRequestBody defines body for PostSubscriptionsHandleSuccessUpgrade for application/json ContentType.
+type PostSubscriptionsHandleSuccessUpgradeJSONRequestBody = HandleSuccessUpgradeParams
+
 // PostSupportMessagesJSONRequestBody defines body for PostSupportMessages for application/json ContentType.
 type PostSupportMessagesJSONRequestBody = SendSupportMessageParams
 
 // PostUsersSendResetPasswordLinkJSONRequestBody defines body for PostUsersSendResetPasswordLink for application/json ContentType.
 type PostUsersSendResetPasswordLinkJSONRequestBody = SendResetPasswordLinkParams
 
+// PostUsersSendVerificationEmailJSONRequestBody defines body for PostUsersSendVerificationEmail for application/json ContentType.
+type PostUsersSendVerificationEmailJSONRequestBody = SendMagicLinkParams
+
 // PostUsersSignUpUserJSONRequestBody defines body for PostUsersSignUpUser for application/json ContentType.
 type PostUsersSignUpUserJSONRequestBody = SignUpUserParams
 
@@ -259,9 +534,21 @@ type PostUsersVerifyMagicLinkTokenJSONRequestBody PostUsersVerifyMagicLinkTokenJ
 // PostUsersUserIdAppsettingsJSONRequestBody defines body for PostUsersUserIdAppsettings for application/json ContentType.
 type PostUsersUserIdAppsettingsJSONRequestBody = SaveAppSettingsParams
 
+// PostUsersUserIdCustomMatchColumnsJSONRequestBody defines body for PostUsersUserIdCustomMatchColumns for application/json ContentType.
+type PostUsersUserIdCustomMatchColumnsJSONRequestBody = CreateMatchCustomColumnParams
+
+// PutUsersUserIdCustomMatchColumnsColumnIdJSONRequestBody defines body for PutUsersUserIdCustomMatchColumnsColumnId for application/json ContentType.
+type PutUsersUserIdCustomMatchColumnsColumnIdJSONRequestBody = UpdateMatchCustomColumnParams
+
 // PostUsersUserIdCustomPlayerColumnsJSONRequestBody defines body for PostUsersUserIdCustomPlayerColumns for application/json ContentType.
 type PostUsersUserIdCustomPlayerColumnsJSONRequestBody = CreatePlayerCustomColumnParams
 
+// PutUsersUserIdCustomPlayerColumnsJSONRequestBody defines body for PutUsersUserIdCustomPlayerColumns for application/json ContentType.
+type PutUsersUserIdCustomPlayerColumnsJSONRequestBody = ReorderCustomColumnsParams
+
+// PutUsersUserIdCustomPlayerColumnsColumnIdJSONRequestBody defines body for PutUsersUserIdCustomPlayerColumnsColumnId for application/json ContentType.
+type PutUsersUserIdCustomPlayerColumnsColumnIdJSONRequestBody = UpdatePlayerCustomColumnParams
+
 // PostUsersUserIdResetCurrentUserPasswordJSONRequestBody defines body for PostUsersUserIdResetCurrentUserPassword for application/json ContentType.
 type PostUsersUserIdResetCurrentUserPasswordJSONRequestBody = ResetCurrentUserPasswordParams
 
@@ -270,12 +557,24 @@ type PostUsersUserIdUsersettingsJSONRequestBody = SaveUserSettingsParams
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List the API keys of the current user
+	// (GET /apiKeys)
+	GetApiKeys(ctx echo.Context) error
+	// Create an API key
+	// (POST /apiKeys)
+	PostApiKeys(ctx echo.Context) error
+	// Revoke an API key
+	// (DELETE /apiKeys/{keyId})
+	DeleteApiKeysKeyId(ctx echo.Context, keyId int) error
+	// Get the iCalendar feed of a player
+	// (GET /calendars/{token})
+	GetCalendarsToken(ctx echo.Context, token string) error
 	// Add a new match
 	// (POST /matches)
 	PostMatches(ctx echo.Context) error
 	// Update multiple matches at the same time
 	// (PUT /matches/batches)
-	PutMatchesBatches(ctx echo.Context) error
+	PutMatchesBatches(ctx echo.Context, params PutMatchesBatchesParams) error
 	// Unassign a player from a match
 	// (POST /matches/unassignPlayerFromMatch)
 	PostMatchesUnassignPlayerFromMatch(ctx echo.Context) error
@@ -285,12 +584,21 @@ type ServerInterface interface {
 	// Save match data
 	// (PUT /matches/{matchId})
 	PutMatchesMatchId(ctx echo.Context, matchId int) error
+	// Get the match custom columns values of a match
+	// (GET /matches/{matchId}/customColumns)
+	GetMatchesMatchIdCustomColumns(ctx echo.Context, matchId int) error
+	// Save a match custom value
+	// (PUT /matches/{matchId}/customColumns)
+	PutMatchesMatchIdCustomColumns(ctx echo.Context, matchId int) error
 	// Get a list of players
 	// (GET /players)
 	GetPlayers(ctx echo.Context, params GetPlayersParams) error
 	// Create a pool player
 	// (POST /players)
 	PostPlayers(ctx echo.Context) error
+	// Get player ratings computed from match results
+	// (GET /players/ratings)
+	GetPlayersRatings(ctx echo.Context, params GetPlayersRatingsParams) error
 	// Delete a player
 	// (DELETE /players/{playerId})
 	DeletePlayersPlayerId(ctx echo.Context, playerId int) error
@@ -300,6 +608,12 @@ type ServerInterface interface {
 	// Save player data
 	// (PUT /players/{playerId})
 	PutPlayersPlayerId(ctx echo.Context, playerId int) error
+	// Revoke the calendar feed token of a player
+	// (DELETE /players/{playerId}/calendarFeed)
+	DeletePlayersPlayerIdCalendarFeed(ctx echo.Context, playerId int) error
+	// Create or rotate the calendar feed token of a player
+	// (POST /players/{playerId}/calendarFeed)
+	PostPlayersPlayerIdCalendarFeed(ctx echo.Context, playerId int) error
 	// Get player custom columns values for a player
 	// (GET /players/{playerId}/customColumns)
 	GetPlayersPlayerIdCustomColumns(ctx echo.Context, playerId int) error
@@ -309,6 +623,18 @@ type ServerInterface interface {
 	// Get the schedule for a player
 	// (GET /players/{playerId}/schedule)
 	GetPlayersPlayerIdSchedule(ctx echo.Context, playerId int) error
+	// Get the schedule of a season shared by a public link
+	// (GET /public/schedules/{token})
+	GetPublicSchedulesToken(ctx echo.Context, token string) error
+	// Stream live events of a season shared by a public link
+	// (GET /public/schedules/{token}/events)
+	GetPublicSchedulesTokenEvents(ctx echo.Context, token string) error
+	// Get the scoreboard of a season shared by a public link
+	// (GET /public/schedules/{token}/scoreboard)
+	GetPublicSchedulesTokenScoreboard(ctx echo.Context, token string, params GetPublicSchedulesTokenScoreboardParams) error
+	// Get the upcoming matches of a season shared by a public link
+	// (GET /public/schedules/{token}/upcoming)
+	GetPublicSchedulesTokenUpcoming(ctx echo.Context, token string) error
 	// Get all seasons (light version)
 	// (GET /seasons)
 	GetSeasons(ctx echo.Context) error
@@ -327,18 +653,57 @@ type ServerInterface interface {
 	// Update a season metadata
 	// (PUT /seasons/{seasonId})
 	PutSeasonsSeasonId(ctx echo.Context, seasonId int) error
-	// Get the public schedule link for a season
-	// (GET /seasons/{seasonId}/publicScheduleLink)
-	GetSeasonsSeasonIdPublicScheduleLink(ctx echo.Context, seasonId int) error
+	// Get the elimination bracket of a season
+	// (GET /seasons/{seasonId}/bracket)
+	GetSeasonsSeasonIdBracket(ctx echo.Context, seasonId int) error
+	// Create a single- or double-elimination bracket for a season
+	// (POST /seasons/{seasonId}/bracket)
+	PostSeasonsSeasonIdBracket(ctx echo.Context, seasonId int) error
+	// Stream live events of a season
+	// (GET /seasons/{seasonId}/events)
+	GetSeasonsSeasonIdEvents(ctx echo.Context, seasonId int) error
+	// List the owner and members of a season
+	// (GET /seasons/{seasonId}/members)
+	GetSeasonsSeasonIdMembers(ctx echo.Context, seasonId int) error
+	// Invite a co-organizer to a season
+	// (POST /seasons/{seasonId}/members)
+	PostSeasonsSeasonIdMembers(ctx echo.Context, seasonId int) error
+	// Remove a member from a season, or leave it
+	// (DELETE /seasons/{seasonId}/members/{memberId})
+	DeleteSeasonsSeasonIdMembersMemberId(ctx echo.Context, seasonId int, memberId int) error
+	// Change the role of a season member
+	// (PUT /seasons/{seasonId}/members/{memberId})
+	PutSeasonsSeasonIdMembersMemberId(ctx echo.Context, seasonId int, memberId int) error
+	// List the public schedule links of a season
+	// (GET /seasons/{seasonId}/publicScheduleLinks)
+	GetSeasonsSeasonIdPublicScheduleLinks(ctx echo.Context, seasonId int) error
+	// Create a public schedule link for a season
+	// (POST /seasons/{seasonId}/publicScheduleLinks)
+	PostSeasonsSeasonIdPublicScheduleLinks(ctx echo.Context, seasonId int) error
+	// Revoke a public schedule link
+	// (DELETE /seasons/{seasonId}/publicScheduleLinks/{linkId})
+	DeleteSeasonsSeasonIdPublicScheduleLinksLinkId(ctx echo.Context, seasonId int, linkId int) error
+	// Generate a round-robin schedule for a season
+	// (POST /seasons/{seasonId}/schedule/generate)
+	PostSeasonsSeasonIdScheduleGenerate(ctx echo.Context, seasonId int) error
 	// Get the scoreboard for a season
 	// (GET /seasons/{seasonId}/scoreboard)
-	GetSeasonsSeasonIdScoreboard(ctx echo.Context, seasonId int) error
+	GetSeasonsSeasonIdScoreboard(ctx echo.Context, seasonId int, params GetSeasonsSeasonIdScoreboardParams) error
 	// Get upcoming seasons for the user
 	// (GET /seasons/{seasonId}/upcoming)
 	GetSeasonsSeasonIdUpcoming(ctx echo.Context, seasonId int) error
+	// Log out the current session, or every session
+	// (DELETE /sessions)
+	DeleteSessions(ctx echo.Context, params DeleteSessionsParams) error
+	// List the active sessions of the current user
+	// (GET /sessions)
+	GetSessions(ctx echo.Context) error
 	// Create a new session / log in an existing user
 	// (POST /sessions)
 	PostSessions(ctx echo.Context) error
+	// Revoke a session of the current user
+	// (DELETE /sessions/{sessionId})
+	DeleteSessionsSessionId(ctx echo.Context, sessionId string) error
 	// Handle successful upgrade
 	// (POST /subscriptions/handleSuccessUpgrade)
 	PostSubscriptionsHandleSuccessUpgrade(ctx echo.Context) error
@@ -354,13 +719,13 @@ type ServerInterface interface {
 	// Send a reset password link
 	// (POST /users/sendResetPasswordLink)
 	PostUsersSendResetPasswordLink(ctx echo.Context) error
-	// Send a verification email
+	// Send a magic link to log in or verify an email
 	// (POST /users/sendVerificationEmail)
 	PostUsersSendVerificationEmail(ctx echo.Context) error
 	// Sign up a new user
 	// (POST /users/signUpUser)
 	PostUsersSignUpUser(ctx echo.Context) error
-	// Update user password
+	// Reset a password with the token of a reset link
 	// (POST /users/updateUserPassword)
 	PostUsersUpdateUserPassword(ctx echo.Context) error
 	// Verify a magic link token
@@ -375,16 +740,34 @@ type ServerInterface interface {
 	// Save app settings
 	// (POST /users/{userId}/appsettings)
 	PostUsersUserIdAppsettings(ctx echo.Context, userId int) error
+	// Get all match custom columns
+	// (GET /users/{userId}/customMatchColumns)
+	GetUsersUserIdCustomMatchColumns(ctx echo.Context, userId int) error
+	// Create a match custom column for the user
+	// (POST /users/{userId}/customMatchColumns)
+	PostUsersUserIdCustomMatchColumns(ctx echo.Context, userId int) error
+	// Delete a match custom column and its values
+	// (DELETE /users/{userId}/customMatchColumns/{columnId})
+	DeleteUsersUserIdCustomMatchColumnsColumnId(ctx echo.Context, userId int, columnId int) error
+	// Update a match custom column
+	// (PUT /users/{userId}/customMatchColumns/{columnId})
+	PutUsersUserIdCustomMatchColumnsColumnId(ctx echo.Context, userId int, columnId int) error
 	// Get all player custom columns
 	// (GET /users/{userId}/customPlayerColumns)
 	GetUsersUserIdCustomPlayerColumns(ctx echo.Context, userId int) error
 	// Create a player custom column for the user
 	// (POST /users/{userId}/customPlayerColumns)
 	PostUsersUserIdCustomPlayerColumns(ctx echo.Context, userId int) error
-	// Delete a player custom column
+	// Reorder the player custom columns of the user
+	// (PUT /users/{userId}/customPlayerColumns)
+	PutUsersUserIdCustomPlayerColumns(ctx echo.Context, userId int) error
+	// Delete a player custom column and its values
 	// (DELETE /users/{userId}/customPlayerColumns/{columnId})
-	DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx echo.Context, userId int, columnId int) error
-	// Reset the current user's password
+	DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx echo.Context, userId int, columnId int, params DeleteUsersUserIdCustomPlayerColumnsColumnIdParams) error
+	// Update a player custom column
+	// (PUT /users/{userId}/customPlayerColumns/{columnId})
+	PutUsersUserIdCustomPlayerColumnsColumnId(ctx echo.Context, userId int, columnId int) error
+	// Change the current user's password
 	// (POST /users/{userId}/resetCurrentUserPassword)
 	PostUsersUserIdResetCurrentUserPassword(ctx echo.Context, userId int) error
 	// Cancel user subscription
@@ -399,6 +782,9 @@ type ServerInterface interface {
 	// Save user settings
 	// (POST /users/{userId}/usersettings)
 	PostUsersUserIdUsersettings(ctx echo.Context, userId int) error
+	// Receive a Stripe webhook event
+	// (POST /webhooks/stripe)
+	PostWebhooksStripe(ctx echo.Context, params PostWebhooksStripeParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -406,12 +792,76 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetApiKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetApiKeys(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetApiKeys(ctx)
+	return err
+}
+
+// PostApiKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) PostApiKeys(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostApiKeys(ctx)
+	return err
+}
+
+// DeleteApiKeysKeyId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteApiKeysKeyId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "keyId" -------------
+	var keyId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", ctx.Param("keyId"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter keyId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteApiKeysKeyId(ctx, keyId)
+	return err
+}
+
+// GetCalendarsToken converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCalendarsToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", ctx.Param("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCalendarsToken(ctx, token)
+	return err
+}
+
 // PostMatches converts echo context to params.
 func (w *ServerInterfaceWrapper) PostMatches(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostMatches(ctx)
 	return err
@@ -423,8 +873,26 @@ func (w *ServerInterfaceWrapper) PutMatchesBatches(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutMatchesBatchesParams
+	// ------------- Optional query parameter "allowFutureResults" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "allowFutureResults", ctx.QueryParams(), &params.AllowFutureResults)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter allowFutureResults: %s", err))
+	}
+
+	// ------------- Optional query parameter "mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "mode", ctx.QueryParams(), &params.Mode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter mode: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutMatchesBatches(ctx)
+	err = w.Handler.PutMatchesBatches(ctx, params)
 	return err
 }
 
@@ -434,6 +902,8 @@ func (w *ServerInterfaceWrapper) PostMatchesUnassignPlayerFromMatch(ctx echo.Con
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostMatchesUnassignPlayerFromMatch(ctx)
 	return err
@@ -452,6 +922,8 @@ func (w *ServerInterfaceWrapper) DeleteMatchesMatchId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DeleteMatchesMatchId(ctx, matchId)
 	return err
@@ -470,17 +942,61 @@ func (w *ServerInterfaceWrapper) PutMatchesMatchId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PutMatchesMatchId(ctx, matchId)
 	return err
 }
 
+// GetMatchesMatchIdCustomColumns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetMatchesMatchIdCustomColumns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "matchId" -------------
+	var matchId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "matchId", ctx.Param("matchId"), &matchId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter matchId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetMatchesMatchIdCustomColumns(ctx, matchId)
+	return err
+}
+
+// PutMatchesMatchIdCustomColumns converts echo context to params.
+func (w *ServerInterfaceWrapper) PutMatchesMatchIdCustomColumns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "matchId" -------------
+	var matchId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "matchId", ctx.Param("matchId"), &matchId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter matchId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutMatchesMatchIdCustomColumns(ctx, matchId)
+	return err
+}
+
 // GetPlayers converts echo context to params.
 func (w *ServerInterfaceWrapper) GetPlayers(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
 	var params GetPlayersParams
 	// ------------- Required query parameter "limit" -------------
@@ -508,11 +1024,56 @@ func (w *ServerInterfaceWrapper) PostPlayers(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PostPlayers(ctx)
 	return err
 }
 
+// GetPlayersRatings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPlayersRatings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPlayersRatingsParams
+	// ------------- Optional query parameter "system" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "system", ctx.QueryParams(), &params.System)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter system: %s", err))
+	}
+
+	// ------------- Optional query parameter "kFactor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "kFactor", ctx.QueryParams(), &params.KFactor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kFactor: %s", err))
+	}
+
+	// ------------- Optional query parameter "seasonId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "seasonId", ctx.QueryParams(), &params.SeasonId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
+	}
+
+	// ------------- Optional query parameter "history" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "history", ctx.QueryParams(), &params.History)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter history: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPlayersRatings(ctx, params)
+	return err
+}
+
 // DeletePlayersPlayerId converts echo context to params.
 func (w *ServerInterfaceWrapper) DeletePlayersPlayerId(ctx echo.Context) error {
 	var err error
@@ -526,6 +1087,8 @@ func (w *ServerInterfaceWrapper) DeletePlayersPlayerId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DeletePlayersPlayerId(ctx, playerId)
 	return err
@@ -544,6 +1107,8 @@ func (w *ServerInterfaceWrapper) GetPlayersPlayerId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetPlayersPlayerId(ctx, playerId)
 	return err
@@ -562,13 +1127,15 @@ func (w *ServerInterfaceWrapper) PutPlayersPlayerId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PutPlayersPlayerId(ctx, playerId)
 	return err
 }
 
-// GetPlayersPlayerIdCustomColumns converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPlayersPlayerIdCustomColumns(ctx echo.Context) error {
+// DeletePlayersPlayerIdCalendarFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) DeletePlayersPlayerIdCalendarFeed(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "playerId" -------------
 	var playerId int
@@ -580,13 +1147,15 @@ func (w *ServerInterfaceWrapper) GetPlayersPlayerIdCustomColumns(ctx echo.Contex
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPlayersPlayerIdCustomColumns(ctx, playerId)
+	err = w.Handler.DeletePlayersPlayerIdCalendarFeed(ctx, playerId)
 	return err
 }
 
-// PutPlayersPlayerIdCustomColumns converts echo context to params.
-func (w *ServerInterfaceWrapper) PutPlayersPlayerIdCustomColumns(ctx echo.Context) error {
+// PostPlayersPlayerIdCalendarFeed converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPlayersPlayerIdCalendarFeed(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "playerId" -------------
 	var playerId int
@@ -598,13 +1167,15 @@ func (w *ServerInterfaceWrapper) PutPlayersPlayerIdCustomColumns(ctx echo.Contex
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutPlayersPlayerIdCustomColumns(ctx, playerId)
+	err = w.Handler.PostPlayersPlayerIdCalendarFeed(ctx, playerId)
 	return err
 }
 
-// GetPlayersPlayerIdSchedule converts echo context to params.
-func (w *ServerInterfaceWrapper) GetPlayersPlayerIdSchedule(ctx echo.Context) error {
+// GetPlayersPlayerIdCustomColumns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPlayersPlayerIdCustomColumns(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "playerId" -------------
 	var playerId int
@@ -616,39 +1187,160 @@ func (w *ServerInterfaceWrapper) GetPlayersPlayerIdSchedule(ctx echo.Context) er
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetPlayersPlayerIdSchedule(ctx, playerId)
+	err = w.Handler.GetPlayersPlayerIdCustomColumns(ctx, playerId)
 	return err
 }
 
-// GetSeasons converts echo context to params.
-func (w *ServerInterfaceWrapper) GetSeasons(ctx echo.Context) error {
+// PutPlayersPlayerIdCustomColumns converts echo context to params.
+func (w *ServerInterfaceWrapper) PutPlayersPlayerIdCustomColumns(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "playerId" -------------
+	var playerId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "playerId", ctx.Param("playerId"), &playerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter playerId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetSeasons(ctx)
+	err = w.Handler.PutPlayersPlayerIdCustomColumns(ctx, playerId)
 	return err
 }
 
-// PostSeasons converts echo context to params.
-func (w *ServerInterfaceWrapper) PostSeasons(ctx echo.Context) error {
+// GetPlayersPlayerIdSchedule converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPlayersPlayerIdSchedule(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "playerId" -------------
+	var playerId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "playerId", ctx.Param("playerId"), &playerId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter playerId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostSeasons(ctx)
+	err = w.Handler.GetPlayersPlayerIdSchedule(ctx, playerId)
 	return err
 }
 
-// GetSeasonsTotalAmount converts echo context to params.
-func (w *ServerInterfaceWrapper) GetSeasonsTotalAmount(ctx echo.Context) error {
+// GetPublicSchedulesToken converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPublicSchedulesToken(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", ctx.Param("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPublicSchedulesToken(ctx, token)
+	return err
+}
+
+// GetPublicSchedulesTokenEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPublicSchedulesTokenEvents(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", ctx.Param("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPublicSchedulesTokenEvents(ctx, token)
+	return err
+}
+
+// GetPublicSchedulesTokenScoreboard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPublicSchedulesTokenScoreboard(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", ctx.Param("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPublicSchedulesTokenScoreboardParams
+	// ------------- Optional query parameter "byGroup" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "byGroup", ctx.QueryParams(), &params.ByGroup)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter byGroup: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPublicSchedulesTokenScoreboard(ctx, token, params)
+	return err
+}
+
+// GetPublicSchedulesTokenUpcoming converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPublicSchedulesTokenUpcoming(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token", ctx.Param("token"), &token, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPublicSchedulesTokenUpcoming(ctx, token)
+	return err
+}
+
+// GetSeasons converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeasons(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetSeasons(ctx)
+	return err
+}
+
+// PostSeasons converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSeasons(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSeasons(ctx)
+	return err
+}
+
+// GetSeasonsTotalAmount converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeasonsTotalAmount(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetSeasonsTotalAmount(ctx)
 	return err
@@ -667,6 +1359,8 @@ func (w *ServerInterfaceWrapper) DeleteSeasonsSeasonId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.DeleteSeasonsSeasonId(ctx, seasonId)
 	return err
@@ -685,6 +1379,8 @@ func (w *ServerInterfaceWrapper) GetSeasonsSeasonId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.GetSeasonsSeasonId(ctx, seasonId)
 	return err
@@ -703,13 +1399,15 @@ func (w *ServerInterfaceWrapper) PutSeasonsSeasonId(ctx echo.Context) error {
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.PutSeasonsSeasonId(ctx, seasonId)
 	return err
 }
 
-// GetSeasonsSeasonIdPublicScheduleLink converts echo context to params.
-func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdPublicScheduleLink(ctx echo.Context) error {
+// GetSeasonsSeasonIdBracket converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdBracket(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "seasonId" -------------
 	var seasonId int
@@ -721,13 +1419,15 @@ func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdPublicScheduleLink(ctx echo.C
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetSeasonsSeasonIdPublicScheduleLink(ctx, seasonId)
+	err = w.Handler.GetSeasonsSeasonIdBracket(ctx, seasonId)
 	return err
 }
 
-// GetSeasonsSeasonIdScoreboard converts echo context to params.
-func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdScoreboard(ctx echo.Context) error {
+// PostSeasonsSeasonIdBracket converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSeasonsSeasonIdBracket(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "seasonId" -------------
 	var seasonId int
@@ -739,13 +1439,15 @@ func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdScoreboard(ctx echo.Context)
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetSeasonsSeasonIdScoreboard(ctx, seasonId)
+	err = w.Handler.PostSeasonsSeasonIdBracket(ctx, seasonId)
 	return err
 }
 
-// GetSeasonsSeasonIdUpcoming converts echo context to params.
-func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdUpcoming(ctx echo.Context) error {
+// GetSeasonsSeasonIdEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdEvents(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "seasonId" -------------
 	var seasonId int
@@ -757,1157 +1459,2340 @@ func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdUpcoming(ctx echo.Context) er
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetSeasonsSeasonIdUpcoming(ctx, seasonId)
-	return err
-}
-
-// PostSessions converts echo context to params.
-func (w *ServerInterfaceWrapper) PostSessions(ctx echo.Context) error {
-	var err error
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostSessions(ctx)
+	err = w.Handler.GetSeasonsSeasonIdEvents(ctx, seasonId)
 	return err
 }
 
-// PostSubscriptionsHandleSuccessUpgrade converts echo context to params.
-func (w *ServerInterfaceWrapper) PostSubscriptionsHandleSuccessUpgrade(ctx echo.Context) error {
+// GetSeasonsSeasonIdMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdMembers(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "seasonId" -------------
+	var seasonId int
 
-	ctx.Set(BearerAuthScopes, []string{})
-
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostSubscriptionsHandleSuccessUpgrade(ctx)
-	return err
-}
-
-// PostSubscriptionsInitUpdatePaymentMethod converts echo context to params.
-func (w *ServerInterfaceWrapper) PostSubscriptionsInitUpdatePaymentMethod(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithOptions("simple", "seasonId", ctx.Param("seasonId"), &seasonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostSubscriptionsInitUpdatePaymentMethod(ctx)
-	return err
-}
-
-// PostSubscriptionsUpgradeUserSubscription converts echo context to params.
-func (w *ServerInterfaceWrapper) PostSubscriptionsUpgradeUserSubscription(ctx echo.Context) error {
-	var err error
-
-	ctx.Set(BearerAuthScopes, []string{})
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostSubscriptionsUpgradeUserSubscription(ctx)
+	err = w.Handler.GetSeasonsSeasonIdMembers(ctx, seasonId)
 	return err
 }
 
-// PostSupportMessages converts echo context to params.
-func (w *ServerInterfaceWrapper) PostSupportMessages(ctx echo.Context) error {
+// PostSeasonsSeasonIdMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSeasonsSeasonIdMembers(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "seasonId" -------------
+	var seasonId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "seasonId", ctx.Param("seasonId"), &seasonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostSupportMessages(ctx)
+	err = w.Handler.PostSeasonsSeasonIdMembers(ctx, seasonId)
 	return err
 }
 
-// PostUsersSendResetPasswordLink converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersSendResetPasswordLink(ctx echo.Context) error {
+// DeleteSeasonsSeasonIdMembersMemberId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSeasonsSeasonIdMembersMemberId(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "seasonId" -------------
+	var seasonId int
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "seasonId", ctx.Param("seasonId"), &seasonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersSendResetPasswordLink(ctx)
-	return err
-}
+	// ------------- Path parameter "memberId" -------------
+	var memberId int
 
-// PostUsersSendVerificationEmail converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersSendVerificationEmail(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithOptions("simple", "memberId", ctx.Param("memberId"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter memberId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersSendVerificationEmail(ctx)
-	return err
-}
-
-// PostUsersSignUpUser converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersSignUpUser(ctx echo.Context) error {
-	var err error
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersSignUpUser(ctx)
+	err = w.Handler.DeleteSeasonsSeasonIdMembersMemberId(ctx, seasonId, memberId)
 	return err
 }
 
-// PostUsersUpdateUserPassword converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersUpdateUserPassword(ctx echo.Context) error {
+// PutSeasonsSeasonIdMembersMemberId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutSeasonsSeasonIdMembersMemberId(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "seasonId" -------------
+	var seasonId int
 
-	ctx.Set(BearerAuthScopes, []string{})
+	err = runtime.BindStyledParameterWithOptions("simple", "seasonId", ctx.Param("seasonId"), &seasonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
+	}
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersUpdateUserPassword(ctx)
-	return err
-}
+	// ------------- Path parameter "memberId" -------------
+	var memberId int
 
-// PostUsersVerifyMagicLinkToken converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersVerifyMagicLinkToken(ctx echo.Context) error {
-	var err error
+	err = runtime.BindStyledParameterWithOptions("simple", "memberId", ctx.Param("memberId"), &memberId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter memberId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersVerifyMagicLinkToken(ctx)
+	err = w.Handler.PutSeasonsSeasonIdMembersMemberId(ctx, seasonId, memberId)
 	return err
 }
 
-// DeleteUsersUserId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteUsersUserId(ctx echo.Context) error {
+// GetSeasonsSeasonIdPublicScheduleLinks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdPublicScheduleLinks(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
+	// ------------- Path parameter "seasonId" -------------
+	var seasonId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "seasonId", ctx.Param("seasonId"), &seasonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteUsersUserId(ctx, userId)
+	err = w.Handler.GetSeasonsSeasonIdPublicScheduleLinks(ctx, seasonId)
 	return err
 }
 
-// GetUsersUserIdAppsettings converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUsersUserIdAppsettings(ctx echo.Context) error {
+// PostSeasonsSeasonIdPublicScheduleLinks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSeasonsSeasonIdPublicScheduleLinks(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
+	// ------------- Path parameter "seasonId" -------------
+	var seasonId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "seasonId", ctx.Param("seasonId"), &seasonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUsersUserIdAppsettings(ctx, userId)
+	err = w.Handler.PostSeasonsSeasonIdPublicScheduleLinks(ctx, seasonId)
 	return err
 }
 
-// PostUsersUserIdAppsettings converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersUserIdAppsettings(ctx echo.Context) error {
+// DeleteSeasonsSeasonIdPublicScheduleLinksLinkId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSeasonsSeasonIdPublicScheduleLinksLinkId(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
+	// ------------- Path parameter "seasonId" -------------
+	var seasonId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "seasonId", ctx.Param("seasonId"), &seasonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "linkId", ctx.Param("linkId"), &linkId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter linkId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersUserIdAppsettings(ctx, userId)
+	err = w.Handler.DeleteSeasonsSeasonIdPublicScheduleLinksLinkId(ctx, seasonId, linkId)
 	return err
 }
 
-// GetUsersUserIdCustomPlayerColumns converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUsersUserIdCustomPlayerColumns(ctx echo.Context) error {
+// PostSeasonsSeasonIdScheduleGenerate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSeasonsSeasonIdScheduleGenerate(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
+	// ------------- Path parameter "seasonId" -------------
+	var seasonId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "seasonId", ctx.Param("seasonId"), &seasonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUsersUserIdCustomPlayerColumns(ctx, userId)
+	err = w.Handler.PostSeasonsSeasonIdScheduleGenerate(ctx, seasonId)
 	return err
 }
 
-// PostUsersUserIdCustomPlayerColumns converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersUserIdCustomPlayerColumns(ctx echo.Context) error {
+// GetSeasonsSeasonIdScoreboard converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdScoreboard(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
+	// ------------- Path parameter "seasonId" -------------
+	var seasonId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "seasonId", ctx.Param("seasonId"), &seasonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersUserIdCustomPlayerColumns(ctx, userId)
-	return err
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-// DeleteUsersUserIdCustomPlayerColumnsColumnId converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSeasonsSeasonIdScoreboardParams
+	// ------------- Optional query parameter "rating" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "rating", ctx.QueryParams(), &params.Rating)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter rating: %s", err))
 	}
 
-	// ------------- Path parameter "columnId" -------------
-	var columnId int
+	// ------------- Optional query parameter "ratingScope" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "columnId", ctx.Param("columnId"), &columnId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "ratingScope", ctx.QueryParams(), &params.RatingScope)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter columnId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ratingScope: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "kFactor" -------------
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx, userId, columnId)
-	return err
-}
+	err = runtime.BindQueryParameter("form", true, false, "kFactor", ctx.QueryParams(), &params.KFactor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter kFactor: %s", err))
+	}
 
-// PostUsersUserIdResetCurrentUserPassword converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersUserIdResetCurrentUserPassword(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
+	// ------------- Optional query parameter "byGroup" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "byGroup", ctx.QueryParams(), &params.ByGroup)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter byGroup: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersUserIdResetCurrentUserPassword(ctx, userId)
+	err = w.Handler.GetSeasonsSeasonIdScoreboard(ctx, seasonId, params)
 	return err
 }
 
-// DeleteUsersUserIdSubscription converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteUsersUserIdSubscription(ctx echo.Context) error {
+// GetSeasonsSeasonIdUpcoming converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSeasonsSeasonIdUpcoming(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
+	// ------------- Path parameter "seasonId" -------------
+	var seasonId int
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "seasonId", ctx.Param("seasonId"), &seasonId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter seasonId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteUsersUserIdSubscription(ctx, userId)
+	err = w.Handler.GetSeasonsSeasonIdUpcoming(ctx, seasonId)
 	return err
 }
 
-// GetUsersUserIdSubscription converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUsersUserIdSubscription(ctx echo.Context) error {
+// DeleteSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSessions(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
-	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUsersUserIdSubscription(ctx, userId)
-	return err
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-// GetUsersUserIdUsersettings converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUsersUserIdUsersettings(ctx echo.Context) error {
-	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSessionsParams
+	// ------------- Optional query parameter "all" -------------
 
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindQueryParameter("form", true, false, "all", ctx.QueryParams(), &params.All)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter all: %s", err))
 	}
 
-	ctx.Set(BearerAuthScopes, []string{})
-
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUsersUserIdUsersettings(ctx, userId)
+	err = w.Handler.DeleteSessions(ctx, params)
 	return err
 }
 
-// PostUsersUserIdUsersettings converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersUserIdUsersettings(ctx echo.Context) error {
+// GetSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetSessions(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "userId" -------------
-	var userId int
-
-	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
-	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersUserIdUsersettings(ctx, userId)
+	err = w.Handler.GetSessions(ctx)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// PostSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSessions(ctx echo.Context) error {
+	var err error
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSessions(ctx)
+	return err
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// DeleteSessionsSessionId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteSessionsSessionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "sessionId" -------------
+	var sessionId string
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
+	err = runtime.BindStyledParameterWithOptions("simple", "sessionId", ctx.Param("sessionId"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sessionId: %s", err))
 	}
 
-	router.POST(baseURL+"/matches", wrapper.PostMatches)
-	router.PUT(baseURL+"/matches/batches", wrapper.PutMatchesBatches)
-	router.POST(baseURL+"/matches/unassignPlayerFromMatch", wrapper.PostMatchesUnassignPlayerFromMatch)
-	router.DELETE(baseURL+"/matches/:matchId", wrapper.DeleteMatchesMatchId)
-	router.PUT(baseURL+"/matches/:matchId", wrapper.PutMatchesMatchId)
-	router.GET(baseURL+"/players", wrapper.GetPlayers)
-	router.POST(baseURL+"/players", wrapper.PostPlayers)
-	router.DELETE(baseURL+"/players/:playerId", wrapper.DeletePlayersPlayerId)
-	router.GET(baseURL+"/players/:playerId", wrapper.GetPlayersPlayerId)
-	router.PUT(baseURL+"/players/:playerId", wrapper.PutPlayersPlayerId)
-	router.GET(baseURL+"/players/:playerId/customColumns", wrapper.GetPlayersPlayerIdCustomColumns)
-	router.PUT(baseURL+"/players/:playerId/customColumns", wrapper.PutPlayersPlayerIdCustomColumns)
-	router.GET(baseURL+"/players/:playerId/schedule", wrapper.GetPlayersPlayerIdSchedule)
-	router.GET(baseURL+"/seasons", wrapper.GetSeasons)
-	router.POST(baseURL+"/seasons", wrapper.PostSeasons)
-	router.GET(baseURL+"/seasons/totalAmount", wrapper.GetSeasonsTotalAmount)
-	router.DELETE(baseURL+"/seasons/:seasonId", wrapper.DeleteSeasonsSeasonId)
-	router.GET(baseURL+"/seasons/:seasonId", wrapper.GetSeasonsSeasonId)
-	router.PUT(baseURL+"/seasons/:seasonId", wrapper.PutSeasonsSeasonId)
-	router.GET(baseURL+"/seasons/:seasonId/publicScheduleLink", wrapper.GetSeasonsSeasonIdPublicScheduleLink)
-	router.GET(baseURL+"/seasons/:seasonId/scoreboard", wrapper.GetSeasonsSeasonIdScoreboard)
-	router.GET(baseURL+"/seasons/:seasonId/upcoming", wrapper.GetSeasonsSeasonIdUpcoming)
-	router.POST(baseURL+"/sessions", wrapper.PostSessions)
-	router.POST(baseURL+"/subscriptions/handleSuccessUpgrade", wrapper.PostSubscriptionsHandleSuccessUpgrade)
-	router.POST(baseURL+"/subscriptions/initUpdatePaymentMethod", wrapper.PostSubscriptionsInitUpdatePaymentMethod)
-	router.POST(baseURL+"/subscriptions/upgradeUserSubscription", wrapper.PostSubscriptionsUpgradeUserSubscription)
-	router.POST(baseURL+"/support/messages", wrapper.PostSupportMessages)
-	router.POST(baseURL+"/users/sendResetPasswordLink", wrapper.PostUsersSendResetPasswordLink)
-	router.POST(baseURL+"/users/sendVerificationEmail", wrapper.PostUsersSendVerificationEmail)
-	router.POST(baseURL+"/users/signUpUser", wrapper.PostUsersSignUpUser)
-	router.POST(baseURL+"/users/updateUserPassword", wrapper.PostUsersUpdateUserPassword)
-	router.POST(baseURL+"/users/verifyMagicLinkToken", wrapper.PostUsersVerifyMagicLinkToken)
-	router.DELETE(baseURL+"/users/:userId", wrapper.DeleteUsersUserId)
-	router.GET(baseURL+"/users/:userId/appsettings", wrapper.GetUsersUserIdAppsettings)
-	router.POST(baseURL+"/users/:userId/appsettings", wrapper.PostUsersUserIdAppsettings)
-	router.GET(baseURL+"/users/:userId/customPlayerColumns", wrapper.GetUsersUserIdCustomPlayerColumns)
-	router.POST(baseURL+"/users/:userId/customPlayerColumns", wrapper.PostUsersUserIdCustomPlayerColumns)
-	router.DELETE(baseURL+"/users/:userId/customPlayerColumns/:columnId", wrapper.DeleteUsersUserIdCustomPlayerColumnsColumnId)
-	router.POST(baseURL+"/users/:userId/resetCurrentUserPassword", wrapper.PostUsersUserIdResetCurrentUserPassword)
-	router.DELETE(baseURL+"/users/:userId/subscription", wrapper.DeleteUsersUserIdSubscription)
-	router.GET(baseURL+"/users/:userId/subscription", wrapper.GetUsersUserIdSubscription)
-	router.GET(baseURL+"/users/:userId/usersettings", wrapper.GetUsersUserIdUsersettings)
-	router.POST(baseURL+"/users/:userId/usersettings", wrapper.PostUsersUserIdUsersettings)
+	ctx.Set(BearerAuthScopes, []string{})
 
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type PostMatchesRequestObject struct {
-	Body *PostMatchesJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteSessionsSessionId(ctx, sessionId)
+	return err
 }
 
-type PostMatchesResponseObject interface {
-	VisitPostMatchesResponse(w http.ResponseWriter) error
-}
+// PostSubscriptionsHandleSuccessUpgrade converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSubscriptionsHandleSuccessUpgrade(ctx echo.Context) error {
+	var err error
 
-type PostMatches200JSONResponse ApiResult
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response PostMatches200JSONResponse) VisitPostMatchesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSubscriptionsHandleSuccessUpgrade(ctx)
+	return err
 }
 
-type PutMatchesBatchesRequestObject struct {
-	Body *PutMatchesBatchesJSONRequestBody
-}
+// PostSubscriptionsInitUpdatePaymentMethod converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSubscriptionsInitUpdatePaymentMethod(ctx echo.Context) error {
+	var err error
 
-type PutMatchesBatchesResponseObject interface {
-	VisitPutMatchesBatchesResponse(w http.ResponseWriter) error
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSubscriptionsInitUpdatePaymentMethod(ctx)
+	return err
 }
 
-type PutMatchesBatches200JSONResponse ApiResult
+// PostSubscriptionsUpgradeUserSubscription converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSubscriptionsUpgradeUserSubscription(ctx echo.Context) error {
+	var err error
 
-func (response PutMatchesBatches200JSONResponse) VisitPutMatchesBatchesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type PostMatchesUnassignPlayerFromMatchRequestObject struct {
-	Body *PostMatchesUnassignPlayerFromMatchJSONRequestBody
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSubscriptionsUpgradeUserSubscription(ctx)
+	return err
 }
 
-type PostMatchesUnassignPlayerFromMatchResponseObject interface {
-	VisitPostMatchesUnassignPlayerFromMatchResponse(w http.ResponseWriter) error
-}
+// PostSupportMessages converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSupportMessages(ctx echo.Context) error {
+	var err error
 
-type PostMatchesUnassignPlayerFromMatch200JSONResponse ApiResult
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response PostMatchesUnassignPlayerFromMatch200JSONResponse) VisitPostMatchesUnassignPlayerFromMatchResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSupportMessages(ctx)
+	return err
 }
 
-type DeleteMatchesMatchIdRequestObject struct {
-	MatchId int `json:"matchId"`
-}
+// PostUsersSendResetPasswordLink converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSendResetPasswordLink(ctx echo.Context) error {
+	var err error
 
-type DeleteMatchesMatchIdResponseObject interface {
-	VisitDeleteMatchesMatchIdResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSendResetPasswordLink(ctx)
+	return err
 }
 
-type DeleteMatchesMatchId200JSONResponse ApiResult
-
-func (response DeleteMatchesMatchId200JSONResponse) VisitDeleteMatchesMatchIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostUsersSendVerificationEmail converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSendVerificationEmail(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSendVerificationEmail(ctx)
+	return err
 }
 
-type PutMatchesMatchIdRequestObject struct {
-	MatchId int `json:"matchId"`
-	Body    *PutMatchesMatchIdJSONRequestBody
-}
+// PostUsersSignUpUser converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSignUpUser(ctx echo.Context) error {
+	var err error
 
-type PutMatchesMatchIdResponseObject interface {
-	VisitPutMatchesMatchIdResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSignUpUser(ctx)
+	return err
 }
 
-type PutMatchesMatchId200JSONResponse ApiResult
-
-func (response PutMatchesMatchId200JSONResponse) VisitPutMatchesMatchIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostUsersUpdateUserPassword converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersUpdateUserPassword(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersUpdateUserPassword(ctx)
+	return err
 }
 
-type GetPlayersRequestObject struct {
-	Params GetPlayersParams
+// PostUsersVerifyMagicLinkToken converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersVerifyMagicLinkToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersVerifyMagicLinkToken(ctx)
+	return err
 }
 
-type GetPlayersResponseObject interface {
-	VisitGetPlayersResponse(w http.ResponseWriter) error
-}
-
-type GetPlayers200JSONResponse ApiResult
+// DeleteUsersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUsersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-func (response GetPlayers200JSONResponse) VisitGetPlayersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostPlayersRequestObject struct {
-	Body *PostPlayersJSONRequestBody
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type PostPlayersResponseObject interface {
-	VisitPostPlayersResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUsersUserId(ctx, userId)
+	return err
 }
 
-type PostPlayers200JSONResponse ApiResult
+// GetUsersUserIdAppsettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersUserIdAppsettings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-func (response PostPlayers200JSONResponse) VisitPostPlayersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeletePlayersPlayerIdRequestObject struct {
-	PlayerId int `json:"playerId"`
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type DeletePlayersPlayerIdResponseObject interface {
-	VisitDeletePlayersPlayerIdResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersUserIdAppsettings(ctx, userId)
+	return err
 }
 
-type DeletePlayersPlayerId200JSONResponse ApiResult
+// PostUsersUserIdAppsettings converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersUserIdAppsettings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-func (response DeletePlayersPlayerId200JSONResponse) VisitDeletePlayersPlayerIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetPlayersPlayerIdRequestObject struct {
-	PlayerId int `json:"playerId"`
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type GetPlayersPlayerIdResponseObject interface {
-	VisitGetPlayersPlayerIdResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersUserIdAppsettings(ctx, userId)
+	return err
 }
 
-type GetPlayersPlayerId200JSONResponse ApiResult
+// GetUsersUserIdCustomMatchColumns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersUserIdCustomMatchColumns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-func (response GetPlayersPlayerId200JSONResponse) VisitGetPlayersPlayerIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PutPlayersPlayerIdRequestObject struct {
-	PlayerId int `json:"playerId"`
-	Body     *PutPlayersPlayerIdJSONRequestBody
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type PutPlayersPlayerIdResponseObject interface {
-	VisitPutPlayersPlayerIdResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersUserIdCustomMatchColumns(ctx, userId)
+	return err
 }
 
-type PutPlayersPlayerId200JSONResponse ApiResult
+// PostUsersUserIdCustomMatchColumns converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersUserIdCustomMatchColumns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-func (response PutPlayersPlayerId200JSONResponse) VisitPutPlayersPlayerIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetPlayersPlayerIdCustomColumnsRequestObject struct {
-	PlayerId int `json:"playerId"`
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type GetPlayersPlayerIdCustomColumnsResponseObject interface {
-	VisitGetPlayersPlayerIdCustomColumnsResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersUserIdCustomMatchColumns(ctx, userId)
+	return err
 }
 
-type GetPlayersPlayerIdCustomColumns200JSONResponse ApiResult
-
-func (response GetPlayersPlayerIdCustomColumns200JSONResponse) VisitGetPlayersPlayerIdCustomColumnsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeleteUsersUserIdCustomMatchColumnsColumnId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUsersUserIdCustomMatchColumnsColumnId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-type PutPlayersPlayerIdCustomColumnsRequestObject struct {
-	PlayerId int `json:"playerId"`
-	Body     *PutPlayersPlayerIdCustomColumnsJSONRequestBody
-}
+	// ------------- Path parameter "columnId" -------------
+	var columnId int
 
-type PutPlayersPlayerIdCustomColumnsResponseObject interface {
-	VisitPutPlayersPlayerIdCustomColumnsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "columnId", ctx.Param("columnId"), &columnId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter columnId: %s", err))
+	}
 
-type PutPlayersPlayerIdCustomColumns200JSONResponse ApiResult
+	ctx.Set(BearerAuthScopes, []string{})
 
-func (response PutPlayersPlayerIdCustomColumns200JSONResponse) VisitPutPlayersPlayerIdCustomColumnsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUsersUserIdCustomMatchColumnsColumnId(ctx, userId, columnId)
+	return err
 }
 
-type GetPlayersPlayerIdScheduleRequestObject struct {
-	PlayerId int `json:"playerId"`
-}
+// PutUsersUserIdCustomMatchColumnsColumnId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutUsersUserIdCustomMatchColumnsColumnId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-type GetPlayersPlayerIdScheduleResponseObject interface {
-	VisitGetPlayersPlayerIdScheduleResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-type GetPlayersPlayerIdSchedule200JSONResponse ApiResult
+	// ------------- Path parameter "columnId" -------------
+	var columnId int
 
-func (response GetPlayersPlayerIdSchedule200JSONResponse) VisitGetPlayersPlayerIdScheduleResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "columnId", ctx.Param("columnId"), &columnId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter columnId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetSeasonsRequestObject struct {
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type GetSeasonsResponseObject interface {
-	VisitGetSeasonsResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutUsersUserIdCustomMatchColumnsColumnId(ctx, userId, columnId)
+	return err
 }
 
-type GetSeasons200JSONResponse ApiResult
+// GetUsersUserIdCustomPlayerColumns converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersUserIdCustomPlayerColumns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-func (response GetSeasons200JSONResponse) VisitGetSeasonsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type PostSeasonsRequestObject struct {
-	Body *PostSeasonsJSONRequestBody
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type PostSeasonsResponseObject interface {
-	VisitPostSeasonsResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersUserIdCustomPlayerColumns(ctx, userId)
+	return err
 }
 
-type PostSeasons200JSONResponse ApiResult
+// PostUsersUserIdCustomPlayerColumns converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersUserIdCustomPlayerColumns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-func (response PostSeasons200JSONResponse) VisitPostSeasonsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type GetSeasonsTotalAmountRequestObject struct {
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type GetSeasonsTotalAmountResponseObject interface {
-	VisitGetSeasonsTotalAmountResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersUserIdCustomPlayerColumns(ctx, userId)
+	return err
 }
 
-type GetSeasonsTotalAmount200JSONResponse ApiResult
+// PutUsersUserIdCustomPlayerColumns converts echo context to params.
+func (w *ServerInterfaceWrapper) PutUsersUserIdCustomPlayerColumns(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-func (response GetSeasonsTotalAmount200JSONResponse) VisitGetSeasonsTotalAmountResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(BearerAuthScopes, []string{})
 
-type DeleteSeasonsSeasonIdRequestObject struct {
-	SeasonId int `json:"seasonId"`
-}
+	ctx.Set(ApiKeyAuthScopes, []string{})
 
-type DeleteSeasonsSeasonIdResponseObject interface {
-	VisitDeleteSeasonsSeasonIdResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutUsersUserIdCustomPlayerColumns(ctx, userId)
+	return err
 }
 
-type DeleteSeasonsSeasonId200JSONResponse ApiResult
-
-func (response DeleteSeasonsSeasonId200JSONResponse) VisitDeleteSeasonsSeasonIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// DeleteUsersUserIdCustomPlayerColumnsColumnId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-	return json.NewEncoder(w).Encode(response)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	// ------------- Path parameter "columnId" -------------
+	var columnId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "columnId", ctx.Param("columnId"), &columnId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter columnId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteUsersUserIdCustomPlayerColumnsColumnIdParams
+	// ------------- Optional query parameter "confirm" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "confirm", ctx.QueryParams(), &params.Confirm)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter confirm: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx, userId, columnId, params)
+	return err
 }
 
-type GetSeasonsSeasonIdRequestObject struct {
-	SeasonId int `json:"seasonId"`
-	Body     *GetSeasonsSeasonIdJSONRequestBody
+// PutUsersUserIdCustomPlayerColumnsColumnId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutUsersUserIdCustomPlayerColumnsColumnId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	// ------------- Path parameter "columnId" -------------
+	var columnId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "columnId", ctx.Param("columnId"), &columnId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter columnId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutUsersUserIdCustomPlayerColumnsColumnId(ctx, userId, columnId)
+	return err
 }
 
-type GetSeasonsSeasonIdResponseObject interface {
-	VisitGetSeasonsSeasonIdResponse(w http.ResponseWriter) error
+// PostUsersUserIdResetCurrentUserPassword converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersUserIdResetCurrentUserPassword(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersUserIdResetCurrentUserPassword(ctx, userId)
+	return err
 }
 
-type GetSeasonsSeasonId200JSONResponse ApiResult
+// DeleteUsersUserIdSubscription converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteUsersUserIdSubscription(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-func (response GetSeasonsSeasonId200JSONResponse) VisitGetSeasonsSeasonIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteUsersUserIdSubscription(ctx, userId)
+	return err
 }
 
-type PutSeasonsSeasonIdRequestObject struct {
-	SeasonId int `json:"seasonId"`
-	Body     *PutSeasonsSeasonIdJSONRequestBody
+// GetUsersUserIdSubscription converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersUserIdSubscription(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersUserIdSubscription(ctx, userId)
+	return err
 }
 
-type PutSeasonsSeasonIdResponseObject interface {
-	VisitPutSeasonsSeasonIdResponse(w http.ResponseWriter) error
+// GetUsersUserIdUsersettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersUserIdUsersettings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersUserIdUsersettings(ctx, userId)
+	return err
 }
 
-type PutSeasonsSeasonId200JSONResponse ApiResult
+// PostUsersUserIdUsersettings converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersUserIdUsersettings(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "userId" -------------
+	var userId int
 
-func (response PutSeasonsSeasonId200JSONResponse) VisitPutSeasonsSeasonIdResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", ctx.Param("userId"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter userId: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	ctx.Set(BearerAuthScopes, []string{})
+
+	ctx.Set(ApiKeyAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersUserIdUsersettings(ctx, userId)
+	return err
 }
 
-type GetSeasonsSeasonIdPublicScheduleLinkRequestObject struct {
-	SeasonId int `json:"seasonId"`
+// PostWebhooksStripe converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksStripe(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostWebhooksStripeParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "Stripe-Signature" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Stripe-Signature")]; found {
+		var StripeSignature string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Stripe-Signature, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Stripe-Signature", valueList[0], &StripeSignature, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Stripe-Signature: %s", err))
+		}
+
+		params.StripeSignature = StripeSignature
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter Stripe-Signature is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksStripe(ctx, params)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
 }
 
-type GetSeasonsSeasonIdPublicScheduleLinkResponseObject interface {
-	VisitGetSeasonsSeasonIdPublicScheduleLinkResponse(w http.ResponseWriter) error
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
 }
 
-type GetSeasonsSeasonIdPublicScheduleLink200JSONResponse ApiResult
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
 
-func (response GetSeasonsSeasonIdPublicScheduleLink200JSONResponse) VisitGetSeasonsSeasonIdPublicScheduleLinkResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/apiKeys", wrapper.GetApiKeys)
+	router.POST(baseURL+"/apiKeys", wrapper.PostApiKeys)
+	router.DELETE(baseURL+"/apiKeys/:keyId", wrapper.DeleteApiKeysKeyId)
+	router.GET(baseURL+"/calendars/:token", wrapper.GetCalendarsToken)
+	router.POST(baseURL+"/matches", wrapper.PostMatches)
+	router.PUT(baseURL+"/matches/batches", wrapper.PutMatchesBatches)
+	router.POST(baseURL+"/matches/unassignPlayerFromMatch", wrapper.PostMatchesUnassignPlayerFromMatch)
+	router.DELETE(baseURL+"/matches/:matchId", wrapper.DeleteMatchesMatchId)
+	router.PUT(baseURL+"/matches/:matchId", wrapper.PutMatchesMatchId)
+	router.GET(baseURL+"/matches/:matchId/customColumns", wrapper.GetMatchesMatchIdCustomColumns)
+	router.PUT(baseURL+"/matches/:matchId/customColumns", wrapper.PutMatchesMatchIdCustomColumns)
+	router.GET(baseURL+"/players", wrapper.GetPlayers)
+	router.POST(baseURL+"/players", wrapper.PostPlayers)
+	router.GET(baseURL+"/players/ratings", wrapper.GetPlayersRatings)
+	router.DELETE(baseURL+"/players/:playerId", wrapper.DeletePlayersPlayerId)
+	router.GET(baseURL+"/players/:playerId", wrapper.GetPlayersPlayerId)
+	router.PUT(baseURL+"/players/:playerId", wrapper.PutPlayersPlayerId)
+	router.DELETE(baseURL+"/players/:playerId/calendarFeed", wrapper.DeletePlayersPlayerIdCalendarFeed)
+	router.POST(baseURL+"/players/:playerId/calendarFeed", wrapper.PostPlayersPlayerIdCalendarFeed)
+	router.GET(baseURL+"/players/:playerId/customColumns", wrapper.GetPlayersPlayerIdCustomColumns)
+	router.PUT(baseURL+"/players/:playerId/customColumns", wrapper.PutPlayersPlayerIdCustomColumns)
+	router.GET(baseURL+"/players/:playerId/schedule", wrapper.GetPlayersPlayerIdSchedule)
+	router.GET(baseURL+"/public/schedules/:token", wrapper.GetPublicSchedulesToken)
+	router.GET(baseURL+"/public/schedules/:token/events", wrapper.GetPublicSchedulesTokenEvents)
+	router.GET(baseURL+"/public/schedules/:token/scoreboard", wrapper.GetPublicSchedulesTokenScoreboard)
+	router.GET(baseURL+"/public/schedules/:token/upcoming", wrapper.GetPublicSchedulesTokenUpcoming)
+	router.GET(baseURL+"/seasons", wrapper.GetSeasons)
+	router.POST(baseURL+"/seasons", wrapper.PostSeasons)
+	router.GET(baseURL+"/seasons/totalAmount", wrapper.GetSeasonsTotalAmount)
+	router.DELETE(baseURL+"/seasons/:seasonId", wrapper.DeleteSeasonsSeasonId)
+	router.GET(baseURL+"/seasons/:seasonId", wrapper.GetSeasonsSeasonId)
+	router.PUT(baseURL+"/seasons/:seasonId", wrapper.PutSeasonsSeasonId)
+	router.GET(baseURL+"/seasons/:seasonId/bracket", wrapper.GetSeasonsSeasonIdBracket)
+	router.POST(baseURL+"/seasons/:seasonId/bracket", wrapper.PostSeasonsSeasonIdBracket)
+	router.GET(baseURL+"/seasons/:seasonId/events", wrapper.GetSeasonsSeasonIdEvents)
+	router.GET(baseURL+"/seasons/:seasonId/members", wrapper.GetSeasonsSeasonIdMembers)
+	router.POST(baseURL+"/seasons/:seasonId/members", wrapper.PostSeasonsSeasonIdMembers)
+	router.DELETE(baseURL+"/seasons/:seasonId/members/:memberId", wrapper.DeleteSeasonsSeasonIdMembersMemberId)
+	router.PUT(baseURL+"/seasons/:seasonId/members/:memberId", wrapper.PutSeasonsSeasonIdMembersMemberId)
+	router.GET(baseURL+"/seasons/:seasonId/publicScheduleLinks", wrapper.GetSeasonsSeasonIdPublicScheduleLinks)
+	router.POST(baseURL+"/seasons/:seasonId/publicScheduleLinks", wrapper.PostSeasonsSeasonIdPublicScheduleLinks)
+	router.DELETE(baseURL+"/seasons/:seasonId/publicScheduleLinks/:linkId", wrapper.DeleteSeasonsSeasonIdPublicScheduleLinksLinkId)
+	router.POST(baseURL+"/seasons/:seasonId/schedule/generate", wrapper.PostSeasonsSeasonIdScheduleGenerate)
+	router.GET(baseURL+"/seasons/:seasonId/scoreboard", wrapper.GetSeasonsSeasonIdScoreboard)
+	router.GET(baseURL+"/seasons/:seasonId/upcoming", wrapper.GetSeasonsSeasonIdUpcoming)
+	router.DELETE(baseURL+"/sessions", wrapper.DeleteSessions)
+	router.GET(baseURL+"/sessions", wrapper.GetSessions)
+	router.POST(baseURL+"/sessions", wrapper.PostSessions)
+	router.DELETE(baseURL+"/sessions/:sessionId", wrapper.DeleteSessionsSessionId)
+	router.POST(baseURL+"/subscriptions/handleSuccessUpgrade", wrapper.PostSubscriptionsHandleSuccessUpgrade)
+	router.POST(baseURL+"/subscriptions/initUpdatePaymentMethod", wrapper.PostSubscriptionsInitUpdatePaymentMethod)
+	router.POST(baseURL+"/subscriptions/upgradeUserSubscription", wrapper.PostSubscriptionsUpgradeUserSubscription)
+	router.POST(baseURL+"/support/messages", wrapper.PostSupportMessages)
+	router.POST(baseURL+"/users/sendResetPasswordLink", wrapper.PostUsersSendResetPasswordLink)
+	router.POST(baseURL+"/users/sendVerificationEmail", wrapper.PostUsersSendVerificationEmail)
+	router.POST(baseURL+"/users/signUpUser", wrapper.PostUsersSignUpUser)
+	router.POST(baseURL+"/users/updateUserPassword", wrapper.PostUsersUpdateUserPassword)
+	router.POST(baseURL+"/users/verifyMagicLinkToken", wrapper.PostUsersVerifyMagicLinkToken)
+	router.DELETE(baseURL+"/users/:userId", wrapper.DeleteUsersUserId)
+	router.GET(baseURL+"/users/:userId/appsettings", wrapper.GetUsersUserIdAppsettings)
+	router.POST(baseURL+"/users/:userId/appsettings", wrapper.PostUsersUserIdAppsettings)
+	router.GET(baseURL+"/users/:userId/customMatchColumns", wrapper.GetUsersUserIdCustomMatchColumns)
+	router.POST(baseURL+"/users/:userId/customMatchColumns", wrapper.PostUsersUserIdCustomMatchColumns)
+	router.DELETE(baseURL+"/users/:userId/customMatchColumns/:columnId", wrapper.DeleteUsersUserIdCustomMatchColumnsColumnId)
+	router.PUT(baseURL+"/users/:userId/customMatchColumns/:columnId", wrapper.PutUsersUserIdCustomMatchColumnsColumnId)
+	router.GET(baseURL+"/users/:userId/customPlayerColumns", wrapper.GetUsersUserIdCustomPlayerColumns)
+	router.POST(baseURL+"/users/:userId/customPlayerColumns", wrapper.PostUsersUserIdCustomPlayerColumns)
+	router.PUT(baseURL+"/users/:userId/customPlayerColumns", wrapper.PutUsersUserIdCustomPlayerColumns)
+	router.DELETE(baseURL+"/users/:userId/customPlayerColumns/:columnId", wrapper.DeleteUsersUserIdCustomPlayerColumnsColumnId)
+	router.PUT(baseURL+"/users/:userId/customPlayerColumns/:columnId", wrapper.PutUsersUserIdCustomPlayerColumnsColumnId)
+	router.POST(baseURL+"/users/:userId/resetCurrentUserPassword", wrapper.PostUsersUserIdResetCurrentUserPassword)
+	router.DELETE(baseURL+"/users/:userId/subscription", wrapper.DeleteUsersUserIdSubscription)
+	router.GET(baseURL+"/users/:userId/subscription", wrapper.GetUsersUserIdSubscription)
+	router.GET(baseURL+"/users/:userId/usersettings", wrapper.GetUsersUserIdUsersettings)
+	router.POST(baseURL+"/users/:userId/usersettings", wrapper.PostUsersUserIdUsersettings)
+	router.POST(baseURL+"/webhooks/stripe", wrapper.PostWebhooksStripe)
 
-	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSeasonsSeasonIdScoreboardRequestObject struct {
-	SeasonId int `json:"seasonId"`
+type GetApiKeysRequestObject struct {
 }
 
-type GetSeasonsSeasonIdScoreboardResponseObject interface {
-	VisitGetSeasonsSeasonIdScoreboardResponse(w http.ResponseWriter) error
+type GetApiKeysResponseObject interface {
+	VisitGetApiKeysResponse(w http.ResponseWriter) error
 }
 
-type GetSeasonsSeasonIdScoreboard200JSONResponse ApiResult
+type GetApiKeys200JSONResponse ApiResult
 
-func (response GetSeasonsSeasonIdScoreboard200JSONResponse) VisitGetSeasonsSeasonIdScoreboardResponse(w http.ResponseWriter) error {
+func (response GetApiKeys200JSONResponse) VisitGetApiKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSeasonsSeasonIdUpcomingRequestObject struct {
-	SeasonId int `json:"seasonId"`
+type PostApiKeysRequestObject struct {
+	Body *PostApiKeysJSONRequestBody
 }
 
-type GetSeasonsSeasonIdUpcomingResponseObject interface {
-	VisitGetSeasonsSeasonIdUpcomingResponse(w http.ResponseWriter) error
+type PostApiKeysResponseObject interface {
+	VisitPostApiKeysResponse(w http.ResponseWriter) error
 }
 
-type GetSeasonsSeasonIdUpcoming200JSONResponse ApiResult
+type PostApiKeys200JSONResponse ApiResult
 
-func (response GetSeasonsSeasonIdUpcoming200JSONResponse) VisitGetSeasonsSeasonIdUpcomingResponse(w http.ResponseWriter) error {
+func (response PostApiKeys200JSONResponse) VisitPostApiKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostSessionsRequestObject struct {
-	Body *PostSessionsJSONRequestBody
+type DeleteApiKeysKeyIdRequestObject struct {
+	KeyId int `json:"keyId"`
 }
 
-type PostSessionsResponseObject interface {
-	VisitPostSessionsResponse(w http.ResponseWriter) error
+type DeleteApiKeysKeyIdResponseObject interface {
+	VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error
 }
 
-type PostSessions200JSONResponse ApiResult
+type DeleteApiKeysKeyId200JSONResponse ApiResult
 
-func (response PostSessions200JSONResponse) VisitPostSessionsResponse(w http.ResponseWriter) error {
+func (response DeleteApiKeysKeyId200JSONResponse) VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostSubscriptionsHandleSuccessUpgradeRequestObject struct {
+type GetCalendarsTokenRequestObject struct {
+	Token string `json:"token"`
 }
 
-type PostSubscriptionsHandleSuccessUpgradeResponseObject interface {
-	VisitPostSubscriptionsHandleSuccessUpgradeResponse(w http.ResponseWriter) error
+type GetCalendarsTokenResponseObject interface {
+	VisitGetCalendarsTokenResponse(w http.ResponseWriter) error
 }
 
-type PostSubscriptionsHandleSuccessUpgrade200JSONResponse ApiResult
+type GetCalendarsToken200TextcalendarResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-func (response PostSubscriptionsHandleSuccessUpgrade200JSONResponse) VisitPostSubscriptionsHandleSuccessUpgradeResponse(w http.ResponseWriter) error {
+func (response GetCalendarsToken200TextcalendarResponse) VisitGetCalendarsTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/calendar")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type PostMatchesRequestObject struct {
+	Body *PostMatchesJSONRequestBody
+}
+
+type PostMatchesResponseObject interface {
+	VisitPostMatchesResponse(w http.ResponseWriter) error
+}
+
+type PostMatches200JSONResponse ApiResult
+
+func (response PostMatches200JSONResponse) VisitPostMatchesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostSubscriptionsInitUpdatePaymentMethodRequestObject struct {
+type PutMatchesBatchesRequestObject struct {
+	Params PutMatchesBatchesParams
+	Body   *PutMatchesBatchesJSONRequestBody
 }
 
-type PostSubscriptionsInitUpdatePaymentMethodResponseObject interface {
-	VisitPostSubscriptionsInitUpdatePaymentMethodResponse(w http.ResponseWriter) error
+type PutMatchesBatchesResponseObject interface {
+	VisitPutMatchesBatchesResponse(w http.ResponseWriter) error
 }
 
-type PostSubscriptionsInitUpdatePaymentMethod200JSONResponse ApiResult
+type PutMatchesBatches200JSONResponse ApiResult
 
-func (response PostSubscriptionsInitUpdatePaymentMethod200JSONResponse) VisitPostSubscriptionsInitUpdatePaymentMethodResponse(w http.ResponseWriter) error {
+func (response PutMatchesBatches200JSONResponse) VisitPutMatchesBatchesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostSubscriptionsUpgradeUserSubscriptionRequestObject struct {
+type PostMatchesUnassignPlayerFromMatchRequestObject struct {
+	Body *PostMatchesUnassignPlayerFromMatchJSONRequestBody
 }
 
-type PostSubscriptionsUpgradeUserSubscriptionResponseObject interface {
-	VisitPostSubscriptionsUpgradeUserSubscriptionResponse(w http.ResponseWriter) error
+type PostMatchesUnassignPlayerFromMatchResponseObject interface {
+	VisitPostMatchesUnassignPlayerFromMatchResponse(w http.ResponseWriter) error
 }
 
-type PostSubscriptionsUpgradeUserSubscription200JSONResponse ApiResult
+type PostMatchesUnassignPlayerFromMatch200JSONResponse ApiResult
 
-func (response PostSubscriptionsUpgradeUserSubscription200JSONResponse) VisitPostSubscriptionsUpgradeUserSubscriptionResponse(w http.ResponseWriter) error {
+func (response PostMatchesUnassignPlayerFromMatch200JSONResponse) VisitPostMatchesUnassignPlayerFromMatchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostSupportMessagesRequestObject struct {
-	Body *PostSupportMessagesJSONRequestBody
+type DeleteMatchesMatchIdRequestObject struct {
+	MatchId int `json:"matchId"`
 }
 
-type PostSupportMessagesResponseObject interface {
-	VisitPostSupportMessagesResponse(w http.ResponseWriter) error
+type DeleteMatchesMatchIdResponseObject interface {
+	VisitDeleteMatchesMatchIdResponse(w http.ResponseWriter) error
 }
 
-type PostSupportMessages200JSONResponse ApiResult
+type DeleteMatchesMatchId200JSONResponse ApiResult
 
-func (response PostSupportMessages200JSONResponse) VisitPostSupportMessagesResponse(w http.ResponseWriter) error {
+func (response DeleteMatchesMatchId200JSONResponse) VisitDeleteMatchesMatchIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersSendResetPasswordLinkRequestObject struct {
-	Body *PostUsersSendResetPasswordLinkJSONRequestBody
+type PutMatchesMatchIdRequestObject struct {
+	MatchId int `json:"matchId"`
+	Body    *PutMatchesMatchIdJSONRequestBody
 }
 
-type PostUsersSendResetPasswordLinkResponseObject interface {
-	VisitPostUsersSendResetPasswordLinkResponse(w http.ResponseWriter) error
+type PutMatchesMatchIdResponseObject interface {
+	VisitPutMatchesMatchIdResponse(w http.ResponseWriter) error
 }
 
-type PostUsersSendResetPasswordLink200JSONResponse ApiResult
+type PutMatchesMatchId200JSONResponse ApiResult
 
-func (response PostUsersSendResetPasswordLink200JSONResponse) VisitPostUsersSendResetPasswordLinkResponse(w http.ResponseWriter) error {
+func (response PutMatchesMatchId200JSONResponse) VisitPutMatchesMatchIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersSendVerificationEmailRequestObject struct {
+type GetMatchesMatchIdCustomColumnsRequestObject struct {
+	MatchId int `json:"matchId"`
 }
 
-type PostUsersSendVerificationEmailResponseObject interface {
-	VisitPostUsersSendVerificationEmailResponse(w http.ResponseWriter) error
+type GetMatchesMatchIdCustomColumnsResponseObject interface {
+	VisitGetMatchesMatchIdCustomColumnsResponse(w http.ResponseWriter) error
 }
 
-type PostUsersSendVerificationEmail200JSONResponse ApiResult
+type GetMatchesMatchIdCustomColumns200JSONResponse ApiResult
 
-func (response PostUsersSendVerificationEmail200JSONResponse) VisitPostUsersSendVerificationEmailResponse(w http.ResponseWriter) error {
+func (response GetMatchesMatchIdCustomColumns200JSONResponse) VisitGetMatchesMatchIdCustomColumnsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersSignUpUserRequestObject struct {
-	Body *PostUsersSignUpUserJSONRequestBody
+type PutMatchesMatchIdCustomColumnsRequestObject struct {
+	MatchId int `json:"matchId"`
+	Body    *PutMatchesMatchIdCustomColumnsJSONRequestBody
 }
 
-type PostUsersSignUpUserResponseObject interface {
-	VisitPostUsersSignUpUserResponse(w http.ResponseWriter) error
+type PutMatchesMatchIdCustomColumnsResponseObject interface {
+	VisitPutMatchesMatchIdCustomColumnsResponse(w http.ResponseWriter) error
 }
 
-type PostUsersSignUpUser200JSONResponse ApiResult
+type PutMatchesMatchIdCustomColumns200JSONResponse ApiResult
 
-func (response PostUsersSignUpUser200JSONResponse) VisitPostUsersSignUpUserResponse(w http.ResponseWriter) error {
+func (response PutMatchesMatchIdCustomColumns200JSONResponse) VisitPutMatchesMatchIdCustomColumnsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUpdateUserPasswordRequestObject struct {
-	Body *PostUsersUpdateUserPasswordJSONRequestBody
+type GetPlayersRequestObject struct {
+	Params GetPlayersParams
 }
 
-type PostUsersUpdateUserPasswordResponseObject interface {
-	VisitPostUsersUpdateUserPasswordResponse(w http.ResponseWriter) error
+type GetPlayersResponseObject interface {
+	VisitGetPlayersResponse(w http.ResponseWriter) error
 }
 
-type PostUsersUpdateUserPassword200JSONResponse ApiResult
+type GetPlayers200JSONResponse ApiResult
 
-func (response PostUsersUpdateUserPassword200JSONResponse) VisitPostUsersUpdateUserPasswordResponse(w http.ResponseWriter) error {
+func (response GetPlayers200JSONResponse) VisitGetPlayersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersVerifyMagicLinkTokenRequestObject struct {
-	Body *PostUsersVerifyMagicLinkTokenJSONRequestBody
+type PostPlayersRequestObject struct {
+	Body *PostPlayersJSONRequestBody
 }
 
-type PostUsersVerifyMagicLinkTokenResponseObject interface {
-	VisitPostUsersVerifyMagicLinkTokenResponse(w http.ResponseWriter) error
+type PostPlayersResponseObject interface {
+	VisitPostPlayersResponse(w http.ResponseWriter) error
 }
 
-type PostUsersVerifyMagicLinkToken200JSONResponse ApiResult
+type PostPlayers200JSONResponse ApiResult
 
-func (response PostUsersVerifyMagicLinkToken200JSONResponse) VisitPostUsersVerifyMagicLinkTokenResponse(w http.ResponseWriter) error {
+func (response PostPlayers200JSONResponse) VisitPostPlayersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdRequestObject struct {
-	UserId int `json:"userId"`
+type GetPlayersRatingsRequestObject struct {
+	Params GetPlayersRatingsParams
 }
 
-type DeleteUsersUserIdResponseObject interface {
-	VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error
+type GetPlayersRatingsResponseObject interface {
+	VisitGetPlayersRatingsResponse(w http.ResponseWriter) error
 }
 
-type DeleteUsersUserId200JSONResponse ApiResult
+type GetPlayersRatings200JSONResponse ApiResult
 
-func (response DeleteUsersUserId200JSONResponse) VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error {
+func (response GetPlayersRatings200JSONResponse) VisitGetPlayersRatingsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersUserIdAppsettingsRequestObject struct {
-	UserId int `json:"userId"`
+type DeletePlayersPlayerIdRequestObject struct {
+	PlayerId int `json:"playerId"`
 }
 
-type GetUsersUserIdAppsettingsResponseObject interface {
-	VisitGetUsersUserIdAppsettingsResponse(w http.ResponseWriter) error
+type DeletePlayersPlayerIdResponseObject interface {
+	VisitDeletePlayersPlayerIdResponse(w http.ResponseWriter) error
 }
 
-type GetUsersUserIdAppsettings200JSONResponse ApiResult
+type DeletePlayersPlayerId200JSONResponse ApiResult
 
-func (response GetUsersUserIdAppsettings200JSONResponse) VisitGetUsersUserIdAppsettingsResponse(w http.ResponseWriter) error {
+func (response DeletePlayersPlayerId200JSONResponse) VisitDeletePlayersPlayerIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdAppsettingsRequestObject struct {
-	UserId int `json:"userId"`
-	Body   *PostUsersUserIdAppsettingsJSONRequestBody
+type GetPlayersPlayerIdRequestObject struct {
+	PlayerId int `json:"playerId"`
 }
 
-type PostUsersUserIdAppsettingsResponseObject interface {
-	VisitPostUsersUserIdAppsettingsResponse(w http.ResponseWriter) error
+type GetPlayersPlayerIdResponseObject interface {
+	VisitGetPlayersPlayerIdResponse(w http.ResponseWriter) error
 }
 
-type PostUsersUserIdAppsettings200JSONResponse ApiResult
+type GetPlayersPlayerId200JSONResponse ApiResult
 
-func (response PostUsersUserIdAppsettings200JSONResponse) VisitPostUsersUserIdAppsettingsResponse(w http.ResponseWriter) error {
+func (response GetPlayersPlayerId200JSONResponse) VisitGetPlayersPlayerIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersUserIdCustomPlayerColumnsRequestObject struct {
-	UserId int `json:"userId"`
+type PutPlayersPlayerIdRequestObject struct {
+	PlayerId int `json:"playerId"`
+	Body     *PutPlayersPlayerIdJSONRequestBody
 }
 
-type GetUsersUserIdCustomPlayerColumnsResponseObject interface {
-	VisitGetUsersUserIdCustomPlayerColumnsResponse(w http.ResponseWriter) error
+type PutPlayersPlayerIdResponseObject interface {
+	VisitPutPlayersPlayerIdResponse(w http.ResponseWriter) error
 }
 
-type GetUsersUserIdCustomPlayerColumns200JSONResponse ApiResult
+type PutPlayersPlayerId200JSONResponse ApiResult
 
-func (response GetUsersUserIdCustomPlayerColumns200JSONResponse) VisitGetUsersUserIdCustomPlayerColumnsResponse(w http.ResponseWriter) error {
+func (response PutPlayersPlayerId200JSONResponse) VisitPutPlayersPlayerIdResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdCustomPlayerColumnsRequestObject struct {
-	UserId int `json:"userId"`
-	Body   *PostUsersUserIdCustomPlayerColumnsJSONRequestBody
+type DeletePlayersPlayerIdCalendarFeedRequestObject struct {
+	PlayerId int `json:"playerId"`
 }
 
-type PostUsersUserIdCustomPlayerColumnsResponseObject interface {
-	VisitPostUsersUserIdCustomPlayerColumnsResponse(w http.ResponseWriter) error
+type DeletePlayersPlayerIdCalendarFeedResponseObject interface {
+	VisitDeletePlayersPlayerIdCalendarFeedResponse(w http.ResponseWriter) error
 }
 
-type PostUsersUserIdCustomPlayerColumns200JSONResponse ApiResult
+type DeletePlayersPlayerIdCalendarFeed200JSONResponse ApiResult
 
-func (response PostUsersUserIdCustomPlayerColumns200JSONResponse) VisitPostUsersUserIdCustomPlayerColumnsResponse(w http.ResponseWriter) error {
+func (response DeletePlayersPlayerIdCalendarFeed200JSONResponse) VisitDeletePlayersPlayerIdCalendarFeedResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdCustomPlayerColumnsColumnIdRequestObject struct {
-	UserId   int `json:"userId"`
-	ColumnId int `json:"columnId"`
+type PostPlayersPlayerIdCalendarFeedRequestObject struct {
+	PlayerId int `json:"playerId"`
 }
 
-type DeleteUsersUserIdCustomPlayerColumnsColumnIdResponseObject interface {
-	VisitDeleteUsersUserIdCustomPlayerColumnsColumnIdResponse(w http.ResponseWriter) error
+type PostPlayersPlayerIdCalendarFeedResponseObject interface {
+	VisitPostPlayersPlayerIdCalendarFeedResponse(w http.ResponseWriter) error
 }
 
-type DeleteUsersUserIdCustomPlayerColumnsColumnId200JSONResponse ApiResult
+type PostPlayersPlayerIdCalendarFeed200JSONResponse ApiResult
 
-func (response DeleteUsersUserIdCustomPlayerColumnsColumnId200JSONResponse) VisitDeleteUsersUserIdCustomPlayerColumnsColumnIdResponse(w http.ResponseWriter) error {
+func (response PostPlayersPlayerIdCalendarFeed200JSONResponse) VisitPostPlayersPlayerIdCalendarFeedResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdResetCurrentUserPasswordRequestObject struct {
-	UserId int `json:"userId"`
-	Body   *PostUsersUserIdResetCurrentUserPasswordJSONRequestBody
+type GetPlayersPlayerIdCustomColumnsRequestObject struct {
+	PlayerId int `json:"playerId"`
 }
 
-type PostUsersUserIdResetCurrentUserPasswordResponseObject interface {
-	VisitPostUsersUserIdResetCurrentUserPasswordResponse(w http.ResponseWriter) error
+type GetPlayersPlayerIdCustomColumnsResponseObject interface {
+	VisitGetPlayersPlayerIdCustomColumnsResponse(w http.ResponseWriter) error
 }
 
-type PostUsersUserIdResetCurrentUserPassword200JSONResponse ApiResult
+type GetPlayersPlayerIdCustomColumns200JSONResponse ApiResult
 
-func (response PostUsersUserIdResetCurrentUserPassword200JSONResponse) VisitPostUsersUserIdResetCurrentUserPasswordResponse(w http.ResponseWriter) error {
+func (response GetPlayersPlayerIdCustomColumns200JSONResponse) VisitGetPlayersPlayerIdCustomColumnsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUsersUserIdSubscriptionRequestObject struct {
-	UserId int `json:"userId"`
+type PutPlayersPlayerIdCustomColumnsRequestObject struct {
+	PlayerId int `json:"playerId"`
+	Body     *PutPlayersPlayerIdCustomColumnsJSONRequestBody
 }
 
-type DeleteUsersUserIdSubscriptionResponseObject interface {
-	VisitDeleteUsersUserIdSubscriptionResponse(w http.ResponseWriter) error
+type PutPlayersPlayerIdCustomColumnsResponseObject interface {
+	VisitPutPlayersPlayerIdCustomColumnsResponse(w http.ResponseWriter) error
 }
 
-type DeleteUsersUserIdSubscription200JSONResponse ApiResult
+type PutPlayersPlayerIdCustomColumns200JSONResponse ApiResult
 
-func (response DeleteUsersUserIdSubscription200JSONResponse) VisitDeleteUsersUserIdSubscriptionResponse(w http.ResponseWriter) error {
+func (response PutPlayersPlayerIdCustomColumns200JSONResponse) VisitPutPlayersPlayerIdCustomColumnsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersUserIdSubscriptionRequestObject struct {
-	UserId int `json:"userId"`
+type GetPlayersPlayerIdScheduleRequestObject struct {
+	PlayerId int `json:"playerId"`
 }
 
-type GetUsersUserIdSubscriptionResponseObject interface {
-	VisitGetUsersUserIdSubscriptionResponse(w http.ResponseWriter) error
+type GetPlayersPlayerIdScheduleResponseObject interface {
+	VisitGetPlayersPlayerIdScheduleResponse(w http.ResponseWriter) error
 }
 
-type GetUsersUserIdSubscription200JSONResponse ApiResult
+type GetPlayersPlayerIdSchedule200JSONResponse ApiResult
 
-func (response GetUsersUserIdSubscription200JSONResponse) VisitGetUsersUserIdSubscriptionResponse(w http.ResponseWriter) error {
+func (response GetPlayersPlayerIdSchedule200JSONResponse) VisitGetPlayersPlayerIdScheduleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetUsersUserIdUsersettingsRequestObject struct {
-	UserId int `json:"userId"`
+type GetPublicSchedulesTokenRequestObject struct {
+	Token string `json:"token"`
 }
 
-type GetUsersUserIdUsersettingsResponseObject interface {
-	VisitGetUsersUserIdUsersettingsResponse(w http.ResponseWriter) error
+type GetPublicSchedulesTokenResponseObject interface {
+	VisitGetPublicSchedulesTokenResponse(w http.ResponseWriter) error
 }
 
-type GetUsersUserIdUsersettings200JSONResponse ApiResult
+type GetPublicSchedulesToken200JSONResponse ApiResult
 
-func (response GetUsersUserIdUsersettings200JSONResponse) VisitGetUsersUserIdUsersettingsResponse(w http.ResponseWriter) error {
+func (response GetPublicSchedulesToken200JSONResponse) VisitGetPublicSchedulesTokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PostUsersUserIdUsersettingsRequestObject struct {
-	UserId int `json:"userId"`
-	Body   *PostUsersUserIdUsersettingsJSONRequestBody
+type GetPublicSchedulesTokenEventsRequestObject struct {
+	Token string `json:"token"`
 }
 
-type PostUsersUserIdUsersettingsResponseObject interface {
-	VisitPostUsersUserIdUsersettingsResponse(w http.ResponseWriter) error
+type GetPublicSchedulesTokenEventsResponseObject interface {
+	VisitGetPublicSchedulesTokenEventsResponse(w http.ResponseWriter) error
 }
 
-type PostUsersUserIdUsersettings200JSONResponse ApiResult
+type GetPublicSchedulesTokenEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
 
-func (response PostUsersUserIdUsersettings200JSONResponse) VisitPostUsersUserIdUsersettingsResponse(w http.ResponseWriter) error {
+func (response GetPublicSchedulesTokenEvents200TexteventStreamResponse) VisitGetPublicSchedulesTokenEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetPublicSchedulesTokenScoreboardRequestObject struct {
+	Token  string `json:"token"`
+	Params GetPublicSchedulesTokenScoreboardParams
+}
+
+type GetPublicSchedulesTokenScoreboardResponseObject interface {
+	VisitGetPublicSchedulesTokenScoreboardResponse(w http.ResponseWriter) error
+}
+
+type GetPublicSchedulesTokenScoreboard200JSONResponse ApiResult
+
+func (response GetPublicSchedulesTokenScoreboard200JSONResponse) VisitGetPublicSchedulesTokenScoreboardResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Add a new match
-	// (POST /matches)
-	PostMatches(ctx context.Context, request PostMatchesRequestObject) (PostMatchesResponseObject, error)
-	// Update multiple matches at the same time
-	// (PUT /matches/batches)
-	PutMatchesBatches(ctx context.Context, request PutMatchesBatchesRequestObject) (PutMatchesBatchesResponseObject, error)
-	// Unassign a player from a match
-	// (POST /matches/unassignPlayerFromMatch)
-	PostMatchesUnassignPlayerFromMatch(ctx context.Context, request PostMatchesUnassignPlayerFromMatchRequestObject) (PostMatchesUnassignPlayerFromMatchResponseObject, error)
-	// Delete a match
-	// (DELETE /matches/{matchId})
-	DeleteMatchesMatchId(ctx context.Context, request DeleteMatchesMatchIdRequestObject) (DeleteMatchesMatchIdResponseObject, error)
-	// Save match data
-	// (PUT /matches/{matchId})
-	PutMatchesMatchId(ctx context.Context, request PutMatchesMatchIdRequestObject) (PutMatchesMatchIdResponseObject, error)
-	// Get a list of players
-	// (GET /players)
-	GetPlayers(ctx context.Context, request GetPlayersRequestObject) (GetPlayersResponseObject, error)
-	// Create a pool player
-	// (POST /players)
-	PostPlayers(ctx context.Context, request PostPlayersRequestObject) (PostPlayersResponseObject, error)
-	// Delete a player
-	// (DELETE /players/{playerId})
+type GetPublicSchedulesTokenUpcomingRequestObject struct {
+	Token string `json:"token"`
+}
+
+type GetPublicSchedulesTokenUpcomingResponseObject interface {
+	VisitGetPublicSchedulesTokenUpcomingResponse(w http.ResponseWriter) error
+}
+
+type GetPublicSchedulesTokenUpcoming200JSONResponse ApiResult
+
+func (response GetPublicSchedulesTokenUpcoming200JSONResponse) VisitGetPublicSchedulesTokenUpcomingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeasonsRequestObject struct {
+}
+
+type GetSeasonsResponseObject interface {
+	VisitGetSeasonsResponse(w http.ResponseWriter) error
+}
+
+type GetSeasons200JSONResponse ApiResult
+
+func (response GetSeasons200JSONResponse) VisitGetSeasonsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSeasonsRequestObject struct {
+	Body *PostSeasonsJSONRequestBody
+}
+
+type PostSeasonsResponseObject interface {
+	VisitPostSeasonsResponse(w http.ResponseWriter) error
+}
+
+type PostSeasons200JSONResponse ApiResult
+
+func (response PostSeasons200JSONResponse) VisitPostSeasonsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeasonsTotalAmountRequestObject struct {
+}
+
+type GetSeasonsTotalAmountResponseObject interface {
+	VisitGetSeasonsTotalAmountResponse(w http.ResponseWriter) error
+}
+
+type GetSeasonsTotalAmount200JSONResponse ApiResult
+
+func (response GetSeasonsTotalAmount200JSONResponse) VisitGetSeasonsTotalAmountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSeasonsSeasonIdRequestObject struct {
+	SeasonId int `json:"seasonId"`
+}
+
+type DeleteSeasonsSeasonIdResponseObject interface {
+	VisitDeleteSeasonsSeasonIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteSeasonsSeasonId200JSONResponse ApiResult
+
+func (response DeleteSeasonsSeasonId200JSONResponse) VisitDeleteSeasonsSeasonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeasonsSeasonIdRequestObject struct {
+	SeasonId int `json:"seasonId"`
+	Body     *GetSeasonsSeasonIdJSONRequestBody
+}
+
+type GetSeasonsSeasonIdResponseObject interface {
+	VisitGetSeasonsSeasonIdResponse(w http.ResponseWriter) error
+}
+
+type GetSeasonsSeasonId200JSONResponse ApiResult
+
+func (response GetSeasonsSeasonId200JSONResponse) VisitGetSeasonsSeasonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSeasonsSeasonIdRequestObject struct {
+	SeasonId int `json:"seasonId"`
+	Body     *PutSeasonsSeasonIdJSONRequestBody
+}
+
+type PutSeasonsSeasonIdResponseObject interface {
+	VisitPutSeasonsSeasonIdResponse(w http.ResponseWriter) error
+}
+
+type PutSeasonsSeasonId200JSONResponse ApiResult
+
+func (response PutSeasonsSeasonId200JSONResponse) VisitPutSeasonsSeasonIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeasonsSeasonIdBracketRequestObject struct {
+	SeasonId int `json:"seasonId"`
+}
+
+type GetSeasonsSeasonIdBracketResponseObject interface {
+	VisitGetSeasonsSeasonIdBracketResponse(w http.ResponseWriter) error
+}
+
+type GetSeasonsSeasonIdBracket200JSONResponse ApiResult
+
+func (response GetSeasonsSeasonIdBracket200JSONResponse) VisitGetSeasonsSeasonIdBracketResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSeasonsSeasonIdBracketRequestObject struct {
+	SeasonId int `json:"seasonId"`
+	Body     *PostSeasonsSeasonIdBracketJSONRequestBody
+}
+
+type PostSeasonsSeasonIdBracketResponseObject interface {
+	VisitPostSeasonsSeasonIdBracketResponse(w http.ResponseWriter) error
+}
+
+type PostSeasonsSeasonIdBracket200JSONResponse ApiResult
+
+func (response PostSeasonsSeasonIdBracket200JSONResponse) VisitPostSeasonsSeasonIdBracketResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeasonsSeasonIdEventsRequestObject struct {
+	SeasonId int `json:"seasonId"`
+}
+
+type GetSeasonsSeasonIdEventsResponseObject interface {
+	VisitGetSeasonsSeasonIdEventsResponse(w http.ResponseWriter) error
+}
+
+type GetSeasonsSeasonIdEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetSeasonsSeasonIdEvents200TexteventStreamResponse) VisitGetSeasonsSeasonIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetSeasonsSeasonIdMembersRequestObject struct {
+	SeasonId int `json:"seasonId"`
+}
+
+type GetSeasonsSeasonIdMembersResponseObject interface {
+	VisitGetSeasonsSeasonIdMembersResponse(w http.ResponseWriter) error
+}
+
+type GetSeasonsSeasonIdMembers200JSONResponse ApiResult
+
+func (response GetSeasonsSeasonIdMembers200JSONResponse) VisitGetSeasonsSeasonIdMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSeasonsSeasonIdMembersRequestObject struct {
+	SeasonId int `json:"seasonId"`
+	Body     *PostSeasonsSeasonIdMembersJSONRequestBody
+}
+
+type PostSeasonsSeasonIdMembersResponseObject interface {
+	VisitPostSeasonsSeasonIdMembersResponse(w http.ResponseWriter) error
+}
+
+type PostSeasonsSeasonIdMembers200JSONResponse ApiResult
+
+func (response PostSeasonsSeasonIdMembers200JSONResponse) VisitPostSeasonsSeasonIdMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSeasonsSeasonIdMembersMemberIdRequestObject struct {
+	SeasonId int `json:"seasonId"`
+	MemberId int `json:"memberId"`
+}
+
+type DeleteSeasonsSeasonIdMembersMemberIdResponseObject interface {
+	VisitDeleteSeasonsSeasonIdMembersMemberIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteSeasonsSeasonIdMembersMemberId200JSONResponse ApiResult
+
+func (response DeleteSeasonsSeasonIdMembersMemberId200JSONResponse) VisitDeleteSeasonsSeasonIdMembersMemberIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutSeasonsSeasonIdMembersMemberIdRequestObject struct {
+	SeasonId int `json:"seasonId"`
+	MemberId int `json:"memberId"`
+	Body     *PutSeasonsSeasonIdMembersMemberIdJSONRequestBody
+}
+
+type PutSeasonsSeasonIdMembersMemberIdResponseObject interface {
+	VisitPutSeasonsSeasonIdMembersMemberIdResponse(w http.ResponseWriter) error
+}
+
+type PutSeasonsSeasonIdMembersMemberId200JSONResponse ApiResult
+
+func (response PutSeasonsSeasonIdMembersMemberId200JSONResponse) VisitPutSeasonsSeasonIdMembersMemberIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeasonsSeasonIdPublicScheduleLinksRequestObject struct {
+	SeasonId int `json:"seasonId"`
+}
+
+type GetSeasonsSeasonIdPublicScheduleLinksResponseObject interface {
+	VisitGetSeasonsSeasonIdPublicScheduleLinksResponse(w http.ResponseWriter) error
+}
+
+type GetSeasonsSeasonIdPublicScheduleLinks200JSONResponse ApiResult
+
+func (response GetSeasonsSeasonIdPublicScheduleLinks200JSONResponse) VisitGetSeasonsSeasonIdPublicScheduleLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSeasonsSeasonIdPublicScheduleLinksRequestObject struct {
+	SeasonId int `json:"seasonId"`
+	Body     *PostSeasonsSeasonIdPublicScheduleLinksJSONRequestBody
+}
+
+type PostSeasonsSeasonIdPublicScheduleLinksResponseObject interface {
+	VisitPostSeasonsSeasonIdPublicScheduleLinksResponse(w http.ResponseWriter) error
+}
+
+type PostSeasonsSeasonIdPublicScheduleLinks200JSONResponse ApiResult
+
+func (response PostSeasonsSeasonIdPublicScheduleLinks200JSONResponse) VisitPostSeasonsSeasonIdPublicScheduleLinksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdRequestObject struct {
+	SeasonId int `json:"seasonId"`
+	LinkId   int `json:"linkId"`
+}
+
+type DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdResponseObject interface {
+	VisitDeleteSeasonsSeasonIdPublicScheduleLinksLinkIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteSeasonsSeasonIdPublicScheduleLinksLinkId200JSONResponse ApiResult
+
+func (response DeleteSeasonsSeasonIdPublicScheduleLinksLinkId200JSONResponse) VisitDeleteSeasonsSeasonIdPublicScheduleLinksLinkIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSeasonsSeasonIdScheduleGenerateRequestObject struct {
+	SeasonId int `json:"seasonId"`
+	Body     *PostSeasonsSeasonIdScheduleGenerateJSONRequestBody
+}
+
+type PostSeasonsSeasonIdScheduleGenerateResponseObject interface {
+	VisitPostSeasonsSeasonIdScheduleGenerateResponse(w http.ResponseWriter) error
+}
+
+type PostSeasonsSeasonIdScheduleGenerate200JSONResponse ApiResult
+
+func (response PostSeasonsSeasonIdScheduleGenerate200JSONResponse) VisitPostSeasonsSeasonIdScheduleGenerateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeasonsSeasonIdScoreboardRequestObject struct {
+	SeasonId int `json:"seasonId"`
+	Params   GetSeasonsSeasonIdScoreboardParams
+}
+
+type GetSeasonsSeasonIdScoreboardResponseObject interface {
+	VisitGetSeasonsSeasonIdScoreboardResponse(w http.ResponseWriter) error
+}
+
+type GetSeasonsSeasonIdScoreboard200JSONResponse ApiResult
+
+func (response GetSeasonsSeasonIdScoreboard200JSONResponse) VisitGetSeasonsSeasonIdScoreboardResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSeasonsSeasonIdUpcomingRequestObject struct {
+	SeasonId int `json:"seasonId"`
+}
+
+type GetSeasonsSeasonIdUpcomingResponseObject interface {
+	VisitGetSeasonsSeasonIdUpcomingResponse(w http.ResponseWriter) error
+}
+
+type GetSeasonsSeasonIdUpcoming200JSONResponse ApiResult
+
+func (response GetSeasonsSeasonIdUpcoming200JSONResponse) VisitGetSeasonsSeasonIdUpcomingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSessionsRequestObject struct {
+	Params DeleteSessionsParams
+}
+
+type DeleteSessionsResponseObject interface {
+	VisitDeleteSessionsResponse(w http.ResponseWriter) error
+}
+
+type DeleteSessions200JSONResponse ApiResult
+
+func (response DeleteSessions200JSONResponse) VisitDeleteSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSessionsRequestObject struct {
+}
+
+type GetSessionsResponseObject interface {
+	VisitGetSessionsResponse(w http.ResponseWriter) error
+}
+
+type GetSessions200JSONResponse ApiResult
+
+func (response GetSessions200JSONResponse) VisitGetSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSessionsRequestObject struct {
+	Body *PostSessionsJSONRequestBody
+}
+
+type PostSessionsResponseObject interface {
+	VisitPostSessionsResponse(w http.ResponseWriter) error
+}
+
+type PostSessions200JSONResponse ApiResult
+
+func (response PostSessions200JSONResponse) VisitPostSessionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSessionsSessionIdRequestObject struct {
+	SessionId string `json:"sessionId"`
+}
+
+type DeleteSessionsSessionIdResponseObject interface {
+	VisitDeleteSessionsSessionIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteSessionsSessionId200JSONResponse ApiResult
+
+func (response DeleteSessionsSessionId200JSONResponse) VisitDeleteSessionsSessionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsHandleSuccessUpgradeRequestObject struct {
+	Body *PostSubscriptionsHandleSuccessUpgradeJSONRequestBody
+}
+
+type PostSubscriptionsHandleSuccessUpgradeResponseObject interface {
+	VisitPostSubscriptionsHandleSuccessUpgradeResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptionsHandleSuccessUpgrade200JSONResponse ApiResult
+
+func (response PostSubscriptionsHandleSuccessUpgrade200JSONResponse) VisitPostSubscriptionsHandleSuccessUpgradeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsInitUpdatePaymentMethodRequestObject struct {
+}
+
+type PostSubscriptionsInitUpdatePaymentMethodResponseObject interface {
+	VisitPostSubscriptionsInitUpdatePaymentMethodResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptionsInitUpdatePaymentMethod200JSONResponse ApiResult
+
+func (response PostSubscriptionsInitUpdatePaymentMethod200JSONResponse) VisitPostSubscriptionsInitUpdatePaymentMethodResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSubscriptionsUpgradeUserSubscriptionRequestObject struct {
+}
+
+type PostSubscriptionsUpgradeUserSubscriptionResponseObject interface {
+	VisitPostSubscriptionsUpgradeUserSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type PostSubscriptionsUpgradeUserSubscription200JSONResponse ApiResult
+
+func (response PostSubscriptionsUpgradeUserSubscription200JSONResponse) VisitPostSubscriptionsUpgradeUserSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostSupportMessagesRequestObject struct {
+	Body *PostSupportMessagesJSONRequestBody
+}
+
+type PostSupportMessagesResponseObject interface {
+	VisitPostSupportMessagesResponse(w http.ResponseWriter) error
+}
+
+type PostSupportMessages200JSONResponse ApiResult
+
+func (response PostSupportMessages200JSONResponse) VisitPostSupportMessagesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSendResetPasswordLinkRequestObject struct {
+	Body *PostUsersSendResetPasswordLinkJSONRequestBody
+}
+
+type PostUsersSendResetPasswordLinkResponseObject interface {
+	VisitPostUsersSendResetPasswordLinkResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSendResetPasswordLink200JSONResponse ApiResult
+
+func (response PostUsersSendResetPasswordLink200JSONResponse) VisitPostUsersSendResetPasswordLinkResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSendVerificationEmailRequestObject struct {
+	Body *PostUsersSendVerificationEmailJSONRequestBody
+}
+
+type PostUsersSendVerificationEmailResponseObject interface {
+	VisitPostUsersSendVerificationEmailResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSendVerificationEmail200JSONResponse ApiResult
+
+func (response PostUsersSendVerificationEmail200JSONResponse) VisitPostUsersSendVerificationEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSignUpUserRequestObject struct {
+	Body *PostUsersSignUpUserJSONRequestBody
+}
+
+type PostUsersSignUpUserResponseObject interface {
+	VisitPostUsersSignUpUserResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSignUpUser200JSONResponse ApiResult
+
+func (response PostUsersSignUpUser200JSONResponse) VisitPostUsersSignUpUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUpdateUserPasswordRequestObject struct {
+	Body *PostUsersUpdateUserPasswordJSONRequestBody
+}
+
+type PostUsersUpdateUserPasswordResponseObject interface {
+	VisitPostUsersUpdateUserPasswordResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUpdateUserPassword200JSONResponse ApiResult
+
+func (response PostUsersUpdateUserPassword200JSONResponse) VisitPostUsersUpdateUserPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersVerifyMagicLinkTokenRequestObject struct {
+	Body *PostUsersVerifyMagicLinkTokenJSONRequestBody
+}
+
+type PostUsersVerifyMagicLinkTokenResponseObject interface {
+	VisitPostUsersVerifyMagicLinkTokenResponse(w http.ResponseWriter) error
+}
+
+type PostUsersVerifyMagicLinkToken200JSONResponse ApiResult
+
+func (response PostUsersVerifyMagicLinkToken200JSONResponse) VisitPostUsersVerifyMagicLinkTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdRequestObject struct {
+	UserId int `json:"userId"`
+}
+
+type DeleteUsersUserIdResponseObject interface {
+	VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersUserId200JSONResponse ApiResult
+
+func (response DeleteUsersUserId200JSONResponse) VisitDeleteUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdAppsettingsRequestObject struct {
+	UserId int `json:"userId"`
+}
+
+type GetUsersUserIdAppsettingsResponseObject interface {
+	VisitGetUsersUserIdAppsettingsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdAppsettings200JSONResponse ApiResult
+
+func (response GetUsersUserIdAppsettings200JSONResponse) VisitGetUsersUserIdAppsettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdAppsettingsRequestObject struct {
+	UserId int `json:"userId"`
+	Body   *PostUsersUserIdAppsettingsJSONRequestBody
+}
+
+type PostUsersUserIdAppsettingsResponseObject interface {
+	VisitPostUsersUserIdAppsettingsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdAppsettings200JSONResponse ApiResult
+
+func (response PostUsersUserIdAppsettings200JSONResponse) VisitPostUsersUserIdAppsettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdCustomMatchColumnsRequestObject struct {
+	UserId int `json:"userId"`
+}
+
+type GetUsersUserIdCustomMatchColumnsResponseObject interface {
+	VisitGetUsersUserIdCustomMatchColumnsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdCustomMatchColumns200JSONResponse ApiResult
+
+func (response GetUsersUserIdCustomMatchColumns200JSONResponse) VisitGetUsersUserIdCustomMatchColumnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdCustomMatchColumnsRequestObject struct {
+	UserId int `json:"userId"`
+	Body   *PostUsersUserIdCustomMatchColumnsJSONRequestBody
+}
+
+type PostUsersUserIdCustomMatchColumnsResponseObject interface {
+	VisitPostUsersUserIdCustomMatchColumnsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdCustomMatchColumns200JSONResponse ApiResult
+
+func (response PostUsersUserIdCustomMatchColumns200JSONResponse) VisitPostUsersUserIdCustomMatchColumnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdCustomMatchColumnsColumnIdRequestObject struct {
+	UserId   int `json:"userId"`
+	ColumnId int `json:"columnId"`
+}
+
+type DeleteUsersUserIdCustomMatchColumnsColumnIdResponseObject interface {
+	VisitDeleteUsersUserIdCustomMatchColumnsColumnIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersUserIdCustomMatchColumnsColumnId200JSONResponse ApiResult
+
+func (response DeleteUsersUserIdCustomMatchColumnsColumnId200JSONResponse) VisitDeleteUsersUserIdCustomMatchColumnsColumnIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdCustomMatchColumnsColumnIdRequestObject struct {
+	UserId   int `json:"userId"`
+	ColumnId int `json:"columnId"`
+	Body     *PutUsersUserIdCustomMatchColumnsColumnIdJSONRequestBody
+}
+
+type PutUsersUserIdCustomMatchColumnsColumnIdResponseObject interface {
+	VisitPutUsersUserIdCustomMatchColumnsColumnIdResponse(w http.ResponseWriter) error
+}
+
+type PutUsersUserIdCustomMatchColumnsColumnId200JSONResponse ApiResult
+
+func (response PutUsersUserIdCustomMatchColumnsColumnId200JSONResponse) VisitPutUsersUserIdCustomMatchColumnsColumnIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdCustomPlayerColumnsRequestObject struct {
+	UserId int `json:"userId"`
+}
+
+type GetUsersUserIdCustomPlayerColumnsResponseObject interface {
+	VisitGetUsersUserIdCustomPlayerColumnsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdCustomPlayerColumns200JSONResponse ApiResult
+
+func (response GetUsersUserIdCustomPlayerColumns200JSONResponse) VisitGetUsersUserIdCustomPlayerColumnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdCustomPlayerColumnsRequestObject struct {
+	UserId int `json:"userId"`
+	Body   *PostUsersUserIdCustomPlayerColumnsJSONRequestBody
+}
+
+type PostUsersUserIdCustomPlayerColumnsResponseObject interface {
+	VisitPostUsersUserIdCustomPlayerColumnsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdCustomPlayerColumns200JSONResponse ApiResult
+
+func (response PostUsersUserIdCustomPlayerColumns200JSONResponse) VisitPostUsersUserIdCustomPlayerColumnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdCustomPlayerColumnsRequestObject struct {
+	UserId int `json:"userId"`
+	Body   *PutUsersUserIdCustomPlayerColumnsJSONRequestBody
+}
+
+type PutUsersUserIdCustomPlayerColumnsResponseObject interface {
+	VisitPutUsersUserIdCustomPlayerColumnsResponse(w http.ResponseWriter) error
+}
+
+type PutUsersUserIdCustomPlayerColumns200JSONResponse ApiResult
+
+func (response PutUsersUserIdCustomPlayerColumns200JSONResponse) VisitPutUsersUserIdCustomPlayerColumnsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdCustomPlayerColumnsColumnIdRequestObject struct {
+	UserId   int `json:"userId"`
+	ColumnId int `json:"columnId"`
+	Params   DeleteUsersUserIdCustomPlayerColumnsColumnIdParams
+}
+
+type DeleteUsersUserIdCustomPlayerColumnsColumnIdResponseObject interface {
+	VisitDeleteUsersUserIdCustomPlayerColumnsColumnIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersUserIdCustomPlayerColumnsColumnId200JSONResponse ApiResult
+
+func (response DeleteUsersUserIdCustomPlayerColumnsColumnId200JSONResponse) VisitDeleteUsersUserIdCustomPlayerColumnsColumnIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutUsersUserIdCustomPlayerColumnsColumnIdRequestObject struct {
+	UserId   int `json:"userId"`
+	ColumnId int `json:"columnId"`
+	Body     *PutUsersUserIdCustomPlayerColumnsColumnIdJSONRequestBody
+}
+
+type PutUsersUserIdCustomPlayerColumnsColumnIdResponseObject interface {
+	VisitPutUsersUserIdCustomPlayerColumnsColumnIdResponse(w http.ResponseWriter) error
+}
+
+type PutUsersUserIdCustomPlayerColumnsColumnId200JSONResponse ApiResult
+
+func (response PutUsersUserIdCustomPlayerColumnsColumnId200JSONResponse) VisitPutUsersUserIdCustomPlayerColumnsColumnIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdResetCurrentUserPasswordRequestObject struct {
+	UserId int `json:"userId"`
+	Body   *PostUsersUserIdResetCurrentUserPasswordJSONRequestBody
+}
+
+type PostUsersUserIdResetCurrentUserPasswordResponseObject interface {
+	VisitPostUsersUserIdResetCurrentUserPasswordResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdResetCurrentUserPassword200JSONResponse ApiResult
+
+func (response PostUsersUserIdResetCurrentUserPassword200JSONResponse) VisitPostUsersUserIdResetCurrentUserPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUsersUserIdSubscriptionRequestObject struct {
+	UserId int `json:"userId"`
+}
+
+type DeleteUsersUserIdSubscriptionResponseObject interface {
+	VisitDeleteUsersUserIdSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type DeleteUsersUserIdSubscription200JSONResponse ApiResult
+
+func (response DeleteUsersUserIdSubscription200JSONResponse) VisitDeleteUsersUserIdSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdSubscriptionRequestObject struct {
+	UserId int `json:"userId"`
+}
+
+type GetUsersUserIdSubscriptionResponseObject interface {
+	VisitGetUsersUserIdSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdSubscription200JSONResponse ApiResult
+
+func (response GetUsersUserIdSubscription200JSONResponse) VisitGetUsersUserIdSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdUsersettingsRequestObject struct {
+	UserId int `json:"userId"`
+}
+
+type GetUsersUserIdUsersettingsResponseObject interface {
+	VisitGetUsersUserIdUsersettingsResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserIdUsersettings200JSONResponse ApiResult
+
+func (response GetUsersUserIdUsersettings200JSONResponse) VisitGetUsersUserIdUsersettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdUsersettingsRequestObject struct {
+	UserId int `json:"userId"`
+	Body   *PostUsersUserIdUsersettingsJSONRequestBody
+}
+
+type PostUsersUserIdUsersettingsResponseObject interface {
+	VisitPostUsersUserIdUsersettingsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdUsersettings200JSONResponse ApiResult
+
+func (response PostUsersUserIdUsersettings200JSONResponse) VisitPostUsersUserIdUsersettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksStripeRequestObject struct {
+	Params PostWebhooksStripeParams
+	Body   io.Reader
+}
+
+type PostWebhooksStripeResponseObject interface {
+	VisitPostWebhooksStripeResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksStripe200JSONResponse ApiResult
+
+func (response PostWebhooksStripe200JSONResponse) VisitPostWebhooksStripeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List the API keys of the current user
+	// (GET /apiKeys)
+	GetApiKeys(ctx context.Context, request GetApiKeysRequestObject) (GetApiKeysResponseObject, error)
+	// Create an API key
+	// (POST /apiKeys)
+	PostApiKeys(ctx context.Context, request PostApiKeysRequestObject) (PostApiKeysResponseObject, error)
+	// Revoke an API key
+	// (DELETE /apiKeys/{keyId})
+	DeleteApiKeysKeyId(ctx context.Context, request DeleteApiKeysKeyIdRequestObject) (DeleteApiKeysKeyIdResponseObject, error)
+	// Get the iCalendar feed of a player
+	// (GET /calendars/{token})
+	GetCalendarsToken(ctx context.Context, request GetCalendarsTokenRequestObject) (GetCalendarsTokenResponseObject, error)
+	// Add a new match
+	// (POST /matches)
+	PostMatches(ctx context.Context, request PostMatchesRequestObject) (PostMatchesResponseObject, error)
+	// Update multiple matches at the same time
+	// (PUT /matches/batches)
+	PutMatchesBatches(ctx context.Context, request PutMatchesBatchesRequestObject) (PutMatchesBatchesResponseObject, error)
+	// Unassign a player from a match
+	// (POST /matches/unassignPlayerFromMatch)
+	PostMatchesUnassignPlayerFromMatch(ctx context.Context, request PostMatchesUnassignPlayerFromMatchRequestObject) (PostMatchesUnassignPlayerFromMatchResponseObject, error)
+	// Delete a match
+	// (DELETE /matches/{matchId})
+	DeleteMatchesMatchId(ctx context.Context, request DeleteMatchesMatchIdRequestObject) (DeleteMatchesMatchIdResponseObject, error)
+	// Save match data
+	// (PUT /matches/{matchId})
+	PutMatchesMatchId(ctx context.Context, request PutMatchesMatchIdRequestObject) (PutMatchesMatchIdResponseObject, error)
+	// Get the match custom columns values of a match
+	// (GET /matches/{matchId}/customColumns)
+	GetMatchesMatchIdCustomColumns(ctx context.Context, request GetMatchesMatchIdCustomColumnsRequestObject) (GetMatchesMatchIdCustomColumnsResponseObject, error)
+	// Save a match custom value
+	// (PUT /matches/{matchId}/customColumns)
+	PutMatchesMatchIdCustomColumns(ctx context.Context, request PutMatchesMatchIdCustomColumnsRequestObject) (PutMatchesMatchIdCustomColumnsResponseObject, error)
+	// Get a list of players
+	// (GET /players)
+	GetPlayers(ctx context.Context, request GetPlayersRequestObject) (GetPlayersResponseObject, error)
+	// Create a pool player
+	// (POST /players)
+	PostPlayers(ctx context.Context, request PostPlayersRequestObject) (PostPlayersResponseObject, error)
+	// Get player ratings computed from match results
+	// (GET /players/ratings)
+	GetPlayersRatings(ctx context.Context, request GetPlayersRatingsRequestObject) (GetPlayersRatingsResponseObject, error)
+	// Delete a player
+	// (DELETE /players/{playerId})
 	DeletePlayersPlayerId(ctx context.Context, request DeletePlayersPlayerIdRequestObject) (DeletePlayersPlayerIdResponseObject, error)
 	// Get a player by ID
 	// (GET /players/{playerId})
@@ -1915,6 +3800,12 @@ type StrictServerInterface interface {
 	// Save player data
 	// (PUT /players/{playerId})
 	PutPlayersPlayerId(ctx context.Context, request PutPlayersPlayerIdRequestObject) (PutPlayersPlayerIdResponseObject, error)
+	// Revoke the calendar feed token of a player
+	// (DELETE /players/{playerId}/calendarFeed)
+	DeletePlayersPlayerIdCalendarFeed(ctx context.Context, request DeletePlayersPlayerIdCalendarFeedRequestObject) (DeletePlayersPlayerIdCalendarFeedResponseObject, error)
+	// Create or rotate the calendar feed token of a player
+	// (POST /players/{playerId}/calendarFeed)
+	PostPlayersPlayerIdCalendarFeed(ctx context.Context, request PostPlayersPlayerIdCalendarFeedRequestObject) (PostPlayersPlayerIdCalendarFeedResponseObject, error)
 	// Get player custom columns values for a player
 	// (GET /players/{playerId}/customColumns)
 	GetPlayersPlayerIdCustomColumns(ctx context.Context, request GetPlayersPlayerIdCustomColumnsRequestObject) (GetPlayersPlayerIdCustomColumnsResponseObject, error)
@@ -1924,6 +3815,18 @@ type StrictServerInterface interface {
 	// Get the schedule for a player
 	// (GET /players/{playerId}/schedule)
 	GetPlayersPlayerIdSchedule(ctx context.Context, request GetPlayersPlayerIdScheduleRequestObject) (GetPlayersPlayerIdScheduleResponseObject, error)
+	// Get the schedule of a season shared by a public link
+	// (GET /public/schedules/{token})
+	GetPublicSchedulesToken(ctx context.Context, request GetPublicSchedulesTokenRequestObject) (GetPublicSchedulesTokenResponseObject, error)
+	// Stream live events of a season shared by a public link
+	// (GET /public/schedules/{token}/events)
+	GetPublicSchedulesTokenEvents(ctx context.Context, request GetPublicSchedulesTokenEventsRequestObject) (GetPublicSchedulesTokenEventsResponseObject, error)
+	// Get the scoreboard of a season shared by a public link
+	// (GET /public/schedules/{token}/scoreboard)
+	GetPublicSchedulesTokenScoreboard(ctx context.Context, request GetPublicSchedulesTokenScoreboardRequestObject) (GetPublicSchedulesTokenScoreboardResponseObject, error)
+	// Get the upcoming matches of a season shared by a public link
+	// (GET /public/schedules/{token}/upcoming)
+	GetPublicSchedulesTokenUpcoming(ctx context.Context, request GetPublicSchedulesTokenUpcomingRequestObject) (GetPublicSchedulesTokenUpcomingResponseObject, error)
 	// Get all seasons (light version)
 	// (GET /seasons)
 	GetSeasons(ctx context.Context, request GetSeasonsRequestObject) (GetSeasonsResponseObject, error)
@@ -1942,18 +3845,57 @@ type StrictServerInterface interface {
 	// Update a season metadata
 	// (PUT /seasons/{seasonId})
 	PutSeasonsSeasonId(ctx context.Context, request PutSeasonsSeasonIdRequestObject) (PutSeasonsSeasonIdResponseObject, error)
-	// Get the public schedule link for a season
-	// (GET /seasons/{seasonId}/publicScheduleLink)
-	GetSeasonsSeasonIdPublicScheduleLink(ctx context.Context, request GetSeasonsSeasonIdPublicScheduleLinkRequestObject) (GetSeasonsSeasonIdPublicScheduleLinkResponseObject, error)
+	// Get the elimination bracket of a season
+	// (GET /seasons/{seasonId}/bracket)
+	GetSeasonsSeasonIdBracket(ctx context.Context, request GetSeasonsSeasonIdBracketRequestObject) (GetSeasonsSeasonIdBracketResponseObject, error)
+	// Create a single- or double-elimination bracket for a season
+	// (POST /seasons/{seasonId}/bracket)
+	PostSeasonsSeasonIdBracket(ctx context.Context, request PostSeasonsSeasonIdBracketRequestObject) (PostSeasonsSeasonIdBracketResponseObject, error)
+	// Stream live events of a season
+	// (GET /seasons/{seasonId}/events)
+	GetSeasonsSeasonIdEvents(ctx context.Context, request GetSeasonsSeasonIdEventsRequestObject) (GetSeasonsSeasonIdEventsResponseObject, error)
+	// List the owner and members of a season
+	// (GET /seasons/{seasonId}/members)
+	GetSeasonsSeasonIdMembers(ctx context.Context, request GetSeasonsSeasonIdMembersRequestObject) (GetSeasonsSeasonIdMembersResponseObject, error)
+	// Invite a co-organizer to a season
+	// (POST /seasons/{seasonId}/members)
+	PostSeasonsSeasonIdMembers(ctx context.Context, request PostSeasonsSeasonIdMembersRequestObject) (PostSeasonsSeasonIdMembersResponseObject, error)
+	// Remove a member from a season, or leave it
+	// (DELETE /seasons/{seasonId}/members/{memberId})
+	DeleteSeasonsSeasonIdMembersMemberId(ctx context.Context, request DeleteSeasonsSeasonIdMembersMemberIdRequestObject) (DeleteSeasonsSeasonIdMembersMemberIdResponseObject, error)
+	// Change the role of a season member
+	// (PUT /seasons/{seasonId}/members/{memberId})
+	PutSeasonsSeasonIdMembersMemberId(ctx context.Context, request PutSeasonsSeasonIdMembersMemberIdRequestObject) (PutSeasonsSeasonIdMembersMemberIdResponseObject, error)
+	// List the public schedule links of a season
+	// (GET /seasons/{seasonId}/publicScheduleLinks)
+	GetSeasonsSeasonIdPublicScheduleLinks(ctx context.Context, request GetSeasonsSeasonIdPublicScheduleLinksRequestObject) (GetSeasonsSeasonIdPublicScheduleLinksResponseObject, error)
+	// Create a public schedule link for a season
+	// (POST /seasons/{seasonId}/publicScheduleLinks)
+	PostSeasonsSeasonIdPublicScheduleLinks(ctx context.Context, request PostSeasonsSeasonIdPublicScheduleLinksRequestObject) (PostSeasonsSeasonIdPublicScheduleLinksResponseObject, error)
+	// Revoke a public schedule link
+	// (DELETE /seasons/{seasonId}/publicScheduleLinks/{linkId})
+	DeleteSeasonsSeasonIdPublicScheduleLinksLinkId(ctx context.Context, request DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdRequestObject) (DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdResponseObject, error)
+	// Generate a round-robin schedule for a season
+	// (POST /seasons/{seasonId}/schedule/generate)
+	PostSeasonsSeasonIdScheduleGenerate(ctx context.Context, request PostSeasonsSeasonIdScheduleGenerateRequestObject) (PostSeasonsSeasonIdScheduleGenerateResponseObject, error)
 	// Get the scoreboard for a season
 	// (GET /seasons/{seasonId}/scoreboard)
 	GetSeasonsSeasonIdScoreboard(ctx context.Context, request GetSeasonsSeasonIdScoreboardRequestObject) (GetSeasonsSeasonIdScoreboardResponseObject, error)
 	// Get upcoming seasons for the user
 	// (GET /seasons/{seasonId}/upcoming)
 	GetSeasonsSeasonIdUpcoming(ctx context.Context, request GetSeasonsSeasonIdUpcomingRequestObject) (GetSeasonsSeasonIdUpcomingResponseObject, error)
+	// Log out the current session, or every session
+	// (DELETE /sessions)
+	DeleteSessions(ctx context.Context, request DeleteSessionsRequestObject) (DeleteSessionsResponseObject, error)
+	// List the active sessions of the current user
+	// (GET /sessions)
+	GetSessions(ctx context.Context, request GetSessionsRequestObject) (GetSessionsResponseObject, error)
 	// Create a new session / log in an existing user
 	// (POST /sessions)
 	PostSessions(ctx context.Context, request PostSessionsRequestObject) (PostSessionsResponseObject, error)
+	// Revoke a session of the current user
+	// (DELETE /sessions/{sessionId})
+	DeleteSessionsSessionId(ctx context.Context, request DeleteSessionsSessionIdRequestObject) (DeleteSessionsSessionIdResponseObject, error)
 	// Handle successful upgrade
 	// (POST /subscriptions/handleSuccessUpgrade)
 	PostSubscriptionsHandleSuccessUpgrade(ctx context.Context, request PostSubscriptionsHandleSuccessUpgradeRequestObject) (PostSubscriptionsHandleSuccessUpgradeResponseObject, error)
@@ -1969,13 +3911,13 @@ type StrictServerInterface interface {
 	// Send a reset password link
 	// (POST /users/sendResetPasswordLink)
 	PostUsersSendResetPasswordLink(ctx context.Context, request PostUsersSendResetPasswordLinkRequestObject) (PostUsersSendResetPasswordLinkResponseObject, error)
-	// Send a verification email
+	// Send a magic link to log in or verify an email
 	// (POST /users/sendVerificationEmail)
 	PostUsersSendVerificationEmail(ctx context.Context, request PostUsersSendVerificationEmailRequestObject) (PostUsersSendVerificationEmailResponseObject, error)
 	// Sign up a new user
 	// (POST /users/signUpUser)
 	PostUsersSignUpUser(ctx context.Context, request PostUsersSignUpUserRequestObject) (PostUsersSignUpUserResponseObject, error)
-	// Update user password
+	// Reset a password with the token of a reset link
 	// (POST /users/updateUserPassword)
 	PostUsersUpdateUserPassword(ctx context.Context, request PostUsersUpdateUserPasswordRequestObject) (PostUsersUpdateUserPasswordResponseObject, error)
 	// Verify a magic link token
@@ -1990,16 +3932,34 @@ type StrictServerInterface interface {
 	// Save app settings
 	// (POST /users/{userId}/appsettings)
 	PostUsersUserIdAppsettings(ctx context.Context, request PostUsersUserIdAppsettingsRequestObject) (PostUsersUserIdAppsettingsResponseObject, error)
+	// Get all match custom columns
+	// (GET /users/{userId}/customMatchColumns)
+	GetUsersUserIdCustomMatchColumns(ctx context.Context, request GetUsersUserIdCustomMatchColumnsRequestObject) (GetUsersUserIdCustomMatchColumnsResponseObject, error)
+	// Create a match custom column for the user
+	// (POST /users/{userId}/customMatchColumns)
+	PostUsersUserIdCustomMatchColumns(ctx context.Context, request PostUsersUserIdCustomMatchColumnsRequestObject) (PostUsersUserIdCustomMatchColumnsResponseObject, error)
+	// Delete a match custom column and its values
+	// (DELETE /users/{userId}/customMatchColumns/{columnId})
+	DeleteUsersUserIdCustomMatchColumnsColumnId(ctx context.Context, request DeleteUsersUserIdCustomMatchColumnsColumnIdRequestObject) (DeleteUsersUserIdCustomMatchColumnsColumnIdResponseObject, error)
+	// Update a match custom column
+	// (PUT /users/{userId}/customMatchColumns/{columnId})
+	PutUsersUserIdCustomMatchColumnsColumnId(ctx context.Context, request PutUsersUserIdCustomMatchColumnsColumnIdRequestObject) (PutUsersUserIdCustomMatchColumnsColumnIdResponseObject, error)
 	// Get all player custom columns
 	// (GET /users/{userId}/customPlayerColumns)
 	GetUsersUserIdCustomPlayerColumns(ctx context.Context, request GetUsersUserIdCustomPlayerColumnsRequestObject) (GetUsersUserIdCustomPlayerColumnsResponseObject, error)
 	// Create a player custom column for the user
 	// (POST /users/{userId}/customPlayerColumns)
 	PostUsersUserIdCustomPlayerColumns(ctx context.Context, request PostUsersUserIdCustomPlayerColumnsRequestObject) (PostUsersUserIdCustomPlayerColumnsResponseObject, error)
-	// Delete a player custom column
+	// Reorder the player custom columns of the user
+	// (PUT /users/{userId}/customPlayerColumns)
+	PutUsersUserIdCustomPlayerColumns(ctx context.Context, request PutUsersUserIdCustomPlayerColumnsRequestObject) (PutUsersUserIdCustomPlayerColumnsResponseObject, error)
+	// Delete a player custom column and its values
 	// (DELETE /users/{userId}/customPlayerColumns/{columnId})
 	DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx context.Context, request DeleteUsersUserIdCustomPlayerColumnsColumnIdRequestObject) (DeleteUsersUserIdCustomPlayerColumnsColumnIdResponseObject, error)
-	// Reset the current user's password
+	// Update a player custom column
+	// (PUT /users/{userId}/customPlayerColumns/{columnId})
+	PutUsersUserIdCustomPlayerColumnsColumnId(ctx context.Context, request PutUsersUserIdCustomPlayerColumnsColumnIdRequestObject) (PutUsersUserIdCustomPlayerColumnsColumnIdResponseObject, error)
+	// Change the current user's password
 	// (POST /users/{userId}/resetCurrentUserPassword)
 	PostUsersUserIdResetCurrentUserPassword(ctx context.Context, request PostUsersUserIdResetCurrentUserPasswordRequestObject) (PostUsersUserIdResetCurrentUserPasswordResponseObject, error)
 	// Cancel user subscription
@@ -2014,560 +3974,1182 @@ type StrictServerInterface interface {
 	// Save user settings
 	// (POST /users/{userId}/usersettings)
 	PostUsersUserIdUsersettings(ctx context.Context, request PostUsersUserIdUsersettingsRequestObject) (PostUsersUserIdUsersettingsResponseObject, error)
+	// Receive a Stripe webhook event
+	// (POST /webhooks/stripe)
+	PostWebhooksStripe(ctx context.Context, request PostWebhooksStripeRequestObject) (PostWebhooksStripeResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetApiKeys operation middleware
+func (sh *strictHandler) GetApiKeys(ctx echo.Context) error {
+	var request GetApiKeysRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiKeys(ctx.Request().Context(), request.(GetApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiKeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetApiKeysResponseObject); ok {
+		return validResponse.VisitGetApiKeysResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostApiKeys operation middleware
+func (sh *strictHandler) PostApiKeys(ctx echo.Context) error {
+	var request PostApiKeysRequestObject
+
+	var body PostApiKeysJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiKeys(ctx.Request().Context(), request.(PostApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiKeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostApiKeysResponseObject); ok {
+		return validResponse.VisitPostApiKeysResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteApiKeysKeyId operation middleware
+func (sh *strictHandler) DeleteApiKeysKeyId(ctx echo.Context, keyId int) error {
+	var request DeleteApiKeysKeyIdRequestObject
+
+	request.KeyId = keyId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiKeysKeyId(ctx.Request().Context(), request.(DeleteApiKeysKeyIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiKeysKeyId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteApiKeysKeyIdResponseObject); ok {
+		return validResponse.VisitDeleteApiKeysKeyIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetCalendarsToken operation middleware
+func (sh *strictHandler) GetCalendarsToken(ctx echo.Context, token string) error {
+	var request GetCalendarsTokenRequestObject
+
+	request.Token = token
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCalendarsToken(ctx.Request().Context(), request.(GetCalendarsTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCalendarsToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetCalendarsTokenResponseObject); ok {
+		return validResponse.VisitGetCalendarsTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostMatches operation middleware
+func (sh *strictHandler) PostMatches(ctx echo.Context) error {
+	var request PostMatchesRequestObject
+
+	var body PostMatchesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMatches(ctx.Request().Context(), request.(PostMatchesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMatches")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostMatchesResponseObject); ok {
+		return validResponse.VisitPostMatchesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutMatchesBatches operation middleware
+func (sh *strictHandler) PutMatchesBatches(ctx echo.Context, params PutMatchesBatchesParams) error {
+	var request PutMatchesBatchesRequestObject
+
+	request.Params = params
+
+	var body PutMatchesBatchesJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutMatchesBatches(ctx.Request().Context(), request.(PutMatchesBatchesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutMatchesBatches")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutMatchesBatchesResponseObject); ok {
+		return validResponse.VisitPutMatchesBatchesResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostMatchesUnassignPlayerFromMatch operation middleware
+func (sh *strictHandler) PostMatchesUnassignPlayerFromMatch(ctx echo.Context) error {
+	var request PostMatchesUnassignPlayerFromMatchRequestObject
+
+	var body PostMatchesUnassignPlayerFromMatchJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMatchesUnassignPlayerFromMatch(ctx.Request().Context(), request.(PostMatchesUnassignPlayerFromMatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMatchesUnassignPlayerFromMatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostMatchesUnassignPlayerFromMatchResponseObject); ok {
+		return validResponse.VisitPostMatchesUnassignPlayerFromMatchResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteMatchesMatchId operation middleware
+func (sh *strictHandler) DeleteMatchesMatchId(ctx echo.Context, matchId int) error {
+	var request DeleteMatchesMatchIdRequestObject
+
+	request.MatchId = matchId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteMatchesMatchId(ctx.Request().Context(), request.(DeleteMatchesMatchIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteMatchesMatchId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteMatchesMatchIdResponseObject); ok {
+		return validResponse.VisitDeleteMatchesMatchIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutMatchesMatchId operation middleware
+func (sh *strictHandler) PutMatchesMatchId(ctx echo.Context, matchId int) error {
+	var request PutMatchesMatchIdRequestObject
+
+	request.MatchId = matchId
+
+	var body PutMatchesMatchIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutMatchesMatchId(ctx.Request().Context(), request.(PutMatchesMatchIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutMatchesMatchId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutMatchesMatchIdResponseObject); ok {
+		return validResponse.VisitPutMatchesMatchIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetMatchesMatchIdCustomColumns operation middleware
+func (sh *strictHandler) GetMatchesMatchIdCustomColumns(ctx echo.Context, matchId int) error {
+	var request GetMatchesMatchIdCustomColumnsRequestObject
+
+	request.MatchId = matchId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMatchesMatchIdCustomColumns(ctx.Request().Context(), request.(GetMatchesMatchIdCustomColumnsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMatchesMatchIdCustomColumns")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetMatchesMatchIdCustomColumnsResponseObject); ok {
+		return validResponse.VisitGetMatchesMatchIdCustomColumnsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+// PutMatchesMatchIdCustomColumns operation middleware
+func (sh *strictHandler) PutMatchesMatchIdCustomColumns(ctx echo.Context, matchId int) error {
+	var request PutMatchesMatchIdCustomColumnsRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
+	request.MatchId = matchId
+
+	var body PutMatchesMatchIdCustomColumnsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutMatchesMatchIdCustomColumns(ctx.Request().Context(), request.(PutMatchesMatchIdCustomColumnsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutMatchesMatchIdCustomColumns")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutMatchesMatchIdCustomColumnsResponseObject); ok {
+		return validResponse.VisitPutMatchesMatchIdCustomColumnsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+// GetPlayers operation middleware
+func (sh *strictHandler) GetPlayers(ctx echo.Context, params GetPlayersParams) error {
+	var request GetPlayersRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlayers(ctx.Request().Context(), request.(GetPlayersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlayers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPlayersResponseObject); ok {
+		return validResponse.VisitGetPlayersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// PostMatches operation middleware
-func (sh *strictHandler) PostMatches(ctx echo.Context) error {
-	var request PostMatchesRequestObject
+// PostPlayers operation middleware
+func (sh *strictHandler) PostPlayers(ctx echo.Context) error {
+	var request PostPlayersRequestObject
+
+	var body PostPlayersJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPlayers(ctx.Request().Context(), request.(PostPlayersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPlayers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPlayersResponseObject); ok {
+		return validResponse.VisitPostPlayersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPlayersRatings operation middleware
+func (sh *strictHandler) GetPlayersRatings(ctx echo.Context, params GetPlayersRatingsParams) error {
+	var request GetPlayersRatingsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlayersRatings(ctx.Request().Context(), request.(GetPlayersRatingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlayersRatings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPlayersRatingsResponseObject); ok {
+		return validResponse.VisitGetPlayersRatingsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeletePlayersPlayerId operation middleware
+func (sh *strictHandler) DeletePlayersPlayerId(ctx echo.Context, playerId int) error {
+	var request DeletePlayersPlayerIdRequestObject
+
+	request.PlayerId = playerId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePlayersPlayerId(ctx.Request().Context(), request.(DeletePlayersPlayerIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePlayersPlayerId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeletePlayersPlayerIdResponseObject); ok {
+		return validResponse.VisitDeletePlayersPlayerIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPlayersPlayerId operation middleware
+func (sh *strictHandler) GetPlayersPlayerId(ctx echo.Context, playerId int) error {
+	var request GetPlayersPlayerIdRequestObject
+
+	request.PlayerId = playerId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlayersPlayerId(ctx.Request().Context(), request.(GetPlayersPlayerIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlayersPlayerId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPlayersPlayerIdResponseObject); ok {
+		return validResponse.VisitGetPlayersPlayerIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutPlayersPlayerId operation middleware
+func (sh *strictHandler) PutPlayersPlayerId(ctx echo.Context, playerId int) error {
+	var request PutPlayersPlayerIdRequestObject
+
+	request.PlayerId = playerId
+
+	var body PutPlayersPlayerIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutPlayersPlayerId(ctx.Request().Context(), request.(PutPlayersPlayerIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutPlayersPlayerId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutPlayersPlayerIdResponseObject); ok {
+		return validResponse.VisitPutPlayersPlayerIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeletePlayersPlayerIdCalendarFeed operation middleware
+func (sh *strictHandler) DeletePlayersPlayerIdCalendarFeed(ctx echo.Context, playerId int) error {
+	var request DeletePlayersPlayerIdCalendarFeedRequestObject
+
+	request.PlayerId = playerId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePlayersPlayerIdCalendarFeed(ctx.Request().Context(), request.(DeletePlayersPlayerIdCalendarFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePlayersPlayerIdCalendarFeed")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeletePlayersPlayerIdCalendarFeedResponseObject); ok {
+		return validResponse.VisitDeletePlayersPlayerIdCalendarFeedResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPlayersPlayerIdCalendarFeed operation middleware
+func (sh *strictHandler) PostPlayersPlayerIdCalendarFeed(ctx echo.Context, playerId int) error {
+	var request PostPlayersPlayerIdCalendarFeedRequestObject
+
+	request.PlayerId = playerId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPlayersPlayerIdCalendarFeed(ctx.Request().Context(), request.(PostPlayersPlayerIdCalendarFeedRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPlayersPlayerIdCalendarFeed")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPlayersPlayerIdCalendarFeedResponseObject); ok {
+		return validResponse.VisitPostPlayersPlayerIdCalendarFeedResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPlayersPlayerIdCustomColumns operation middleware
+func (sh *strictHandler) GetPlayersPlayerIdCustomColumns(ctx echo.Context, playerId int) error {
+	var request GetPlayersPlayerIdCustomColumnsRequestObject
+
+	request.PlayerId = playerId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPlayersPlayerIdCustomColumns(ctx.Request().Context(), request.(GetPlayersPlayerIdCustomColumnsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPlayersPlayerIdCustomColumns")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPlayersPlayerIdCustomColumnsResponseObject); ok {
+		return validResponse.VisitGetPlayersPlayerIdCustomColumnsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutPlayersPlayerIdCustomColumns operation middleware
+func (sh *strictHandler) PutPlayersPlayerIdCustomColumns(ctx echo.Context, playerId int) error {
+	var request PutPlayersPlayerIdCustomColumnsRequestObject
+
+	request.PlayerId = playerId
 
-	var body PostMatchesJSONRequestBody
+	var body PutPlayersPlayerIdCustomColumnsJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostMatches(ctx.Request().Context(), request.(PostMatchesRequestObject))
+		return sh.ssi.PutPlayersPlayerIdCustomColumns(ctx.Request().Context(), request.(PutPlayersPlayerIdCustomColumnsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostMatches")
+		handler = middleware(handler, "PutPlayersPlayerIdCustomColumns")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostMatchesResponseObject); ok {
-		return validResponse.VisitPostMatchesResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutPlayersPlayerIdCustomColumnsResponseObject); ok {
+		return validResponse.VisitPutPlayersPlayerIdCustomColumnsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PutMatchesBatches operation middleware
-func (sh *strictHandler) PutMatchesBatches(ctx echo.Context) error {
-	var request PutMatchesBatchesRequestObject
+// GetPlayersPlayerIdSchedule operation middleware
+func (sh *strictHandler) GetPlayersPlayerIdSchedule(ctx echo.Context, playerId int) error {
+	var request GetPlayersPlayerIdScheduleRequestObject
 
-	var body PutMatchesBatchesJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.PlayerId = playerId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutMatchesBatches(ctx.Request().Context(), request.(PutMatchesBatchesRequestObject))
+		return sh.ssi.GetPlayersPlayerIdSchedule(ctx.Request().Context(), request.(GetPlayersPlayerIdScheduleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutMatchesBatches")
+		handler = middleware(handler, "GetPlayersPlayerIdSchedule")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PutMatchesBatchesResponseObject); ok {
-		return validResponse.VisitPutMatchesBatchesResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPlayersPlayerIdScheduleResponseObject); ok {
+		return validResponse.VisitGetPlayersPlayerIdScheduleResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostMatchesUnassignPlayerFromMatch operation middleware
-func (sh *strictHandler) PostMatchesUnassignPlayerFromMatch(ctx echo.Context) error {
-	var request PostMatchesUnassignPlayerFromMatchRequestObject
+// GetPublicSchedulesToken operation middleware
+func (sh *strictHandler) GetPublicSchedulesToken(ctx echo.Context, token string) error {
+	var request GetPublicSchedulesTokenRequestObject
 
-	var body PostMatchesUnassignPlayerFromMatchJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Token = token
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostMatchesUnassignPlayerFromMatch(ctx.Request().Context(), request.(PostMatchesUnassignPlayerFromMatchRequestObject))
+		return sh.ssi.GetPublicSchedulesToken(ctx.Request().Context(), request.(GetPublicSchedulesTokenRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostMatchesUnassignPlayerFromMatch")
+		handler = middleware(handler, "GetPublicSchedulesToken")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostMatchesUnassignPlayerFromMatchResponseObject); ok {
-		return validResponse.VisitPostMatchesUnassignPlayerFromMatchResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPublicSchedulesTokenResponseObject); ok {
+		return validResponse.VisitGetPublicSchedulesTokenResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteMatchesMatchId operation middleware
-func (sh *strictHandler) DeleteMatchesMatchId(ctx echo.Context, matchId int) error {
-	var request DeleteMatchesMatchIdRequestObject
+// GetPublicSchedulesTokenEvents operation middleware
+func (sh *strictHandler) GetPublicSchedulesTokenEvents(ctx echo.Context, token string) error {
+	var request GetPublicSchedulesTokenEventsRequestObject
 
-	request.MatchId = matchId
+	request.Token = token
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteMatchesMatchId(ctx.Request().Context(), request.(DeleteMatchesMatchIdRequestObject))
+		return sh.ssi.GetPublicSchedulesTokenEvents(ctx.Request().Context(), request.(GetPublicSchedulesTokenEventsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteMatchesMatchId")
+		handler = middleware(handler, "GetPublicSchedulesTokenEvents")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteMatchesMatchIdResponseObject); ok {
-		return validResponse.VisitDeleteMatchesMatchIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPublicSchedulesTokenEventsResponseObject); ok {
+		return validResponse.VisitGetPublicSchedulesTokenEventsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PutMatchesMatchId operation middleware
-func (sh *strictHandler) PutMatchesMatchId(ctx echo.Context, matchId int) error {
-	var request PutMatchesMatchIdRequestObject
+// GetPublicSchedulesTokenScoreboard operation middleware
+func (sh *strictHandler) GetPublicSchedulesTokenScoreboard(ctx echo.Context, token string, params GetPublicSchedulesTokenScoreboardParams) error {
+	var request GetPublicSchedulesTokenScoreboardRequestObject
 
-	request.MatchId = matchId
+	request.Token = token
+	request.Params = params
 
-	var body PutMatchesMatchIdJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPublicSchedulesTokenScoreboard(ctx.Request().Context(), request.(GetPublicSchedulesTokenScoreboardRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPublicSchedulesTokenScoreboard")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		return err
+	} else if validResponse, ok := response.(GetPublicSchedulesTokenScoreboardResponseObject); ok {
+		return validResponse.VisitGetPublicSchedulesTokenScoreboardResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
 	}
-	request.Body = &body
+	return nil
+}
+
+// GetPublicSchedulesTokenUpcoming operation middleware
+func (sh *strictHandler) GetPublicSchedulesTokenUpcoming(ctx echo.Context, token string) error {
+	var request GetPublicSchedulesTokenUpcomingRequestObject
+
+	request.Token = token
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutMatchesMatchId(ctx.Request().Context(), request.(PutMatchesMatchIdRequestObject))
+		return sh.ssi.GetPublicSchedulesTokenUpcoming(ctx.Request().Context(), request.(GetPublicSchedulesTokenUpcomingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutMatchesMatchId")
+		handler = middleware(handler, "GetPublicSchedulesTokenUpcoming")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PutMatchesMatchIdResponseObject); ok {
-		return validResponse.VisitPutMatchesMatchIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetPublicSchedulesTokenUpcomingResponseObject); ok {
+		return validResponse.VisitGetPublicSchedulesTokenUpcomingResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetPlayers operation middleware
-func (sh *strictHandler) GetPlayers(ctx echo.Context, params GetPlayersParams) error {
-	var request GetPlayersRequestObject
-
-	request.Params = params
+// GetSeasons operation middleware
+func (sh *strictHandler) GetSeasons(ctx echo.Context) error {
+	var request GetSeasonsRequestObject
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPlayers(ctx.Request().Context(), request.(GetPlayersRequestObject))
+		return sh.ssi.GetSeasons(ctx.Request().Context(), request.(GetSeasonsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPlayers")
+		handler = middleware(handler, "GetSeasons")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetPlayersResponseObject); ok {
-		return validResponse.VisitGetPlayersResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetSeasonsResponseObject); ok {
+		return validResponse.VisitGetSeasonsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostPlayers operation middleware
-func (sh *strictHandler) PostPlayers(ctx echo.Context) error {
-	var request PostPlayersRequestObject
+// PostSeasons operation middleware
+func (sh *strictHandler) PostSeasons(ctx echo.Context) error {
+	var request PostSeasonsRequestObject
 
-	var body PostPlayersJSONRequestBody
+	var body PostSeasonsJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPlayers(ctx.Request().Context(), request.(PostPlayersRequestObject))
+		return sh.ssi.PostSeasons(ctx.Request().Context(), request.(PostSeasonsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPlayers")
+		handler = middleware(handler, "PostSeasons")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostPlayersResponseObject); ok {
-		return validResponse.VisitPostPlayersResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostSeasonsResponseObject); ok {
+		return validResponse.VisitPostSeasonsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeletePlayersPlayerId operation middleware
-func (sh *strictHandler) DeletePlayersPlayerId(ctx echo.Context, playerId int) error {
-	var request DeletePlayersPlayerIdRequestObject
+// GetSeasonsTotalAmount operation middleware
+func (sh *strictHandler) GetSeasonsTotalAmount(ctx echo.Context) error {
+	var request GetSeasonsTotalAmountRequestObject
 
-	request.PlayerId = playerId
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSeasonsTotalAmount(ctx.Request().Context(), request.(GetSeasonsTotalAmountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSeasonsTotalAmount")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSeasonsTotalAmountResponseObject); ok {
+		return validResponse.VisitGetSeasonsTotalAmountResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteSeasonsSeasonId operation middleware
+func (sh *strictHandler) DeleteSeasonsSeasonId(ctx echo.Context, seasonId int) error {
+	var request DeleteSeasonsSeasonIdRequestObject
+
+	request.SeasonId = seasonId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeletePlayersPlayerId(ctx.Request().Context(), request.(DeletePlayersPlayerIdRequestObject))
+		return sh.ssi.DeleteSeasonsSeasonId(ctx.Request().Context(), request.(DeleteSeasonsSeasonIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeletePlayersPlayerId")
+		handler = middleware(handler, "DeleteSeasonsSeasonId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeletePlayersPlayerIdResponseObject); ok {
-		return validResponse.VisitDeletePlayersPlayerIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteSeasonsSeasonIdResponseObject); ok {
+		return validResponse.VisitDeleteSeasonsSeasonIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetPlayersPlayerId operation middleware
-func (sh *strictHandler) GetPlayersPlayerId(ctx echo.Context, playerId int) error {
-	var request GetPlayersPlayerIdRequestObject
+// GetSeasonsSeasonId operation middleware
+func (sh *strictHandler) GetSeasonsSeasonId(ctx echo.Context, seasonId int) error {
+	var request GetSeasonsSeasonIdRequestObject
 
-	request.PlayerId = playerId
+	request.SeasonId = seasonId
+
+	var body GetSeasonsSeasonIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPlayersPlayerId(ctx.Request().Context(), request.(GetPlayersPlayerIdRequestObject))
+		return sh.ssi.GetSeasonsSeasonId(ctx.Request().Context(), request.(GetSeasonsSeasonIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPlayersPlayerId")
+		handler = middleware(handler, "GetSeasonsSeasonId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetPlayersPlayerIdResponseObject); ok {
-		return validResponse.VisitGetPlayersPlayerIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetSeasonsSeasonIdResponseObject); ok {
+		return validResponse.VisitGetSeasonsSeasonIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PutPlayersPlayerId operation middleware
-func (sh *strictHandler) PutPlayersPlayerId(ctx echo.Context, playerId int) error {
-	var request PutPlayersPlayerIdRequestObject
+// PutSeasonsSeasonId operation middleware
+func (sh *strictHandler) PutSeasonsSeasonId(ctx echo.Context, seasonId int) error {
+	var request PutSeasonsSeasonIdRequestObject
 
-	request.PlayerId = playerId
+	request.SeasonId = seasonId
 
-	var body PutPlayersPlayerIdJSONRequestBody
+	var body PutSeasonsSeasonIdJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutPlayersPlayerId(ctx.Request().Context(), request.(PutPlayersPlayerIdRequestObject))
+		return sh.ssi.PutSeasonsSeasonId(ctx.Request().Context(), request.(PutSeasonsSeasonIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutPlayersPlayerId")
+		handler = middleware(handler, "PutSeasonsSeasonId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PutPlayersPlayerIdResponseObject); ok {
-		return validResponse.VisitPutPlayersPlayerIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutSeasonsSeasonIdResponseObject); ok {
+		return validResponse.VisitPutSeasonsSeasonIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetPlayersPlayerIdCustomColumns operation middleware
-func (sh *strictHandler) GetPlayersPlayerIdCustomColumns(ctx echo.Context, playerId int) error {
-	var request GetPlayersPlayerIdCustomColumnsRequestObject
+// GetSeasonsSeasonIdBracket operation middleware
+func (sh *strictHandler) GetSeasonsSeasonIdBracket(ctx echo.Context, seasonId int) error {
+	var request GetSeasonsSeasonIdBracketRequestObject
 
-	request.PlayerId = playerId
+	request.SeasonId = seasonId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPlayersPlayerIdCustomColumns(ctx.Request().Context(), request.(GetPlayersPlayerIdCustomColumnsRequestObject))
+		return sh.ssi.GetSeasonsSeasonIdBracket(ctx.Request().Context(), request.(GetSeasonsSeasonIdBracketRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPlayersPlayerIdCustomColumns")
+		handler = middleware(handler, "GetSeasonsSeasonIdBracket")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetPlayersPlayerIdCustomColumnsResponseObject); ok {
-		return validResponse.VisitGetPlayersPlayerIdCustomColumnsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetSeasonsSeasonIdBracketResponseObject); ok {
+		return validResponse.VisitGetSeasonsSeasonIdBracketResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PutPlayersPlayerIdCustomColumns operation middleware
-func (sh *strictHandler) PutPlayersPlayerIdCustomColumns(ctx echo.Context, playerId int) error {
-	var request PutPlayersPlayerIdCustomColumnsRequestObject
+// PostSeasonsSeasonIdBracket operation middleware
+func (sh *strictHandler) PostSeasonsSeasonIdBracket(ctx echo.Context, seasonId int) error {
+	var request PostSeasonsSeasonIdBracketRequestObject
 
-	request.PlayerId = playerId
+	request.SeasonId = seasonId
 
-	var body PutPlayersPlayerIdCustomColumnsJSONRequestBody
+	var body PostSeasonsSeasonIdBracketJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutPlayersPlayerIdCustomColumns(ctx.Request().Context(), request.(PutPlayersPlayerIdCustomColumnsRequestObject))
+		return sh.ssi.PostSeasonsSeasonIdBracket(ctx.Request().Context(), request.(PostSeasonsSeasonIdBracketRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutPlayersPlayerIdCustomColumns")
+		handler = middleware(handler, "PostSeasonsSeasonIdBracket")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PutPlayersPlayerIdCustomColumnsResponseObject); ok {
-		return validResponse.VisitPutPlayersPlayerIdCustomColumnsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostSeasonsSeasonIdBracketResponseObject); ok {
+		return validResponse.VisitPostSeasonsSeasonIdBracketResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetPlayersPlayerIdSchedule operation middleware
-func (sh *strictHandler) GetPlayersPlayerIdSchedule(ctx echo.Context, playerId int) error {
-	var request GetPlayersPlayerIdScheduleRequestObject
+// GetSeasonsSeasonIdEvents operation middleware
+func (sh *strictHandler) GetSeasonsSeasonIdEvents(ctx echo.Context, seasonId int) error {
+	var request GetSeasonsSeasonIdEventsRequestObject
 
-	request.PlayerId = playerId
+	request.SeasonId = seasonId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPlayersPlayerIdSchedule(ctx.Request().Context(), request.(GetPlayersPlayerIdScheduleRequestObject))
+		return sh.ssi.GetSeasonsSeasonIdEvents(ctx.Request().Context(), request.(GetSeasonsSeasonIdEventsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPlayersPlayerIdSchedule")
+		handler = middleware(handler, "GetSeasonsSeasonIdEvents")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetPlayersPlayerIdScheduleResponseObject); ok {
-		return validResponse.VisitGetPlayersPlayerIdScheduleResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetSeasonsSeasonIdEventsResponseObject); ok {
+		return validResponse.VisitGetSeasonsSeasonIdEventsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetSeasons operation middleware
-func (sh *strictHandler) GetSeasons(ctx echo.Context) error {
-	var request GetSeasonsRequestObject
+// GetSeasonsSeasonIdMembers operation middleware
+func (sh *strictHandler) GetSeasonsSeasonIdMembers(ctx echo.Context, seasonId int) error {
+	var request GetSeasonsSeasonIdMembersRequestObject
+
+	request.SeasonId = seasonId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSeasons(ctx.Request().Context(), request.(GetSeasonsRequestObject))
+		return sh.ssi.GetSeasonsSeasonIdMembers(ctx.Request().Context(), request.(GetSeasonsSeasonIdMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSeasons")
+		handler = middleware(handler, "GetSeasonsSeasonIdMembers")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetSeasonsResponseObject); ok {
-		return validResponse.VisitGetSeasonsResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetSeasonsSeasonIdMembersResponseObject); ok {
+		return validResponse.VisitGetSeasonsSeasonIdMembersResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostSeasons operation middleware
-func (sh *strictHandler) PostSeasons(ctx echo.Context) error {
-	var request PostSeasonsRequestObject
+// PostSeasonsSeasonIdMembers operation middleware
+func (sh *strictHandler) PostSeasonsSeasonIdMembers(ctx echo.Context, seasonId int) error {
+	var request PostSeasonsSeasonIdMembersRequestObject
 
-	var body PostSeasonsJSONRequestBody
+	request.SeasonId = seasonId
+
+	var body PostSeasonsSeasonIdMembersJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostSeasons(ctx.Request().Context(), request.(PostSeasonsRequestObject))
+		return sh.ssi.PostSeasonsSeasonIdMembers(ctx.Request().Context(), request.(PostSeasonsSeasonIdMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostSeasons")
+		handler = middleware(handler, "PostSeasonsSeasonIdMembers")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostSeasonsResponseObject); ok {
-		return validResponse.VisitPostSeasonsResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostSeasonsSeasonIdMembersResponseObject); ok {
+		return validResponse.VisitPostSeasonsSeasonIdMembersResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetSeasonsTotalAmount operation middleware
-func (sh *strictHandler) GetSeasonsTotalAmount(ctx echo.Context) error {
-	var request GetSeasonsTotalAmountRequestObject
+// DeleteSeasonsSeasonIdMembersMemberId operation middleware
+func (sh *strictHandler) DeleteSeasonsSeasonIdMembersMemberId(ctx echo.Context, seasonId int, memberId int) error {
+	var request DeleteSeasonsSeasonIdMembersMemberIdRequestObject
+
+	request.SeasonId = seasonId
+	request.MemberId = memberId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSeasonsSeasonIdMembersMemberId(ctx.Request().Context(), request.(DeleteSeasonsSeasonIdMembersMemberIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSeasonsSeasonIdMembersMemberId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteSeasonsSeasonIdMembersMemberIdResponseObject); ok {
+		return validResponse.VisitDeleteSeasonsSeasonIdMembersMemberIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutSeasonsSeasonIdMembersMemberId operation middleware
+func (sh *strictHandler) PutSeasonsSeasonIdMembersMemberId(ctx echo.Context, seasonId int, memberId int) error {
+	var request PutSeasonsSeasonIdMembersMemberIdRequestObject
+
+	request.SeasonId = seasonId
+	request.MemberId = memberId
+
+	var body PutSeasonsSeasonIdMembersMemberIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSeasonsTotalAmount(ctx.Request().Context(), request.(GetSeasonsTotalAmountRequestObject))
+		return sh.ssi.PutSeasonsSeasonIdMembersMemberId(ctx.Request().Context(), request.(PutSeasonsSeasonIdMembersMemberIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSeasonsTotalAmount")
+		handler = middleware(handler, "PutSeasonsSeasonIdMembersMemberId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetSeasonsTotalAmountResponseObject); ok {
-		return validResponse.VisitGetSeasonsTotalAmountResponse(ctx.Response())
+	} else if validResponse, ok := response.(PutSeasonsSeasonIdMembersMemberIdResponseObject); ok {
+		return validResponse.VisitPutSeasonsSeasonIdMembersMemberIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// DeleteSeasonsSeasonId operation middleware
-func (sh *strictHandler) DeleteSeasonsSeasonId(ctx echo.Context, seasonId int) error {
-	var request DeleteSeasonsSeasonIdRequestObject
+// GetSeasonsSeasonIdPublicScheduleLinks operation middleware
+func (sh *strictHandler) GetSeasonsSeasonIdPublicScheduleLinks(ctx echo.Context, seasonId int) error {
+	var request GetSeasonsSeasonIdPublicScheduleLinksRequestObject
 
 	request.SeasonId = seasonId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteSeasonsSeasonId(ctx.Request().Context(), request.(DeleteSeasonsSeasonIdRequestObject))
+		return sh.ssi.GetSeasonsSeasonIdPublicScheduleLinks(ctx.Request().Context(), request.(GetSeasonsSeasonIdPublicScheduleLinksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteSeasonsSeasonId")
+		handler = middleware(handler, "GetSeasonsSeasonIdPublicScheduleLinks")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(DeleteSeasonsSeasonIdResponseObject); ok {
-		return validResponse.VisitDeleteSeasonsSeasonIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetSeasonsSeasonIdPublicScheduleLinksResponseObject); ok {
+		return validResponse.VisitGetSeasonsSeasonIdPublicScheduleLinksResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetSeasonsSeasonId operation middleware
-func (sh *strictHandler) GetSeasonsSeasonId(ctx echo.Context, seasonId int) error {
-	var request GetSeasonsSeasonIdRequestObject
+// PostSeasonsSeasonIdPublicScheduleLinks operation middleware
+func (sh *strictHandler) PostSeasonsSeasonIdPublicScheduleLinks(ctx echo.Context, seasonId int) error {
+	var request PostSeasonsSeasonIdPublicScheduleLinksRequestObject
 
 	request.SeasonId = seasonId
 
-	var body GetSeasonsSeasonIdJSONRequestBody
+	var body PostSeasonsSeasonIdPublicScheduleLinksJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSeasonsSeasonId(ctx.Request().Context(), request.(GetSeasonsSeasonIdRequestObject))
+		return sh.ssi.PostSeasonsSeasonIdPublicScheduleLinks(ctx.Request().Context(), request.(PostSeasonsSeasonIdPublicScheduleLinksRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSeasonsSeasonId")
+		handler = middleware(handler, "PostSeasonsSeasonIdPublicScheduleLinks")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetSeasonsSeasonIdResponseObject); ok {
-		return validResponse.VisitGetSeasonsSeasonIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostSeasonsSeasonIdPublicScheduleLinksResponseObject); ok {
+		return validResponse.VisitPostSeasonsSeasonIdPublicScheduleLinksResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PutSeasonsSeasonId operation middleware
-func (sh *strictHandler) PutSeasonsSeasonId(ctx echo.Context, seasonId int) error {
-	var request PutSeasonsSeasonIdRequestObject
+// DeleteSeasonsSeasonIdPublicScheduleLinksLinkId operation middleware
+func (sh *strictHandler) DeleteSeasonsSeasonIdPublicScheduleLinksLinkId(ctx echo.Context, seasonId int, linkId int) error {
+	var request DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdRequestObject
 
 	request.SeasonId = seasonId
-
-	var body PutSeasonsSeasonIdJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.LinkId = linkId
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PutSeasonsSeasonId(ctx.Request().Context(), request.(PutSeasonsSeasonIdRequestObject))
+		return sh.ssi.DeleteSeasonsSeasonIdPublicScheduleLinksLinkId(ctx.Request().Context(), request.(DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PutSeasonsSeasonId")
+		handler = middleware(handler, "DeleteSeasonsSeasonIdPublicScheduleLinksLinkId")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PutSeasonsSeasonIdResponseObject); ok {
-		return validResponse.VisitPutSeasonsSeasonIdResponse(ctx.Response())
+	} else if validResponse, ok := response.(DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdResponseObject); ok {
+		return validResponse.VisitDeleteSeasonsSeasonIdPublicScheduleLinksLinkIdResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetSeasonsSeasonIdPublicScheduleLink operation middleware
-func (sh *strictHandler) GetSeasonsSeasonIdPublicScheduleLink(ctx echo.Context, seasonId int) error {
-	var request GetSeasonsSeasonIdPublicScheduleLinkRequestObject
+// PostSeasonsSeasonIdScheduleGenerate operation middleware
+func (sh *strictHandler) PostSeasonsSeasonIdScheduleGenerate(ctx echo.Context, seasonId int) error {
+	var request PostSeasonsSeasonIdScheduleGenerateRequestObject
 
 	request.SeasonId = seasonId
 
+	var body PostSeasonsSeasonIdScheduleGenerateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSeasonsSeasonIdPublicScheduleLink(ctx.Request().Context(), request.(GetSeasonsSeasonIdPublicScheduleLinkRequestObject))
+		return sh.ssi.PostSeasonsSeasonIdScheduleGenerate(ctx.Request().Context(), request.(PostSeasonsSeasonIdScheduleGenerateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSeasonsSeasonIdPublicScheduleLink")
+		handler = middleware(handler, "PostSeasonsSeasonIdScheduleGenerate")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetSeasonsSeasonIdPublicScheduleLinkResponseObject); ok {
-		return validResponse.VisitGetSeasonsSeasonIdPublicScheduleLinkResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostSeasonsSeasonIdScheduleGenerateResponseObject); ok {
+		return validResponse.VisitPostSeasonsSeasonIdScheduleGenerateResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -2575,10 +5157,11 @@ func (sh *strictHandler) GetSeasonsSeasonIdPublicScheduleLink(ctx echo.Context,
 }
 
 // GetSeasonsSeasonIdScoreboard operation middleware
-func (sh *strictHandler) GetSeasonsSeasonIdScoreboard(ctx echo.Context, seasonId int) error {
+func (sh *strictHandler) GetSeasonsSeasonIdScoreboard(ctx echo.Context, seasonId int, params GetSeasonsSeasonIdScoreboardParams) error {
 	var request GetSeasonsSeasonIdScoreboardRequestObject
 
 	request.SeasonId = seasonId
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.GetSeasonsSeasonIdScoreboard(ctx.Request().Context(), request.(GetSeasonsSeasonIdScoreboardRequestObject))
@@ -2624,6 +5207,54 @@ func (sh *strictHandler) GetSeasonsSeasonIdUpcoming(ctx echo.Context, seasonId i
 	return nil
 }
 
+// DeleteSessions operation middleware
+func (sh *strictHandler) DeleteSessions(ctx echo.Context, params DeleteSessionsParams) error {
+	var request DeleteSessionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSessions(ctx.Request().Context(), request.(DeleteSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteSessionsResponseObject); ok {
+		return validResponse.VisitDeleteSessionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetSessions operation middleware
+func (sh *strictHandler) GetSessions(ctx echo.Context) error {
+	var request GetSessionsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSessions(ctx.Request().Context(), request.(GetSessionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSessions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetSessionsResponseObject); ok {
+		return validResponse.VisitGetSessionsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostSessions operation middleware
 func (sh *strictHandler) PostSessions(ctx echo.Context) error {
 	var request PostSessionsRequestObject
@@ -2653,10 +5284,41 @@ func (sh *strictHandler) PostSessions(ctx echo.Context) error {
 	return nil
 }
 
+// DeleteSessionsSessionId operation middleware
+func (sh *strictHandler) DeleteSessionsSessionId(ctx echo.Context, sessionId string) error {
+	var request DeleteSessionsSessionIdRequestObject
+
+	request.SessionId = sessionId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSessionsSessionId(ctx.Request().Context(), request.(DeleteSessionsSessionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSessionsSessionId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteSessionsSessionIdResponseObject); ok {
+		return validResponse.VisitDeleteSessionsSessionIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostSubscriptionsHandleSuccessUpgrade operation middleware
 func (sh *strictHandler) PostSubscriptionsHandleSuccessUpgrade(ctx echo.Context) error {
 	var request PostSubscriptionsHandleSuccessUpgradeRequestObject
 
+	var body PostSubscriptionsHandleSuccessUpgradeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.PostSubscriptionsHandleSuccessUpgrade(ctx.Request().Context(), request.(PostSubscriptionsHandleSuccessUpgradeRequestObject))
 	}
@@ -2784,6 +5446,12 @@ func (sh *strictHandler) PostUsersSendResetPasswordLink(ctx echo.Context) error
 func (sh *strictHandler) PostUsersSendVerificationEmail(ctx echo.Context) error {
 	var request PostUsersSendVerificationEmailRequestObject
 
+	var body PostUsersSendVerificationEmailJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.PostUsersSendVerificationEmail(ctx.Request().Context(), request.(PostUsersSendVerificationEmailRequestObject))
 	}
@@ -2971,6 +5639,120 @@ func (sh *strictHandler) PostUsersUserIdAppsettings(ctx echo.Context, userId int
 	return nil
 }
 
+// GetUsersUserIdCustomMatchColumns operation middleware
+func (sh *strictHandler) GetUsersUserIdCustomMatchColumns(ctx echo.Context, userId int) error {
+	var request GetUsersUserIdCustomMatchColumnsRequestObject
+
+	request.UserId = userId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersUserIdCustomMatchColumns(ctx.Request().Context(), request.(GetUsersUserIdCustomMatchColumnsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersUserIdCustomMatchColumns")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersUserIdCustomMatchColumnsResponseObject); ok {
+		return validResponse.VisitGetUsersUserIdCustomMatchColumnsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostUsersUserIdCustomMatchColumns operation middleware
+func (sh *strictHandler) PostUsersUserIdCustomMatchColumns(ctx echo.Context, userId int) error {
+	var request PostUsersUserIdCustomMatchColumnsRequestObject
+
+	request.UserId = userId
+
+	var body PostUsersUserIdCustomMatchColumnsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersUserIdCustomMatchColumns(ctx.Request().Context(), request.(PostUsersUserIdCustomMatchColumnsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersUserIdCustomMatchColumns")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersUserIdCustomMatchColumnsResponseObject); ok {
+		return validResponse.VisitPostUsersUserIdCustomMatchColumnsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// DeleteUsersUserIdCustomMatchColumnsColumnId operation middleware
+func (sh *strictHandler) DeleteUsersUserIdCustomMatchColumnsColumnId(ctx echo.Context, userId int, columnId int) error {
+	var request DeleteUsersUserIdCustomMatchColumnsColumnIdRequestObject
+
+	request.UserId = userId
+	request.ColumnId = columnId
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteUsersUserIdCustomMatchColumnsColumnId(ctx.Request().Context(), request.(DeleteUsersUserIdCustomMatchColumnsColumnIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteUsersUserIdCustomMatchColumnsColumnId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(DeleteUsersUserIdCustomMatchColumnsColumnIdResponseObject); ok {
+		return validResponse.VisitDeleteUsersUserIdCustomMatchColumnsColumnIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PutUsersUserIdCustomMatchColumnsColumnId operation middleware
+func (sh *strictHandler) PutUsersUserIdCustomMatchColumnsColumnId(ctx echo.Context, userId int, columnId int) error {
+	var request PutUsersUserIdCustomMatchColumnsColumnIdRequestObject
+
+	request.UserId = userId
+	request.ColumnId = columnId
+
+	var body PutUsersUserIdCustomMatchColumnsColumnIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutUsersUserIdCustomMatchColumnsColumnId(ctx.Request().Context(), request.(PutUsersUserIdCustomMatchColumnsColumnIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutUsersUserIdCustomMatchColumnsColumnId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutUsersUserIdCustomMatchColumnsColumnIdResponseObject); ok {
+		return validResponse.VisitPutUsersUserIdCustomMatchColumnsColumnIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // GetUsersUserIdCustomPlayerColumns operation middleware
 func (sh *strictHandler) GetUsersUserIdCustomPlayerColumns(ctx echo.Context, userId int) error {
 	var request GetUsersUserIdCustomPlayerColumnsRequestObject
@@ -3027,12 +5809,44 @@ func (sh *strictHandler) PostUsersUserIdCustomPlayerColumns(ctx echo.Context, us
 	return nil
 }
 
+// PutUsersUserIdCustomPlayerColumns operation middleware
+func (sh *strictHandler) PutUsersUserIdCustomPlayerColumns(ctx echo.Context, userId int) error {
+	var request PutUsersUserIdCustomPlayerColumnsRequestObject
+
+	request.UserId = userId
+
+	var body PutUsersUserIdCustomPlayerColumnsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutUsersUserIdCustomPlayerColumns(ctx.Request().Context(), request.(PutUsersUserIdCustomPlayerColumnsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutUsersUserIdCustomPlayerColumns")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutUsersUserIdCustomPlayerColumnsResponseObject); ok {
+		return validResponse.VisitPutUsersUserIdCustomPlayerColumnsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // DeleteUsersUserIdCustomPlayerColumnsColumnId operation middleware
-func (sh *strictHandler) DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx echo.Context, userId int, columnId int) error {
+func (sh *strictHandler) DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx echo.Context, userId int, columnId int, params DeleteUsersUserIdCustomPlayerColumnsColumnIdParams) error {
 	var request DeleteUsersUserIdCustomPlayerColumnsColumnIdRequestObject
 
 	request.UserId = userId
 	request.ColumnId = columnId
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
 		return sh.ssi.DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx.Request().Context(), request.(DeleteUsersUserIdCustomPlayerColumnsColumnIdRequestObject))
@@ -3053,6 +5867,38 @@ func (sh *strictHandler) DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx echo.C
 	return nil
 }
 
+// PutUsersUserIdCustomPlayerColumnsColumnId operation middleware
+func (sh *strictHandler) PutUsersUserIdCustomPlayerColumnsColumnId(ctx echo.Context, userId int, columnId int) error {
+	var request PutUsersUserIdCustomPlayerColumnsColumnIdRequestObject
+
+	request.UserId = userId
+	request.ColumnId = columnId
+
+	var body PutUsersUserIdCustomPlayerColumnsColumnIdJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutUsersUserIdCustomPlayerColumnsColumnId(ctx.Request().Context(), request.(PutUsersUserIdCustomPlayerColumnsColumnIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutUsersUserIdCustomPlayerColumnsColumnId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PutUsersUserIdCustomPlayerColumnsColumnIdResponseObject); ok {
+		return validResponse.VisitPutUsersUserIdCustomPlayerColumnsColumnIdResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostUsersUserIdResetCurrentUserPassword operation middleware
 func (sh *strictHandler) PostUsersUserIdResetCurrentUserPassword(ctx echo.Context, userId int) error {
 	var request PostUsersUserIdResetCurrentUserPasswordRequestObject
@@ -3189,3 +6035,30 @@ func (sh *strictHandler) PostUsersUserIdUsersettings(ctx echo.Context, userId in
 	}
 	return nil
 }
+
+// PostWebhooksStripe operation middleware
+func (sh *strictHandler) PostWebhooksStripe(ctx echo.Context, params PostWebhooksStripeParams) error {
+	var request PostWebhooksStripeRequestObject
+
+	request.Params = params
+
+	request.Body = ctx.Request().Body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooksStripe(ctx.Request().Context(), request.(PostWebhooksStripeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooksStripe")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostWebhooksStripeResponseObject); ok {
+		return validResponse.VisitPostWebhooksStripeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
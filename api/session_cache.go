@@ -0,0 +1,113 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// SessionDurationMinutes is how long a session lasts after it was last
+// authenticated with Stytch, which extends it
+const SessionDurationMinutes = 60
+
+// sessionCacheSweepSize is the number of entries from which expired ones are
+// dropped when a new one is added
+const sessionCacheSweepSize = 10000
+
+// SessionCache remembers the principal of authenticated session tokens for a
+// short time, so that most requests do not cost a round trip to Stytch.
+// Revoking a session evicts it from the cache of this instance only, other
+// instances accept it until their entry expires.
+type SessionCache struct {
+	ttl time.Duration
+
+	mu      sync.Mutex
+	entries map[string]sessionCacheEntry
+}
+
+type sessionCacheEntry struct {
+	principal Principal
+	expiresAt time.Time
+}
+
+// NewSessionCache creates a cache keeping entries for ttl
+func NewSessionCache(ttl time.Duration) *SessionCache {
+	return &SessionCache{
+		ttl:     ttl,
+		entries: make(map[string]sessionCacheEntry),
+	}
+}
+
+// sessionCacheKey hashes a token, so the cache holds no usable tokens
+func sessionCacheKey(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// Get returns the principal of a token authenticated less than ttl ago
+func (c *SessionCache) Get(token string) (Principal, bool) {
+	key := sessionCacheKey(token)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return Principal{}, false
+	}
+	if !time.Now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return Principal{}, false
+	}
+	return entry.principal, true
+}
+
+// Put remembers the principal of a token, never past the expiry of its session
+func (c *SessionCache) Put(token string, principal Principal, sessionExpiresAt *time.Time) {
+	now := time.Now()
+	expiresAt := now.Add(c.ttl)
+	if sessionExpiresAt != nil && sessionExpiresAt.Before(expiresAt) {
+		expiresAt = *sessionExpiresAt
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.entries) >= sessionCacheSweepSize {
+		for key, entry := range c.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(c.entries, key)
+			}
+		}
+	}
+	c.entries[sessionCacheKey(token)] = sessionCacheEntry{
+		principal: principal,
+		expiresAt: expiresAt,
+	}
+}
+
+// Evict forgets the tokens of the given sessions
+func (c *SessionCache) Evict(sessionIDs ...string) {
+	evicted := make(map[string]bool, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		evicted[sessionID] = true
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries {
+		if evicted[entry.principal.SessionID] {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// EvictUser forgets the tokens of every session of a Stytch user but one
+func (c *SessionCache) EvictUser(stytchUserID string, keepSessionID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key, entry := range c.entries {
+		if entry.principal.StytchUserID == stytchUserID && entry.principal.SessionID != keepSessionID {
+			delete(c.entries, key)
+		}
+	}
+}
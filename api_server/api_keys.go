@@ -0,0 +1,197 @@
+package api_server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// apiKeyBytes is the entropy of an API key
+const apiKeyBytes = 32
+
+// apiKeyPrefixLength is how much of a key is kept to tell keys apart
+const apiKeyPrefixLength = len(api.APIKeyPrefix) + 8
+
+// maxAPIKeys is how many unexpired keys a user can hold
+const maxAPIKeys = 20
+
+// maxAPIKeyNameLength matches api_keys.name
+const maxAPIKeyNameLength = 100
+
+// APIKey describes a personal API key without the key itself, which is only
+// returned when it is created
+type APIKey struct {
+	Id         int32      `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	Expired    bool       `json:"expired"`
+}
+
+func apiKeyInfo(key db.ApiKey, now time.Time) APIKey {
+	info := APIKey{
+		Id:        key.ID,
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    key.Scopes,
+		CreatedAt: key.Createdat.Time,
+	}
+	if key.Expiresat.Valid {
+		info.ExpiresAt = Ptr(key.Expiresat.Time)
+		info.Expired = !key.Expiresat.Time.After(now)
+	}
+	if key.Lastusedat.Valid {
+		info.LastUsedAt = Ptr(key.Lastusedat.Time)
+	}
+	return info
+}
+
+// CreateAPIKey creates a personal API key for the user and returns it with the
+// key, which cannot be read back later
+func (s *AuthServer) CreateAPIKey(
+	ctx context.Context,
+	userId int32,
+	name string,
+	scopes []string,
+	expiresAt *time.Time,
+) (*db.ApiKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > maxAPIKeyNameLength {
+		return nil, "", apierror.Validation(apierror.CodeInvalidField, fmt.Sprintf("The name of an API key must have 1 to %d characters", maxAPIKeyNameLength))
+	}
+	if len(scopes) == 0 {
+		return nil, "", apierror.Validation(apierror.CodeInvalidField, "An API key needs at least one scope")
+	}
+	uniqueScopes := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		if !api.IsValidScope(scope) {
+			return nil, "", apierror.Validation(apierror.CodeInvalidField, fmt.Sprintf("Unknown scope %q", scope))
+		}
+		if !slices.Contains(uniqueScopes, scope) {
+			uniqueScopes = append(uniqueScopes, scope)
+		}
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", apierror.Validation(apierror.CodeValidation, "The expiry of an API key must be in the future")
+	}
+
+	keys, err := s.DB.CountActiveApiKeys(ctx, userId)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to count API keys: %w", err)
+	}
+	if keys >= maxAPIKeys {
+		return nil, "", apierror.Conflict(apierror.CodeConflict, fmt.Sprintf("You can have at most %d API keys, revoke one first", maxAPIKeys))
+	}
+
+	raw := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	key := api.APIKeyPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	params := db.CreateApiKeyParams{
+		Userid:  userId,
+		Name:    name,
+		Prefix:  key[:apiKeyPrefixLength],
+		Keyhash: api.HashAPIKey(key),
+		Scopes:  uniqueScopes,
+	}
+	if expiresAt != nil {
+		params.Expiresat = pgtype.Timestamp{Time: expiresAt.UTC(), Valid: true}
+	}
+	apiKey, err := s.DB.CreateApiKey(ctx, params)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create API key: %w", err)
+	}
+	return &apiKey, key, nil
+}
+
+// API endpoint implementations
+
+func (s *AuthServer) GetApiKeys(ctx context.Context, request api.GetApiKeysRequestObject) (api.GetApiKeysResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := s.DB.GetApiKeys(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API keys: %w", err)
+	}
+
+	now := time.Now()
+	apiKeys := make([]APIKey, 0, len(keys))
+	for _, key := range keys {
+		apiKeys = append(apiKeys, apiKeyInfo(key, now))
+	}
+
+	keysMap := map[string]interface{}{
+		"keys": apiKeys,
+	}
+	return api.GetApiKeys200JSONResponse(api.ApiResult{
+		Data:      &keysMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *AuthServer) PostApiKeys(ctx context.Context, request api.PostApiKeysRequestObject) (api.PostApiKeysResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	scopes := make([]string, 0, len(request.Body.Scopes))
+	for _, scope := range request.Body.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+	apiKey, key, err := s.CreateAPIKey(ctx, userID, request.Body.Name, scopes, request.Body.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	keyMap := map[string]interface{}{
+		"apiKey": apiKeyInfo(*apiKey, time.Now()),
+		"key":    key,
+	}
+	return api.PostApiKeys200JSONResponse(api.ApiResult{
+		Data:      &keyMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *AuthServer) DeleteApiKeysKeyId(ctx context.Context, request api.DeleteApiKeysKeyIdRequestObject) (api.DeleteApiKeysKeyIdResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.DB.RevokeApiKey(ctx, db.RevokeApiKeyParams{
+		ID:     int32(request.KeyId),
+		Userid: userID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound(fmt.Sprintf("API key %d not found", request.KeyId))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke API key: %w", err)
+	}
+
+	return api.DeleteApiKeysKeyId200JSONResponse(api.ApiResult{
+		IsSuccess: Ptr(true),
+	}), nil
+}
@@ -43,6 +43,14 @@ func (s MyApiServer) PutMatchesMatchId(ctx context.Context, request api.PutMatch
 	return s.MatchesServer.PutMatchesMatchId(ctx, request)
 }
 
+func (s MyApiServer) GetMatchesMatchIdCustomColumns(ctx context.Context, request api.GetMatchesMatchIdCustomColumnsRequestObject) (api.GetMatchesMatchIdCustomColumnsResponseObject, error) {
+	return s.MatchesServer.GetMatchesMatchIdCustomColumns(ctx, request)
+}
+
+func (s MyApiServer) PutMatchesMatchIdCustomColumns(ctx context.Context, request api.PutMatchesMatchIdCustomColumnsRequestObject) (api.PutMatchesMatchIdCustomColumnsResponseObject, error) {
+	return s.MatchesServer.PutMatchesMatchIdCustomColumns(ctx, request)
+}
+
 func (s MyApiServer) GetPlayers(ctx context.Context, request api.GetPlayersRequestObject) (api.GetPlayersResponseObject, error) {
 	return s.PlayersServer.GetPlayers(ctx, request)
 }
@@ -55,6 +63,10 @@ func (s MyApiServer) DeletePlayersPlayerId(ctx context.Context, request api.Dele
 	return s.PlayersServer.DeletePlayersPlayerId(ctx, request)
 }
 
+func (s MyApiServer) GetPlayersRatings(ctx context.Context, request api.GetPlayersRatingsRequestObject) (api.GetPlayersRatingsResponseObject, error) {
+	return s.PlayersServer.GetPlayersRatings(ctx, request)
+}
+
 func (s MyApiServer) GetPlayersPlayerId(ctx context.Context, request api.GetPlayersPlayerIdRequestObject) (api.GetPlayersPlayerIdResponseObject, error) {
 	return s.PlayersServer.GetPlayersPlayerId(ctx, request)
 }
@@ -71,6 +83,18 @@ func (s MyApiServer) PutPlayersPlayerIdCustomColumns(ctx context.Context, reques
 	return s.PlayersServer.PutPlayersPlayerIdCustomColumns(ctx, request)
 }
 
+func (s MyApiServer) PostPlayersPlayerIdCalendarFeed(ctx context.Context, request api.PostPlayersPlayerIdCalendarFeedRequestObject) (api.PostPlayersPlayerIdCalendarFeedResponseObject, error) {
+	return s.PlayersServer.PostPlayersPlayerIdCalendarFeed(ctx, request)
+}
+
+func (s MyApiServer) DeletePlayersPlayerIdCalendarFeed(ctx context.Context, request api.DeletePlayersPlayerIdCalendarFeedRequestObject) (api.DeletePlayersPlayerIdCalendarFeedResponseObject, error) {
+	return s.PlayersServer.DeletePlayersPlayerIdCalendarFeed(ctx, request)
+}
+
+func (s MyApiServer) GetCalendarsToken(ctx context.Context, request api.GetCalendarsTokenRequestObject) (api.GetCalendarsTokenResponseObject, error) {
+	return s.PlayersServer.GetCalendarsToken(ctx, request)
+}
+
 func (s MyApiServer) GetPlayersPlayerIdSchedule(ctx context.Context, request api.GetPlayersPlayerIdScheduleRequestObject) (api.GetPlayersPlayerIdScheduleResponseObject, error) {
 	return s.PlayersServer.GetPlayersPlayerIdSchedule(ctx, request)
 }
@@ -99,22 +123,90 @@ func (s MyApiServer) PutSeasonsSeasonId(ctx context.Context, request api.PutSeas
 	return s.SeasonsServer.PutSeasonsSeasonId(ctx, request)
 }
 
-func (s MyApiServer) GetSeasonsSeasonIdPublicScheduleLink(ctx context.Context, request api.GetSeasonsSeasonIdPublicScheduleLinkRequestObject) (api.GetSeasonsSeasonIdPublicScheduleLinkResponseObject, error) {
-	return s.SeasonsServer.GetSeasonsSeasonIdPublicScheduleLink(ctx, request)
+func (s MyApiServer) GetSeasonsSeasonIdPublicScheduleLinks(ctx context.Context, request api.GetSeasonsSeasonIdPublicScheduleLinksRequestObject) (api.GetSeasonsSeasonIdPublicScheduleLinksResponseObject, error) {
+	return s.SeasonsServer.GetSeasonsSeasonIdPublicScheduleLinks(ctx, request)
+}
+
+func (s MyApiServer) PostSeasonsSeasonIdPublicScheduleLinks(ctx context.Context, request api.PostSeasonsSeasonIdPublicScheduleLinksRequestObject) (api.PostSeasonsSeasonIdPublicScheduleLinksResponseObject, error) {
+	return s.SeasonsServer.PostSeasonsSeasonIdPublicScheduleLinks(ctx, request)
+}
+
+func (s MyApiServer) DeleteSeasonsSeasonIdPublicScheduleLinksLinkId(ctx context.Context, request api.DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdRequestObject) (api.DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdResponseObject, error) {
+	return s.SeasonsServer.DeleteSeasonsSeasonIdPublicScheduleLinksLinkId(ctx, request)
+}
+
+func (s MyApiServer) GetSeasonsSeasonIdMembers(ctx context.Context, request api.GetSeasonsSeasonIdMembersRequestObject) (api.GetSeasonsSeasonIdMembersResponseObject, error) {
+	return s.SeasonsServer.GetSeasonsSeasonIdMembers(ctx, request)
+}
+
+func (s MyApiServer) PostSeasonsSeasonIdMembers(ctx context.Context, request api.PostSeasonsSeasonIdMembersRequestObject) (api.PostSeasonsSeasonIdMembersResponseObject, error) {
+	return s.SeasonsServer.PostSeasonsSeasonIdMembers(ctx, request)
+}
+
+func (s MyApiServer) PutSeasonsSeasonIdMembersMemberId(ctx context.Context, request api.PutSeasonsSeasonIdMembersMemberIdRequestObject) (api.PutSeasonsSeasonIdMembersMemberIdResponseObject, error) {
+	return s.SeasonsServer.PutSeasonsSeasonIdMembersMemberId(ctx, request)
+}
+
+func (s MyApiServer) DeleteSeasonsSeasonIdMembersMemberId(ctx context.Context, request api.DeleteSeasonsSeasonIdMembersMemberIdRequestObject) (api.DeleteSeasonsSeasonIdMembersMemberIdResponseObject, error) {
+	return s.SeasonsServer.DeleteSeasonsSeasonIdMembersMemberId(ctx, request)
+}
+
+func (s MyApiServer) GetPublicSchedulesToken(ctx context.Context, request api.GetPublicSchedulesTokenRequestObject) (api.GetPublicSchedulesTokenResponseObject, error) {
+	return s.SeasonsServer.GetPublicSchedulesToken(ctx, request)
+}
+
+func (s MyApiServer) GetPublicSchedulesTokenScoreboard(ctx context.Context, request api.GetPublicSchedulesTokenScoreboardRequestObject) (api.GetPublicSchedulesTokenScoreboardResponseObject, error) {
+	return s.SeasonsServer.GetPublicSchedulesTokenScoreboard(ctx, request)
+}
+
+func (s MyApiServer) GetPublicSchedulesTokenUpcoming(ctx context.Context, request api.GetPublicSchedulesTokenUpcomingRequestObject) (api.GetPublicSchedulesTokenUpcomingResponseObject, error) {
+	return s.SeasonsServer.GetPublicSchedulesTokenUpcoming(ctx, request)
+}
+
+func (s MyApiServer) GetPublicSchedulesTokenEvents(ctx context.Context, request api.GetPublicSchedulesTokenEventsRequestObject) (api.GetPublicSchedulesTokenEventsResponseObject, error) {
+	return s.SeasonsServer.GetPublicSchedulesTokenEvents(ctx, request)
+}
+
+func (s MyApiServer) PostSeasonsSeasonIdScheduleGenerate(ctx context.Context, request api.PostSeasonsSeasonIdScheduleGenerateRequestObject) (api.PostSeasonsSeasonIdScheduleGenerateResponseObject, error) {
+	return s.SeasonsServer.PostSeasonsSeasonIdScheduleGenerate(ctx, request)
+}
+
+func (s MyApiServer) GetSeasonsSeasonIdBracket(ctx context.Context, request api.GetSeasonsSeasonIdBracketRequestObject) (api.GetSeasonsSeasonIdBracketResponseObject, error) {
+	return s.SeasonsServer.GetSeasonsSeasonIdBracket(ctx, request)
+}
+
+func (s MyApiServer) PostSeasonsSeasonIdBracket(ctx context.Context, request api.PostSeasonsSeasonIdBracketRequestObject) (api.PostSeasonsSeasonIdBracketResponseObject, error) {
+	return s.SeasonsServer.PostSeasonsSeasonIdBracket(ctx, request)
 }
 
 func (s MyApiServer) GetSeasonsSeasonIdScoreboard(ctx context.Context, request api.GetSeasonsSeasonIdScoreboardRequestObject) (api.GetSeasonsSeasonIdScoreboardResponseObject, error) {
 	return s.SeasonsServer.GetSeasonsSeasonIdScoreboard(ctx, request)
 }
 
+func (s MyApiServer) GetSeasonsSeasonIdEvents(ctx context.Context, request api.GetSeasonsSeasonIdEventsRequestObject) (api.GetSeasonsSeasonIdEventsResponseObject, error) {
+	return s.SeasonsServer.GetSeasonsSeasonIdEvents(ctx, request)
+}
+
 func (s MyApiServer) GetSeasonsSeasonIdUpcoming(ctx context.Context, request api.GetSeasonsSeasonIdUpcomingRequestObject) (api.GetSeasonsSeasonIdUpcomingResponseObject, error) {
 	return s.SeasonsServer.GetSeasonsSeasonIdUpcoming(ctx, request)
 }
 
+func (s MyApiServer) GetSessions(ctx context.Context, request api.GetSessionsRequestObject) (api.GetSessionsResponseObject, error) {
+	return s.AuthServer.GetSessions(ctx, request)
+}
+
+func (s MyApiServer) DeleteSessions(ctx context.Context, request api.DeleteSessionsRequestObject) (api.DeleteSessionsResponseObject, error) {
+	return s.AuthServer.DeleteSessions(ctx, request)
+}
+
 func (s MyApiServer) PostSessions(ctx context.Context, request api.PostSessionsRequestObject) (api.PostSessionsResponseObject, error) {
 	return s.AuthServer.PostSessions(ctx, request)
 }
 
+func (s MyApiServer) DeleteSessionsSessionId(ctx context.Context, request api.DeleteSessionsSessionIdRequestObject) (api.DeleteSessionsSessionIdResponseObject, error) {
+	return s.AuthServer.DeleteSessionsSessionId(ctx, request)
+}
+
 func (s MyApiServer) PostSubscriptionsHandleSuccessUpgrade(ctx context.Context, request api.PostSubscriptionsHandleSuccessUpgradeRequestObject) (api.PostSubscriptionsHandleSuccessUpgradeResponseObject, error) {
 	return s.SubscriptionsServer.PostSubscriptionsHandleSuccessUpgrade(ctx, request)
 }
@@ -127,6 +219,10 @@ func (s MyApiServer) PostSubscriptionsUpgradeUserSubscription(ctx context.Contex
 	return s.SubscriptionsServer.PostSubscriptionsUpgradeUserSubscription(ctx, request)
 }
 
+func (s MyApiServer) PostWebhooksStripe(ctx context.Context, request api.PostWebhooksStripeRequestObject) (api.PostWebhooksStripeResponseObject, error) {
+	return s.SubscriptionsServer.PostWebhooksStripe(ctx, request)
+}
+
 func (s MyApiServer) PostSupportMessages(ctx context.Context, request api.PostSupportMessagesRequestObject) (api.PostSupportMessagesResponseObject, error) {
 	return s.AuthServer.PostSupportMessages(ctx, request)
 }
@@ -144,15 +240,39 @@ func (s MyApiServer) PostUsersUserIdAppsettings(ctx context.Context, request api
 }
 
 func (s MyApiServer) GetUsersUserIdCustomPlayerColumns(ctx context.Context, request api.GetUsersUserIdCustomPlayerColumnsRequestObject) (api.GetUsersUserIdCustomPlayerColumnsResponseObject, error) {
-	return s.AuthServer.GetUsersUserIdCustomPlayerColumns(ctx, request)
+	return s.PlayersServer.GetUsersUserIdCustomPlayerColumns(ctx, request)
 }
 
 func (s MyApiServer) PostUsersUserIdCustomPlayerColumns(ctx context.Context, request api.PostUsersUserIdCustomPlayerColumnsRequestObject) (api.PostUsersUserIdCustomPlayerColumnsResponseObject, error) {
-	return s.AuthServer.PostUsersUserIdCustomPlayerColumns(ctx, request)
+	return s.PlayersServer.PostUsersUserIdCustomPlayerColumns(ctx, request)
+}
+
+func (s MyApiServer) PutUsersUserIdCustomPlayerColumns(ctx context.Context, request api.PutUsersUserIdCustomPlayerColumnsRequestObject) (api.PutUsersUserIdCustomPlayerColumnsResponseObject, error) {
+	return s.PlayersServer.PutUsersUserIdCustomPlayerColumns(ctx, request)
+}
+
+func (s MyApiServer) PutUsersUserIdCustomPlayerColumnsColumnId(ctx context.Context, request api.PutUsersUserIdCustomPlayerColumnsColumnIdRequestObject) (api.PutUsersUserIdCustomPlayerColumnsColumnIdResponseObject, error) {
+	return s.PlayersServer.PutUsersUserIdCustomPlayerColumnsColumnId(ctx, request)
 }
 
 func (s MyApiServer) DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx context.Context, request api.DeleteUsersUserIdCustomPlayerColumnsColumnIdRequestObject) (api.DeleteUsersUserIdCustomPlayerColumnsColumnIdResponseObject, error) {
-	return s.AuthServer.DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx, request)
+	return s.PlayersServer.DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx, request)
+}
+
+func (s MyApiServer) GetUsersUserIdCustomMatchColumns(ctx context.Context, request api.GetUsersUserIdCustomMatchColumnsRequestObject) (api.GetUsersUserIdCustomMatchColumnsResponseObject, error) {
+	return s.MatchesServer.GetUsersUserIdCustomMatchColumns(ctx, request)
+}
+
+func (s MyApiServer) PostUsersUserIdCustomMatchColumns(ctx context.Context, request api.PostUsersUserIdCustomMatchColumnsRequestObject) (api.PostUsersUserIdCustomMatchColumnsResponseObject, error) {
+	return s.MatchesServer.PostUsersUserIdCustomMatchColumns(ctx, request)
+}
+
+func (s MyApiServer) PutUsersUserIdCustomMatchColumnsColumnId(ctx context.Context, request api.PutUsersUserIdCustomMatchColumnsColumnIdRequestObject) (api.PutUsersUserIdCustomMatchColumnsColumnIdResponseObject, error) {
+	return s.MatchesServer.PutUsersUserIdCustomMatchColumnsColumnId(ctx, request)
+}
+
+func (s MyApiServer) DeleteUsersUserIdCustomMatchColumnsColumnId(ctx context.Context, request api.DeleteUsersUserIdCustomMatchColumnsColumnIdRequestObject) (api.DeleteUsersUserIdCustomMatchColumnsColumnIdResponseObject, error) {
+	return s.MatchesServer.DeleteUsersUserIdCustomMatchColumnsColumnId(ctx, request)
 }
 
 func (s MyApiServer) PostUsersUserIdResetCurrentUserPassword(ctx context.Context, request api.PostUsersUserIdResetCurrentUserPasswordRequestObject) (api.PostUsersUserIdResetCurrentUserPasswordResponseObject, error) {
@@ -194,3 +314,15 @@ func (s MyApiServer) PostUsersUpdateUserPassword(ctx context.Context, request ap
 func (s MyApiServer) PostUsersVerifyMagicLinkToken(ctx context.Context, request api.PostUsersVerifyMagicLinkTokenRequestObject) (api.PostUsersVerifyMagicLinkTokenResponseObject, error) {
 	return s.AuthServer.PostUsersVerifyMagicLinkToken(ctx, request)
 }
+
+func (s MyApiServer) GetApiKeys(ctx context.Context, request api.GetApiKeysRequestObject) (api.GetApiKeysResponseObject, error) {
+	return s.AuthServer.GetApiKeys(ctx, request)
+}
+
+func (s MyApiServer) PostApiKeys(ctx context.Context, request api.PostApiKeysRequestObject) (api.PostApiKeysResponseObject, error) {
+	return s.AuthServer.PostApiKeys(ctx, request)
+}
+
+func (s MyApiServer) DeleteApiKeysKeyId(ctx context.Context, request api.DeleteApiKeysKeyIdRequestObject) (api.DeleteApiKeysKeyIdResponseObject, error) {
+	return s.AuthServer.DeleteApiKeysKeyId(ctx, request)
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
 	"github.com/gameplan-backend/db"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/labstack/echo/v4"
 	"github.com/mailgun/mailgun-go/v4"
 	"github.com/stripe/stripe-go/v81"
 	"github.com/stripe/stripe-go/v81/client"
@@ -22,6 +25,13 @@ type AuthServer struct {
 	StripeClient *client.API
 	DB           *db.Queries
 	Emailer      *mailgun.MailgunImpl
+	// MagicLinks sends the login links, which also verify emails
+	MagicLinks MagicLinkClient
+	// AppURL is the base URL of the app, magic links open a page of it
+	AppURL string
+	// Sessions caches authenticated tokens, revoked sessions are evicted
+	Sessions *api.SessionCache
+	Logger   echo.Logger
 }
 
 func (s *AuthServer) PostSessions(ctx context.Context, request api.PostSessionsRequestObject) (api.PostSessionsResponseObject, error) {
@@ -33,19 +43,10 @@ func (s *AuthServer) PostSessions(ctx context.Context, request api.PostSessionsR
 	resp, err := s.StytchClient.Passwords.Authenticate(ctx, &passwords.AuthenticateParams{
 		Email:                  params.Email,
 		Password:               params.Password,
-		SessionDurationMinutes: 60,
+		SessionDurationMinutes: api.SessionDurationMinutes,
 	})
 	if err != nil {
-		return api.PostSessions200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("INVALID_CREDENTIALS"),
-				Message: Ptr("Invalid credentials"),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, apierror.New(http.StatusUnauthorized, apierror.CodeInvalidCredentials, "Invalid credentials")
 	}
 
 	responseData := map[string]any{
@@ -60,49 +61,20 @@ func (s *AuthServer) PostSessions(ctx context.Context, request api.PostSessionsR
 }
 
 func (s *AuthServer) PostSupportMessages(ctx context.Context, request api.PostSupportMessagesRequestObject) (api.PostSupportMessagesResponseObject, error) {
-	return api.PostSupportMessages200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("PostSupportMessages not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
+	return nil, apierror.NotImplemented("PostSupportMessages not implemented yet")
 }
 
 func (s *AuthServer) DeleteUsersUserId(ctx context.Context, request api.DeleteUsersUserIdRequestObject) (api.DeleteUsersUserIdResponseObject, error) {
 	// Delete user subscription
 	err := s.DB.DeleteUserSubscription(ctx, int32(request.UserId))
 	if err != nil {
-		fmt.Printf("Failed to delete user subscription: %v\n", err)
-		return api.DeleteUsersUserId200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DATABASE_ERROR"),
-				Message: Ptr("Failed to delete user subscription"),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, fmt.Errorf("failed to delete user subscription: %w", err)
 	}
 
 	// Delete user
 	err = s.DB.DeleteUser(ctx, int32(request.UserId))
 	if err != nil {
-		fmt.Printf("Failed to delete user: %v\n", err)
-		return api.DeleteUsersUserId200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DATABASE_ERROR"),
-				Message: Ptr("Failed to delete user"),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, fmt.Errorf("failed to delete user: %w", err)
 	}
 
 	return api.DeleteUsersUserId200JSONResponse(api.ApiResult{
@@ -111,150 +83,26 @@ func (s *AuthServer) DeleteUsersUserId(ctx context.Context, request api.DeleteUs
 }
 
 func (s *AuthServer) GetUsersUserIdAppsettings(ctx context.Context, request api.GetUsersUserIdAppsettingsRequestObject) (api.GetUsersUserIdAppsettingsResponseObject, error) {
-	return api.GetUsersUserIdAppsettings200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("GetUsersUserIdAppsettings not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
+	return nil, apierror.NotImplemented("GetUsersUserIdAppsettings not implemented yet")
 }
 
 func (s *AuthServer) PostUsersUserIdAppsettings(ctx context.Context, request api.PostUsersUserIdAppsettingsRequestObject) (api.PostUsersUserIdAppsettingsResponseObject, error) {
-	return api.PostUsersUserIdAppsettings200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("App settings update not implemented"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
-}
-
-func (s *AuthServer) GetUsersUserIdCustomPlayerColumns(ctx context.Context, request api.GetUsersUserIdCustomPlayerColumnsRequestObject) (api.GetUsersUserIdCustomPlayerColumnsResponseObject, error) {
-	return api.GetUsersUserIdCustomPlayerColumns200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("GetUsersUserIdCustomPlayerColumns not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
-}
-
-func (s *AuthServer) PostUsersUserIdCustomPlayerColumns(ctx context.Context, request api.PostUsersUserIdCustomPlayerColumnsRequestObject) (api.PostUsersUserIdCustomPlayerColumnsResponseObject, error) {
-	return api.PostUsersUserIdCustomPlayerColumns200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("Custom player columns not implemented"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
-}
-
-func (s *AuthServer) DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx context.Context, request api.DeleteUsersUserIdCustomPlayerColumnsColumnIdRequestObject) (api.DeleteUsersUserIdCustomPlayerColumnsColumnIdResponseObject, error) {
-	return api.DeleteUsersUserIdCustomPlayerColumnsColumnId200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("DeleteUsersUserIdCustomPlayerColumnsColumnId not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
-}
-
-func (s *AuthServer) PostUsersUserIdResetCurrentUserPassword(ctx context.Context, request api.PostUsersUserIdResetCurrentUserPasswordRequestObject) (api.PostUsersUserIdResetCurrentUserPasswordResponseObject, error) {
-	return api.PostUsersUserIdResetCurrentUserPassword200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("Password reset not implemented"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
+	return nil, apierror.NotImplemented("App settings update not implemented")
 }
 
 func (s *AuthServer) GetUsersUserIdUsersettings(ctx context.Context, request api.GetUsersUserIdUsersettingsRequestObject) (api.GetUsersUserIdUsersettingsResponseObject, error) {
-	return api.GetUsersUserIdUsersettings200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("GetUsersUserIdUsersettings not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
+	return nil, apierror.NotImplemented("GetUsersUserIdUsersettings not implemented yet")
 }
 
 func (s *AuthServer) PostUsersUserIdUsersettings(ctx context.Context, request api.PostUsersUserIdUsersettingsRequestObject) (api.PostUsersUserIdUsersettingsResponseObject, error) {
-	return api.PostUsersUserIdUsersettings200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("User settings update not implemented"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
-}
-
-func (s *AuthServer) PostUsersSendResetPasswordLink(ctx context.Context, request api.PostUsersSendResetPasswordLinkRequestObject) (api.PostUsersSendResetPasswordLinkResponseObject, error) {
-	return api.PostUsersSendResetPasswordLink200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("PostUsersSendResetPasswordLink not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
-}
-
-func (s *AuthServer) PostUsersSendVerificationEmail(ctx context.Context, request api.PostUsersSendVerificationEmailRequestObject) (api.PostUsersSendVerificationEmailResponseObject, error) {
-	return api.PostUsersSendVerificationEmail200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("PostUsersSendVerificationEmail not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
+	return nil, apierror.NotImplemented("User settings update not implemented")
 }
 
 func (s *AuthServer) PostUsersSignUpUser(ctx context.Context, request api.PostUsersSignUpUserRequestObject) (api.PostUsersSignUpUserResponseObject, error) {
 	params := request.Body
 
-	// Validate password length
-	if len(params.Password) < 8 {
-		return api.PostUsersSignUpUser200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("WEAK_PASSWORD"),
-				Message: Ptr("Password must be at least 8 characters"),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+	if err := ValidatePassword(params.Password, params.Email); err != nil {
+		return nil, err
 	}
 
 	// Create Stytch user
@@ -267,39 +115,12 @@ func (s *AuthServer) PostUsersSignUpUser(ctx context.Context, request api.PostUs
 		if errors.As(err, &stytchErr) {
 			switch stytchErr.ErrorType {
 			case "duplicate_email":
-				return api.PostUsersSignUpUser200JSONResponse(api.ApiResult{
-					Error: &struct {
-						Code    *string `json:"code,omitempty"`
-						Message *string `json:"message,omitempty"`
-					}{
-						Code:    Ptr("DUPLICATE_EMAIL"),
-						Message: Ptr("Email address already exists"),
-					},
-					IsSuccess: Ptr(false),
-				}), nil
+				return nil, apierror.Conflict(apierror.CodeDuplicateEmail, "Email address already exists")
 			case "invalid_email":
-				return api.PostUsersSignUpUser200JSONResponse(api.ApiResult{
-					Error: &struct {
-						Code    *string `json:"code,omitempty"`
-						Message *string `json:"message,omitempty"`
-					}{
-						Code:    Ptr("INVALID_EMAIL"),
-						Message: Ptr("Invalid email address format"),
-					},
-					IsSuccess: Ptr(false),
-				}), nil
+				return nil, apierror.Validation(apierror.CodeInvalidEmail, "Invalid email address format")
 			}
 		}
-		return api.PostUsersSignUpUser200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("STYTCH_ERROR"),
-				Message: Ptr("Failed to create user account"),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, apierror.Upstream(apierror.CodeStytchError, "Failed to create user account", err)
 	}
 
 	// Create Stripe customer
@@ -313,16 +134,7 @@ func (s *AuthServer) PostUsersSignUpUser(ctx context.Context, request api.PostUs
 		},
 	})
 	if err != nil {
-		return api.PostUsersSignUpUser200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("STRIPE_ERROR"),
-				Message: Ptr("Failed to create billing profile"),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, apierror.Upstream(apierror.CodeStripeError, "Failed to create billing profile", err)
 	}
 
 	// Create database user
@@ -345,16 +157,7 @@ func (s *AuthServer) PostUsersSignUpUser(ctx context.Context, request api.PostUs
 		Isverified: false,
 	})
 	if err != nil {
-		return api.PostUsersSignUpUser200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DATABASE_ERROR"),
-				Message: Ptr("Failed to save user information"),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	// Update Stytch metadata with our internal user ID
@@ -386,32 +189,6 @@ func (s *AuthServer) PostUsersSignUpUser(ctx context.Context, request api.PostUs
 	}), nil
 }
 
-func (s *AuthServer) PostUsersUpdateUserPassword(ctx context.Context, request api.PostUsersUpdateUserPasswordRequestObject) (api.PostUsersUpdateUserPasswordResponseObject, error) {
-	return api.PostUsersUpdateUserPassword200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("Password update not implemented"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
-}
-
-func (s *AuthServer) PostUsersVerifyMagicLinkToken(ctx context.Context, request api.PostUsersVerifyMagicLinkTokenRequestObject) (api.PostUsersVerifyMagicLinkTokenResponseObject, error) {
-	return api.PostUsersVerifyMagicLinkToken200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("Magic link verification not implemented"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
-}
-
 // Helper function to get pointers for optional fields in structs
 func Ptr[T any](v T) *T {
 	return &v
@@ -0,0 +1,371 @@
+package api_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Season formats stored in seasons.format
+const (
+	FormatLeague            = "league"
+	FormatSingleElimination = "single_elimination"
+	FormatDoubleElimination = "double_elimination"
+)
+
+// Bracket sides stored in bracket_matches.bracket
+const (
+	BracketWinners = "winners"
+	BracketLosers  = "losers"
+	BracketFinal   = "final"
+)
+
+// BracketLink points at the slot a player moves into after a bracket match
+type BracketLink struct {
+	Node int `json:"node"`
+	Slot int `json:"slot"`
+}
+
+// BracketNode is a playable match of a bracket plan. Players are nil until
+// they are known, either from seeding, a bye or an earlier result.
+type BracketNode struct {
+	Bracket    string       `json:"bracket"`
+	Round      int          `json:"round"`
+	Position   int          `json:"position"`
+	DateIndex  int          `json:"-"`
+	PlayerId1  *int32       `json:"playerId1"`
+	PlayerId2  *int32       `json:"playerId2"`
+	WinnerNext *BracketLink `json:"winnerNext"`
+	LoserNext  *BracketLink `json:"loserNext"`
+}
+
+type bracketSourceKind int
+
+const (
+	sourceEmpty bracketSourceKind = iota
+	sourcePlayer
+	sourceWinnerOf
+	sourceLoserOf
+)
+
+// bracketSource describes where the occupant of a slot comes from
+type bracketSource struct {
+	kind     bracketSourceKind
+	playerId int32
+	node     int
+}
+
+type rawBracketNode struct {
+	bracket   string
+	round     int
+	position  int
+	dateIndex int
+	slots     [2]bracketSource
+}
+
+// PlanBracket lays out a single or double elimination bracket for players
+// ordered by seed. The field is padded to the next power of two with byes that
+// go to the top seeds; matches left with a single participant are skipped and
+// that participant is wired straight into the following slot.
+func PlanBracket(seeds []int32, format string) ([]BracketNode, error) {
+	if len(seeds) < 2 {
+		return nil, errors.New("at least two players are required for a bracket")
+	}
+	if format != FormatSingleElimination && format != FormatDoubleElimination {
+		return nil, fmt.Errorf("unknown bracket format %q", format)
+	}
+
+	size, rounds := 1, 0
+	for size < len(seeds) {
+		size *= 2
+		rounds++
+	}
+
+	var raw []rawBracketNode
+	add := func(node rawBracketNode) int {
+		raw = append(raw, node)
+		return len(raw) - 1
+	}
+
+	// Winners bracket
+	order := seedOrder(size)
+	winners := make([][]int, rounds+1)
+	for i := 0; i < size/2; i++ {
+		winners[1] = append(winners[1], add(rawBracketNode{
+			bracket:  BracketWinners,
+			round:    1,
+			position: i + 1,
+			slots:    [2]bracketSource{seedSource(seeds, order[2*i]), seedSource(seeds, order[2*i+1])},
+		}))
+	}
+	for round := 2; round <= rounds; round++ {
+		previous := winners[round-1]
+		for i := 0; i < len(previous)/2; i++ {
+			winners[round] = append(winners[round], add(rawBracketNode{
+				bracket:   BracketWinners,
+				round:     round,
+				position:  i + 1,
+				dateIndex: round - 1,
+				slots: [2]bracketSource{
+					{kind: sourceWinnerOf, node: previous[2*i]},
+					{kind: sourceWinnerOf, node: previous[2*i+1]},
+				},
+			}))
+		}
+	}
+	winnersFinal := winners[rounds][0]
+
+	if format == FormatDoubleElimination {
+		// Losers bracket: odd rounds pair survivors, even rounds bring in the
+		// losers of the next winners round, in reverse order to delay rematches.
+		var previous []int
+		loserRounds := 2 * (rounds - 1)
+		for round := 1; round <= loserRounds; round++ {
+			var current []int
+			switch {
+			case round == 1:
+				for i := 0; i < len(winners[1])/2; i++ {
+					current = append(current, add(rawBracketNode{
+						bracket:   BracketLosers,
+						round:     round,
+						position:  i + 1,
+						dateIndex: round,
+						slots: [2]bracketSource{
+							{kind: sourceLoserOf, node: winners[1][2*i]},
+							{kind: sourceLoserOf, node: winners[1][2*i+1]},
+						},
+					}))
+				}
+			case round%2 == 0:
+				dropping := winners[round/2+1]
+				for i := range previous {
+					current = append(current, add(rawBracketNode{
+						bracket:   BracketLosers,
+						round:     round,
+						position:  i + 1,
+						dateIndex: round,
+						slots: [2]bracketSource{
+							{kind: sourceWinnerOf, node: previous[i]},
+							{kind: sourceLoserOf, node: dropping[len(dropping)-1-i]},
+						},
+					}))
+				}
+			default:
+				for i := 0; i < len(previous)/2; i++ {
+					current = append(current, add(rawBracketNode{
+						bracket:   BracketLosers,
+						round:     round,
+						position:  i + 1,
+						dateIndex: round,
+						slots: [2]bracketSource{
+							{kind: sourceWinnerOf, node: previous[2*i]},
+							{kind: sourceWinnerOf, node: previous[2*i+1]},
+						},
+					}))
+				}
+			}
+			previous = current
+		}
+
+		// Grand final between the winners and losers bracket champions
+		challenger := bracketSource{kind: sourceLoserOf, node: winnersFinal}
+		if len(previous) == 1 {
+			challenger = bracketSource{kind: sourceWinnerOf, node: previous[0]}
+		}
+		dateIndex := rounds
+		if loserRounds+1 > dateIndex {
+			dateIndex = loserRounds + 1
+		}
+		add(rawBracketNode{
+			bracket:   BracketFinal,
+			round:     1,
+			position:  1,
+			dateIndex: dateIndex,
+			slots:     [2]bracketSource{{kind: sourceWinnerOf, node: winnersFinal}, challenger},
+		})
+	}
+
+	return resolveBracket(raw), nil
+}
+
+// resolveBracket drops the matches that byes make unnecessary and rewires the
+// remaining ones. Nodes are visited in creation order, which always lists a
+// node after the nodes feeding it.
+func resolveBracket(raw []rawBracketNode) []BracketNode {
+	winnerOf := make([]bracketSource, len(raw))
+	loserOf := make([]bracketSource, len(raw))
+	resolved := make([][2]bracketSource, len(raw))
+	index := make([]int, len(raw))
+
+	resolve := func(source bracketSource) bracketSource {
+		switch source.kind {
+		case sourceWinnerOf:
+			return winnerOf[source.node]
+		case sourceLoserOf:
+			return loserOf[source.node]
+		}
+		return source
+	}
+
+	var nodes []BracketNode
+	for i, node := range raw {
+		slots := [2]bracketSource{resolve(node.slots[0]), resolve(node.slots[1])}
+		resolved[i] = slots
+		index[i] = -1
+
+		switch {
+		case slots[0].kind == sourceEmpty && slots[1].kind == sourceEmpty:
+			// Nobody reaches this match
+			winnerOf[i] = bracketSource{kind: sourceEmpty}
+			loserOf[i] = bracketSource{kind: sourceEmpty}
+		case slots[0].kind == sourceEmpty || slots[1].kind == sourceEmpty:
+			// A bye: the lone participant passes straight through
+			winnerOf[i] = slots[0]
+			if slots[0].kind == sourceEmpty {
+				winnerOf[i] = slots[1]
+			}
+			loserOf[i] = bracketSource{kind: sourceEmpty}
+		default:
+			index[i] = len(nodes)
+			winnerOf[i] = bracketSource{kind: sourceWinnerOf, node: i}
+			loserOf[i] = bracketSource{kind: sourceLoserOf, node: i}
+
+			planned := BracketNode{
+				Bracket:   node.bracket,
+				Round:     node.round,
+				Position:  node.position,
+				DateIndex: node.dateIndex,
+			}
+			if slots[0].kind == sourcePlayer {
+				planned.PlayerId1 = Ptr(slots[0].playerId)
+			}
+			if slots[1].kind == sourcePlayer {
+				planned.PlayerId2 = Ptr(slots[1].playerId)
+			}
+			nodes = append(nodes, planned)
+		}
+	}
+
+	// Link every playable match to the slots its winner and loser move into
+	for i, slots := range resolved {
+		if index[i] < 0 {
+			continue
+		}
+		for slot, source := range slots {
+			link := &BracketLink{Node: index[i], Slot: slot + 1}
+			switch source.kind {
+			case sourceWinnerOf:
+				nodes[index[source.node]].WinnerNext = link
+			case sourceLoserOf:
+				nodes[index[source.node]].LoserNext = link
+			}
+		}
+	}
+	return nodes
+}
+
+// seedOrder returns the seeds of a power-of-two bracket in slot order so that
+// seed 1 and seed 2 can only meet in the final (1, 8, 4, 5, 2, 7, 3, 6 for 8).
+func seedOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		next := make([]int, 0, len(order)*2)
+		for _, seed := range order {
+			next = append(next, seed, 2*len(order)+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+func seedSource(seeds []int32, seed int) bracketSource {
+	if seed > len(seeds) {
+		return bracketSource{kind: sourceEmpty}
+	}
+	return bracketSource{kind: sourcePlayer, playerId: seeds[seed-1]}
+}
+
+// bracketMatchPlayed tells whether a bracket match has a result, the players
+// in it can then no longer change
+func bracketMatchPlayed(row db.GetSeasonBracketRow) bool {
+	return row.Winnerid.Valid || row.Playerid1points != 0 || row.Playerid2points != 0
+}
+
+// AdvanceBracket moves the winner, and in double elimination the loser, of a
+// bracket match into their next slots. A corrected result replaces the
+// players moved before and a cleared one takes them out, which is refused once
+// the next match is played. Matches outside a bracket are left alone.
+func AdvanceBracket(ctx context.Context, queries *db.Queries, match db.Match) error {
+	node, err := queries.GetBracketMatchByMatchId(ctx, match.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get bracket match: %w", err)
+	}
+	rows, err := queries.GetSeasonBracket(ctx, node.Seasonid)
+	if err != nil {
+		return fmt.Errorf("failed to get bracket: %w", err)
+	}
+	bracket := make(map[int32]db.GetSeasonBracketRow, len(rows))
+	for _, row := range rows {
+		bracket[row.Matchid] = row
+	}
+
+	var winner, loser pgtype.Int4
+	if match.Winnerid.Valid {
+		winner, loser = match.Winnerid, match.Playerid1
+		if match.Playerid1.Int32 == match.Winnerid.Int32 {
+			loser = match.Playerid2
+		}
+	}
+
+	if err := assignBracketSlot(ctx, queries, bracket, node.Winnernextmatchid, node.Winnernextslot, winner); err != nil {
+		return err
+	}
+	return assignBracketSlot(ctx, queries, bracket, node.Losernextmatchid, node.Losernextslot, loser)
+}
+
+func assignBracketSlot(
+	ctx context.Context,
+	queries *db.Queries,
+	bracket map[int32]db.GetSeasonBracketRow,
+	matchId pgtype.Int4,
+	slot pgtype.Int4,
+	player pgtype.Int4,
+) error {
+	if !matchId.Valid || !slot.Valid {
+		return nil
+	}
+
+	next := bracket[matchId.Int32]
+	current := next.Playerid1
+	if slot.Int32 == 2 {
+		current = next.Playerid2
+	}
+	if current.Valid == player.Valid && (!player.Valid || current.Int32 == player.Int32) {
+		return nil
+	}
+	if bracketMatchPlayed(next) {
+		return apierror.Conflict(
+			apierror.CodeConflict,
+			fmt.Sprintf("Match %d was already played with the previous result, correct it first", matchId.Int32),
+		)
+	}
+
+	var err error
+	if slot.Int32 == 1 {
+		err = queries.AssignMatchPlayer1(ctx, db.AssignMatchPlayer1Params{Playerid1: player, ID: matchId.Int32})
+	} else {
+		err = queries.AssignMatchPlayer2(ctx, db.AssignMatchPlayer2Params{Playerid2: player, ID: matchId.Int32})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to advance player into match %d: %w", matchId.Int32, err)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package api_server
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestSeedOrder(t *testing.T) {
+	tests := []struct {
+		size int
+		want []int
+	}{
+		{1, []int{1}},
+		{2, []int{1, 2}},
+		{4, []int{1, 4, 2, 3}},
+		{8, []int{1, 8, 4, 5, 2, 7, 3, 6}},
+	}
+	for _, tt := range tests {
+		if got := seedOrder(tt.size); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("seedOrder(%d) = %v, want %v", tt.size, got, tt.want)
+		}
+	}
+}
+
+// playBracket plays a bracket plan in order with the better seed, the lower
+// player ID, winning every match. It returns the losses of every player and
+// the winner of the last match.
+func playBracket(t *testing.T, nodes []BracketNode) (map[int32]int, int32) {
+	t.Helper()
+	players := make([][2]*int32, len(nodes))
+	for i, node := range nodes {
+		players[i] = [2]*int32{node.PlayerId1, node.PlayerId2}
+	}
+
+	losses := map[int32]int{}
+	var champion int32
+	for i, node := range nodes {
+		if players[i][0] == nil || players[i][1] == nil {
+			t.Fatalf("%s round %d match %d is played without two players", node.Bracket, node.Round, node.Position)
+		}
+		winner, loser := *players[i][0], *players[i][1]
+		if loser < winner {
+			winner, loser = loser, winner
+		}
+		losses[loser]++
+		champion = winner
+
+		if next := node.WinnerNext; next != nil {
+			players[next.Node][next.Slot-1] = Ptr(winner)
+		}
+		if next := node.LoserNext; next != nil {
+			if nodes[next.Node].Bracket == BracketWinners {
+				t.Fatalf("the loser of %s round %d match %d drops into the winners bracket", node.Bracket, node.Round, node.Position)
+			}
+			players[next.Node][next.Slot-1] = Ptr(loser)
+		}
+	}
+	return losses, champion
+}
+
+func bracketSeeds(count int) []int32 {
+	seeds := make([]int32, count)
+	for i := range seeds {
+		seeds[i] = int32(i + 1)
+	}
+	return seeds
+}
+
+func TestPlanBracket(t *testing.T) {
+	tests := []struct {
+		name        string
+		players     int
+		format      string
+		wantMatches int
+		// firstRound lists the seeds playing in the first winners round,
+		// the others have a bye
+		firstRound []int32
+	}{
+		{"single two players", 2, FormatSingleElimination, 1, []int32{1, 2}},
+		{"single power of two", 8, FormatSingleElimination, 7, []int32{1, 2, 3, 4, 5, 6, 7, 8}},
+		{"single three players", 3, FormatSingleElimination, 2, []int32{2, 3}},
+		{"single six players", 6, FormatSingleElimination, 5, []int32{3, 4, 5, 6}},
+		{"double two players", 2, FormatDoubleElimination, 2, []int32{1, 2}},
+		{"double power of two", 8, FormatDoubleElimination, 14, []int32{1, 2, 3, 4, 5, 6, 7, 8}},
+		{"double five players", 5, FormatDoubleElimination, 8, []int32{4, 5}},
+		{"double six players", 6, FormatDoubleElimination, 10, []int32{3, 4, 5, 6}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, err := PlanBracket(bracketSeeds(tt.players), tt.format)
+			if err != nil {
+				t.Fatalf("planning the bracket failed: %v", err)
+			}
+			if len(nodes) != tt.wantMatches {
+				t.Fatalf("got %d matches, want %d", len(nodes), tt.wantMatches)
+			}
+
+			// Byes go to the top seeds, who only enter in a later round
+			var firstRound []int32
+			for _, node := range nodes {
+				if node.Bracket != BracketWinners || node.Round != 1 {
+					continue
+				}
+				for _, player := range []*int32{node.PlayerId1, node.PlayerId2} {
+					if player != nil {
+						firstRound = append(firstRound, *player)
+					}
+				}
+			}
+			sort.Slice(firstRound, func(i, j int) bool { return firstRound[i] < firstRound[j] })
+			if !reflect.DeepEqual(firstRound, tt.firstRound) {
+				t.Fatalf("seeds %v play in the first round, want %v", firstRound, tt.firstRound)
+			}
+
+			// Everybody but the champion is knocked out after one loss, or
+			// after two in double elimination
+			losses, champion := playBracket(t, nodes)
+			if champion != 1 {
+				t.Fatalf("seed %d won the bracket, want seed 1", champion)
+			}
+			lives := 1
+			if tt.format == FormatDoubleElimination {
+				lives = 2
+			}
+			for player := int32(2); player <= int32(tt.players); player++ {
+				if losses[player] != lives {
+					t.Fatalf("seed %d lost %d times, want %d", player, losses[player], lives)
+				}
+			}
+		})
+	}
+}
+
+func TestPlanBracketDoubleEliminationFinal(t *testing.T) {
+	nodes, err := PlanBracket(bracketSeeds(4), FormatDoubleElimination)
+	if err != nil {
+		t.Fatalf("planning the bracket failed: %v", err)
+	}
+
+	final := len(nodes) - 1
+	if nodes[final].Bracket != BracketFinal {
+		t.Fatalf("the last match is in the %s bracket, want the final", nodes[final].Bracket)
+	}
+	for i, node := range nodes[:final] {
+		if node.WinnerNext == nil && node.LoserNext == nil {
+			t.Fatalf("%s round %d match %d leads nowhere", node.Bracket, node.Round, node.Position)
+		}
+		if node.Bracket == BracketWinners && node.LoserNext == nil {
+			t.Fatalf("the loser of winners round %d match %d is knocked out", node.Round, node.Position)
+		}
+		if node.Bracket == BracketLosers && node.LoserNext != nil {
+			t.Fatalf("the loser of losers round %d match %d plays on", node.Round, node.Position)
+		}
+		if i > 0 && node.DateIndex < nodes[i-1].DateIndex && node.Bracket == nodes[i-1].Bracket {
+			t.Fatalf("%s round %d is scheduled before the round feeding it", node.Bracket, node.Round)
+		}
+	}
+	if nodes[final].WinnerNext != nil || nodes[final].LoserNext != nil {
+		t.Fatal("the final leads to another match")
+	}
+}
+
+func TestPlanBracketErrors(t *testing.T) {
+	if _, err := PlanBracket(bracketSeeds(1), FormatSingleElimination); err == nil {
+		t.Fatal("a bracket of one player was planned")
+	}
+	if _, err := PlanBracket(bracketSeeds(4), FormatLeague); err == nil {
+		t.Fatal("a league was planned as a bracket")
+	}
+}
@@ -0,0 +1,379 @@
+package api_server
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/mail"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Field types of the custom player and match columns
+const (
+	FieldTypeText         = string(api.Text)
+	FieldTypeInteger      = string(api.Integer)
+	FieldTypeDecimal      = string(api.Decimal)
+	FieldTypeBoolean      = string(api.Boolean)
+	FieldTypeDate         = string(api.Date)
+	FieldTypeEmail        = string(api.Email)
+	FieldTypePhone        = string(api.Phone)
+	FieldTypeSingleSelect = string(api.SingleSelect)
+	FieldTypeMultiSelect  = string(api.MultiSelect)
+)
+
+// phonePattern is a phone number once spaces and punctuation are removed
+var phonePattern = regexp.MustCompile(`^\+?[0-9]{7,15}$`)
+
+// CustomField is the definition a custom value is checked against
+type CustomField struct {
+	ID         int32
+	Name       string
+	FieldType  string
+	Options    []string
+	IsRequired bool
+}
+
+// PlayerCustomField returns the definition of a custom player column
+func PlayerCustomField(column db.PlayerCustomColumn) CustomField {
+	return CustomField{
+		ID:         column.ID,
+		Name:       column.Name,
+		FieldType:  column.FieldType,
+		Options:    column.Options,
+		IsRequired: column.IsRequired.Valid && column.IsRequired.Bool,
+	}
+}
+
+// MatchCustomField returns the definition of a custom match column
+func MatchCustomField(column db.MatchCustomColumn) CustomField {
+	return CustomField{
+		ID:         column.ID,
+		Name:       column.Name,
+		FieldType:  column.FieldType,
+		Options:    column.Options,
+		IsRequired: column.IsRequired.Valid && column.IsRequired.Bool,
+	}
+}
+
+// CustomColumn is the whole definition of a custom player or match column, as
+// it is created and updated
+type CustomColumn struct {
+	CustomField
+	Description  pgtype.Text
+	IsActive     pgtype.Bool
+	DisplayOrder pgtype.Int4
+}
+
+// PlayerCustomColumn returns the whole definition of a custom player column
+func PlayerCustomColumn(column db.PlayerCustomColumn) CustomColumn {
+	return CustomColumn{
+		CustomField:  PlayerCustomField(column),
+		Description:  column.Description,
+		IsActive:     column.IsActive,
+		DisplayOrder: column.DisplayOrder,
+	}
+}
+
+// MatchCustomColumn returns the whole definition of a custom match column
+func MatchCustomColumn(column db.MatchCustomColumn) CustomColumn {
+	return CustomColumn{
+		CustomField:  MatchCustomField(column),
+		Description:  column.Description,
+		IsActive:     column.IsActive,
+		DisplayOrder: column.DisplayOrder,
+	}
+}
+
+// NewCustomColumn validates the definition of a new column. Match columns are
+// created with the same parameters as player columns.
+func NewCustomColumn(params api.CreatePlayerCustomColumnParams) (CustomColumn, error) {
+	return CustomColumn{}.Merge(api.UpdatePlayerCustomColumnParams{
+		Name:         &params.Name,
+		FieldType:    &params.FieldType,
+		Options:      params.Options,
+		Description:  params.Description,
+		IsRequired:   params.IsRequired,
+		DisplayOrder: params.DisplayOrder,
+	})
+}
+
+// Merge applies the changes sent to update a column and validates the result,
+// the fields left out keep their value. Match columns are updated with the
+// same parameters as player columns.
+func (c CustomColumn) Merge(changes api.UpdatePlayerCustomColumnParams) (CustomColumn, error) {
+	if changes.Name != nil {
+		c.Name = *changes.Name
+	}
+	if changes.FieldType != nil {
+		c.FieldType = string(*changes.FieldType)
+		// Options left over from a select type do not carry to another type
+		if changes.Options == nil && c.FieldType != FieldTypeSingleSelect && c.FieldType != FieldTypeMultiSelect {
+			c.Options = nil
+		}
+	}
+	if changes.Options != nil {
+		c.Options = *changes.Options
+	}
+	options, err := ValidateCustomColumn(c.FieldType, c.Options)
+	if err != nil {
+		return CustomColumn{}, err
+	}
+	c.Options = options
+	if changes.Description != nil {
+		c.Description = pgtype.Text{String: *changes.Description, Valid: true}
+	}
+	if changes.IsRequired != nil {
+		c.IsRequired = *changes.IsRequired
+	}
+	// Deactivating hides the column and keeps its values, unlike a delete
+	if changes.IsActive != nil {
+		c.IsActive = pgtype.Bool{Bool: *changes.IsActive, Valid: true}
+	}
+	if changes.DisplayOrder != nil {
+		c.DisplayOrder = pgtype.Int4{Int32: int32(*changes.DisplayOrder), Valid: true}
+	}
+	return c, nil
+}
+
+// ValidateCustomColumn checks the type of a column definition and returns its
+// options without blanks. Only the select types have options, at least one.
+func ValidateCustomColumn(fieldType string, options []string) ([]string, error) {
+	cleaned := make([]string, 0, len(options))
+	for _, option := range options {
+		option = strings.TrimSpace(option)
+		if option == "" {
+			continue
+		}
+		if slices.Contains(cleaned, option) {
+			return nil, apierror.Validation(apierror.CodeInvalidCustomColumn, fmt.Sprintf("Option %q is listed twice", option))
+		}
+		cleaned = append(cleaned, option)
+	}
+
+	switch fieldType {
+	case FieldTypeSingleSelect, FieldTypeMultiSelect:
+		if len(cleaned) == 0 {
+			return nil, apierror.Validation(apierror.CodeInvalidCustomColumn, fmt.Sprintf("A %s column needs at least one option", fieldType))
+		}
+	case FieldTypeText, FieldTypeInteger, FieldTypeDecimal, FieldTypeBoolean, FieldTypeDate, FieldTypeEmail, FieldTypePhone:
+		if len(cleaned) > 0 {
+			return nil, apierror.Validation(apierror.CodeInvalidCustomColumn, fmt.Sprintf("Only select columns have options, not %s columns", fieldType))
+		}
+	default:
+		return nil, apierror.Validation(apierror.CodeInvalidCustomColumn, fmt.Sprintf("Unknown field type %q", fieldType))
+	}
+	return cleaned, nil
+}
+
+// Normalize checks a value sent by a client and returns how it is stored. A
+// null or blank value clears the column, which a required column refuses.
+func (f CustomField) Normalize(value interface{}) (pgtype.Text, error) {
+	if text, ok := value.(string); ok {
+		value = strings.TrimSpace(text)
+	}
+	if value == nil || value == "" {
+		if f.IsRequired {
+			return pgtype.Text{}, f.invalid("is required")
+		}
+		return pgtype.Text{Valid: false}, nil
+	}
+
+	stored, err := f.normalize(value)
+	if err != nil {
+		return pgtype.Text{}, err
+	}
+	return pgtype.Text{String: stored, Valid: true}, nil
+}
+
+func (f CustomField) normalize(value interface{}) (string, error) {
+	text, isText := value.(string)
+
+	switch f.FieldType {
+	case FieldTypeText:
+		if !isText {
+			return "", f.invalid("must be a string")
+		}
+		return text, nil
+
+	case FieldTypeInteger:
+		switch v := value.(type) {
+		case float64:
+			if v != math.Trunc(v) || math.Abs(v) > math.MaxInt64 {
+				return "", f.invalid("must be a whole number")
+			}
+			return strconv.FormatInt(int64(v), 10), nil
+		case string:
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return "", f.invalid("must be a whole number")
+			}
+			return strconv.FormatInt(n, 10), nil
+		}
+		return "", f.invalid("must be a whole number")
+
+	case FieldTypeDecimal:
+		number, ok := value.(float64)
+		if isText {
+			parsed, err := strconv.ParseFloat(text, 64)
+			number, ok = parsed, err == nil
+		}
+		if !ok || math.IsNaN(number) || math.IsInf(number, 0) {
+			return "", f.invalid("must be a number")
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+
+	case FieldTypeBoolean:
+		if b, ok := value.(bool); ok {
+			return strconv.FormatBool(b), nil
+		}
+		switch strings.ToLower(text) {
+		case "true", "yes", "1":
+			return "true", nil
+		case "false", "no", "0":
+			return "false", nil
+		}
+		return "", f.invalid("must be true or false")
+
+	case FieldTypeDate:
+		date, err := time.Parse(time.DateOnly, text)
+		if !isText || err != nil {
+			return "", f.invalid("must be a date formatted as YYYY-MM-DD")
+		}
+		return date.Format(time.DateOnly), nil
+
+	case FieldTypeEmail:
+		address, err := mail.ParseAddress(text)
+		if !isText || err != nil || address.Name != "" || address.Address != text {
+			return "", f.invalid("must be an email address")
+		}
+		return strings.ToLower(address.Address), nil
+
+	case FieldTypePhone:
+		phone := strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "").Replace(text)
+		if !isText || !phonePattern.MatchString(phone) {
+			return "", f.invalid("must be a phone number")
+		}
+		return phone, nil
+
+	case FieldTypeSingleSelect:
+		if !isText || !slices.Contains(f.Options, text) {
+			return "", f.invalid(fmt.Sprintf("must be one of %s", strings.Join(f.Options, ", ")))
+		}
+		return text, nil
+
+	case FieldTypeMultiSelect:
+		var choices []string
+		switch v := value.(type) {
+		case []interface{}:
+			for _, item := range v {
+				choice, ok := item.(string)
+				if !ok {
+					return "", f.invalid("must be a list of options")
+				}
+				choices = append(choices, strings.TrimSpace(choice))
+			}
+		case string:
+			for _, choice := range strings.Split(v, ",") {
+				choices = append(choices, strings.TrimSpace(choice))
+			}
+		default:
+			return "", f.invalid("must be a list of options")
+		}
+
+		// Stored in the order of the options, without duplicates
+		selected := make([]string, 0, len(choices))
+		for _, option := range f.Options {
+			if slices.Contains(choices, option) {
+				selected = append(selected, option)
+			}
+		}
+		for _, choice := range choices {
+			if choice != "" && !slices.Contains(f.Options, choice) {
+				return "", f.invalid(fmt.Sprintf("must only hold options among %s", strings.Join(f.Options, ", ")))
+			}
+		}
+		if len(selected) == 0 && f.IsRequired {
+			return "", f.invalid("is required")
+		}
+		stored, err := json.Marshal(selected)
+		if err != nil {
+			return "", err
+		}
+		return string(stored), nil
+	}
+	return "", f.invalid(fmt.Sprintf("has an unknown field type %q", f.FieldType))
+}
+
+func (f CustomField) invalid(reason string) error {
+	return apierror.Validation(apierror.CodeInvalidCustomValue, fmt.Sprintf("%s %s", f.Name, reason))
+}
+
+// DecodeCustomValue returns a stored value as typed JSON. Values stored before
+// the column had a type, which may not parse, are returned as they are.
+func DecodeCustomValue(fieldType string, stored pgtype.Text) interface{} {
+	if !stored.Valid {
+		return nil
+	}
+	switch fieldType {
+	case FieldTypeInteger:
+		if n, err := strconv.ParseInt(stored.String, 10, 64); err == nil {
+			return n
+		}
+	case FieldTypeDecimal:
+		if n, err := strconv.ParseFloat(stored.String, 64); err == nil {
+			return n
+		}
+	case FieldTypeBoolean:
+		if b, err := strconv.ParseBool(stored.String); err == nil {
+			return b
+		}
+	case FieldTypeMultiSelect:
+		var selected []string
+		if err := json.Unmarshal([]byte(stored.String), &selected); err == nil {
+			return selected
+		}
+	}
+	return stored.String
+}
+
+// NormalizeCustomValues checks values keyed by column name against the fields
+// and returns them keyed by column ID. With checkRequired, a required field
+// must have a value either in values or in existing.
+func NormalizeCustomValues(
+	fields []CustomField,
+	values map[string]interface{},
+	existing map[int32]bool,
+	checkRequired bool,
+) (map[int32]pgtype.Text, error) {
+	normalized := make(map[int32]pgtype.Text, len(values))
+	for name, value := range values {
+		index := slices.IndexFunc(fields, func(field CustomField) bool { return field.Name == name })
+		if index < 0 {
+			return nil, apierror.Validation(apierror.CodeInvalidCustomValue, fmt.Sprintf("There is no active custom column named %q", name))
+		}
+		stored, err := fields[index].Normalize(value)
+		if err != nil {
+			return nil, err
+		}
+		normalized[fields[index].ID] = stored
+	}
+
+	if checkRequired {
+		for _, field := range fields {
+			// Normalize already refused to clear a required field
+			if _, provided := normalized[field.ID]; field.IsRequired && !provided && !existing[field.ID] {
+				return nil, field.invalid("is required")
+			}
+		}
+	}
+	return normalized, nil
+}
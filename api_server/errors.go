@@ -0,0 +1,22 @@
+package api_server
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/gameplan-backend/apierror"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolation is the Postgres error code of a broken UNIQUE constraint
+const uniqueViolation = "23505"
+
+// duplicateNameError reports that the user already has a record of that kind
+// with the same name. Other errors are returned unchanged.
+func duplicateNameError(err error, kind string, name string) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return apierror.Conflict(apierror.CodeDuplicateName, fmt.Sprintf("You already have a %s named %q", kind, name))
+	}
+	return err
+}
@@ -0,0 +1,111 @@
+package api_server
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// fakeQuery answers a query with the columns of the row it returns, or with
+// an error such as pgx.ErrNoRows. Exec queries only return the error.
+type fakeQuery func(args []any) ([]any, error)
+
+// fakeDB stands in for Postgres in tests. Queries are answered by the name
+// sqlc gives them, any other query fails the test.
+type fakeDB struct {
+	t       *testing.T
+	queries map[string]fakeQuery
+}
+
+func newFakeDB(t *testing.T, queries map[string]fakeQuery) *fakeDB {
+	return &fakeDB{t: t, queries: queries}
+}
+
+func (f *fakeDB) answer(sql string, args []any) ([]any, error) {
+	name := sql
+	if fields := strings.Fields(sql); len(fields) > 2 && fields[0] == "--" && fields[1] == "name:" {
+		name = fields[2]
+	}
+	query, ok := f.queries[name]
+	if !ok {
+		f.t.Fatalf("unexpected query %s", name)
+	}
+	return query(args)
+}
+
+func (f *fakeDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	_, err := f.answer(sql, args)
+	return pgconn.CommandTag{}, err
+}
+
+func (f *fakeDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	f.t.Fatalf("unexpected query of several rows: %s", sql)
+	return nil, nil
+}
+
+func (f *fakeDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	columns, err := f.answer(sql, args)
+	return fakeRow{columns: columns, err: err}
+}
+
+// Begin lets the fake stand in for the pool too. Its transactions answer the
+// same queries and are never rolled back.
+func (f *fakeDB) Begin(ctx context.Context) (pgx.Tx, error) {
+	return fakeTx{db: f}, nil
+}
+
+// fakeTx implements the pgx.Tx methods the queries use, calling any other
+// panics
+type fakeTx struct {
+	pgx.Tx
+	db *fakeDB
+}
+
+func (tx fakeTx) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	return tx.db.Exec(ctx, sql, args...)
+}
+
+func (tx fakeTx) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	return tx.db.Query(ctx, sql, args...)
+}
+
+func (tx fakeTx) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return tx.db.QueryRow(ctx, sql, args...)
+}
+
+func (tx fakeTx) Commit(ctx context.Context) error {
+	return nil
+}
+
+func (tx fakeTx) Rollback(ctx context.Context) error {
+	return nil
+}
+
+type fakeRow struct {
+	columns []any
+	err     error
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	for i, column := range r.columns {
+		reflect.ValueOf(dest[i]).Elem().Set(reflect.ValueOf(column))
+	}
+	return nil
+}
+
+// rowOf returns the fields of a db model as the columns sqlc scans them into
+func rowOf(model any) []any {
+	value := reflect.ValueOf(model)
+	columns := make([]any, value.NumField())
+	for i := range columns {
+		columns[i] = value.Field(i).Interface()
+	}
+	return columns
+}
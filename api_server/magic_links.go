@@ -0,0 +1,164 @@
+package api_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/stytchauth/stytch-go/v16/stytch/consumer/magiclinks"
+	"github.com/stytchauth/stytch-go/v16/stytch/consumer/magiclinks/email"
+	"github.com/stytchauth/stytch-go/v16/stytch/consumer/stytchapi"
+	"github.com/stytchauth/stytch-go/v16/stytch/stytcherror"
+)
+
+// magicLinkRequestsPerHour is how many magic and password reset links one
+// address can be sent within the hour CountRecentMagicLinkRequests looks
+// back on
+const magicLinkRequestsPerHour = 5
+
+// magicLinkExpirationMinutes is how long a magic link can be used
+const magicLinkExpirationMinutes = 15
+
+// MagicLinkPath is the page of the app magic links open, with the token and
+// the purpose in the query. It must be allowed as a login redirect URL in
+// Stytch.
+const MagicLinkPath = "/auth/magic-link"
+
+// MagicLinkClient sends and authenticates magic links, StytchMagicLinks in
+// production and a fake in tests
+type MagicLinkClient interface {
+	Send(ctx context.Context, params *email.SendParams) (*email.SendResponse, error)
+	Authenticate(ctx context.Context, params *magiclinks.AuthenticateParams) (*magiclinks.AuthenticateResponse, error)
+}
+
+// StytchMagicLinks is the MagicLinkClient backed by the Stytch API
+type StytchMagicLinks struct {
+	Client *stytchapi.API
+}
+
+func (m StytchMagicLinks) Send(ctx context.Context, params *email.SendParams) (*email.SendResponse, error) {
+	return m.Client.MagicLinks.Email.Send(ctx, params)
+}
+
+func (m StytchMagicLinks) Authenticate(ctx context.Context, params *magiclinks.AuthenticateParams) (*magiclinks.AuthenticateResponse, error) {
+	return m.Client.MagicLinks.Authenticate(ctx, params)
+}
+
+// checkEmailLinkRateLimit counts a link sent to an address, failing once the
+// address was sent too many
+func (s *AuthServer) checkEmailLinkRateLimit(ctx context.Context, address string) error {
+	if err := s.DB.DeleteExpiredMagicLinkRequests(ctx); err != nil {
+		return fmt.Errorf("failed to delete expired magic link requests: %w", err)
+	}
+	// The request is recorded before counting, so concurrent ones cannot all pass
+	rateLimitKey := strings.ToLower(address)
+	if err := s.DB.CreateMagicLinkRequest(ctx, rateLimitKey); err != nil {
+		return fmt.Errorf("failed to record magic link request: %w", err)
+	}
+	requests, err := s.DB.CountRecentMagicLinkRequests(ctx, rateLimitKey)
+	if err != nil {
+		return fmt.Errorf("failed to count magic link requests: %w", err)
+	}
+	if requests > magicLinkRequestsPerHour {
+		return apierror.RateLimited("Too many links were requested for this email, try again in an hour")
+	}
+	return nil
+}
+
+// SendMagicLink emails a link to log in, which also verifies the address. An
+// address without an account gets nothing, without the caller being told, so
+// the call cannot be used to find out who has an account. Every call counts
+// against the rate limit of the address.
+func (s *AuthServer) SendMagicLink(ctx context.Context, address string, purpose api.SendMagicLinkParamsPurpose) error {
+	address = strings.TrimSpace(address)
+	if !strings.Contains(address, "@") {
+		return apierror.Validation(apierror.CodeInvalidEmail, "Invalid email address format")
+	}
+	if err := s.checkEmailLinkRateLimit(ctx, address); err != nil {
+		return err
+	}
+
+	if _, err := s.DB.GetUserByEmail(ctx, address); errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	_, err := s.MagicLinks.Send(ctx, &email.SendParams{
+		Email:                  address,
+		LoginMagicLinkURL:      s.AppURL + MagicLinkPath + "?purpose=" + url.QueryEscape(string(purpose)),
+		LoginExpirationMinutes: magicLinkExpirationMinutes,
+	})
+	var stytchErr stytcherror.Error
+	if errors.As(err, &stytchErr) && stytchErr.StatusCode < http.StatusInternalServerError {
+		// The account is unknown to Stytch, which is not reported either
+		s.Logger.Warnf("Magic link not sent: %v", err)
+		return nil
+	}
+	if err != nil {
+		return apierror.Upstream(apierror.CodeStytchError, "Failed to send magic link", err)
+	}
+	return nil
+}
+
+// AuthenticateMagicLink logs the owner of a magic link in and marks their
+// email as verified, returning their session token
+func (s *AuthServer) AuthenticateMagicLink(ctx context.Context, token string) (string, error) {
+	resp, err := s.MagicLinks.Authenticate(ctx, &magiclinks.AuthenticateParams{
+		Token:                  token,
+		SessionDurationMinutes: api.SessionDurationMinutes,
+	})
+	var stytchErr stytcherror.Error
+	if errors.As(err, &stytchErr) && stytchErr.StatusCode < http.StatusInternalServerError {
+		return "", apierror.New(http.StatusUnauthorized, apierror.CodeInvalidCredentials, "Invalid or expired magic link")
+	}
+	if err != nil {
+		return "", apierror.Upstream(apierror.CodeStytchError, "Failed to authenticate magic link", err)
+	}
+
+	if _, err := s.DB.VerifyUserByStytchId(ctx, resp.UserID); errors.Is(err, pgx.ErrNoRows) {
+		return "", apierror.Unauthenticated("Unknown user")
+	} else if err != nil {
+		return "", fmt.Errorf("failed to verify user: %w", err)
+	}
+	return resp.SessionToken, nil
+}
+
+// API endpoint implementations
+
+func (s *AuthServer) PostUsersSendVerificationEmail(ctx context.Context, request api.PostUsersSendVerificationEmailRequestObject) (api.PostUsersSendVerificationEmailResponseObject, error) {
+	purpose := api.Login
+	if request.Body.Purpose != nil {
+		purpose = *request.Body.Purpose
+	}
+
+	if err := s.SendMagicLink(ctx, request.Body.Email, purpose); err != nil {
+		return nil, err
+	}
+
+	return api.PostUsersSendVerificationEmail200JSONResponse(api.ApiResult{
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *AuthServer) PostUsersVerifyMagicLinkToken(ctx context.Context, request api.PostUsersVerifyMagicLinkTokenRequestObject) (api.PostUsersVerifyMagicLinkTokenResponseObject, error) {
+	sessionToken, err := s.AuthenticateMagicLink(ctx, request.Body.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	responseData := map[string]any{
+		"message": "Login successful",
+		"token":   sessionToken,
+	}
+	return api.PostUsersVerifyMagicLinkToken200JSONResponse(api.ApiResult{
+		Data:      &responseData,
+		IsSuccess: Ptr(true),
+	}), nil
+}
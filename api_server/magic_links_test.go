@@ -0,0 +1,150 @@
+package api_server
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/labstack/echo/v4"
+	"github.com/stytchauth/stytch-go/v16/stytch/consumer/magiclinks"
+	"github.com/stytchauth/stytch-go/v16/stytch/consumer/magiclinks/email"
+	"github.com/stytchauth/stytch-go/v16/stytch/stytcherror"
+)
+
+// fakeMagicLinks is a MagicLinkClient that records the emails links are sent
+// to and logs in the Stytch users of the tokens it knows
+type fakeMagicLinks struct {
+	sent []string
+	// stytchUsers maps the token of a link to the Stytch user it logs in
+	stytchUsers map[string]string
+}
+
+func (m *fakeMagicLinks) Send(ctx context.Context, params *email.SendParams) (*email.SendResponse, error) {
+	m.sent = append(m.sent, params.Email)
+	return &email.SendResponse{StatusCode: http.StatusOK}, nil
+}
+
+func (m *fakeMagicLinks) Authenticate(ctx context.Context, params *magiclinks.AuthenticateParams) (*magiclinks.AuthenticateResponse, error) {
+	stytchUser, ok := m.stytchUsers[params.Token]
+	if !ok {
+		return nil, stytcherror.Error{StatusCode: http.StatusUnauthorized}
+	}
+	return &magiclinks.AuthenticateResponse{
+		StatusCode:   http.StatusOK,
+		UserID:       stytchUser,
+		SessionToken: "session-" + params.Token,
+	}, nil
+}
+
+// newMagicLinkServer returns an AuthServer over the users given by email, and
+// the fake its links are sent with
+func newMagicLinkServer(t *testing.T, users map[string]*db.User) (*AuthServer, *fakeMagicLinks) {
+	requests := map[string]int64{}
+	queries := db.New(newFakeDB(t, map[string]fakeQuery{
+		"DeleteExpiredMagicLinkRequests": func(args []any) ([]any, error) {
+			return nil, nil
+		},
+		"CreateMagicLinkRequest": func(args []any) ([]any, error) {
+			requests[args[0].(string)]++
+			return nil, nil
+		},
+		"CountRecentMagicLinkRequests": func(args []any) ([]any, error) {
+			return []any{requests[args[0].(string)]}, nil
+		},
+		"GetUserByEmail": func(args []any) ([]any, error) {
+			user, ok := users[args[0].(string)]
+			if !ok {
+				return nil, pgx.ErrNoRows
+			}
+			return []any{user.ID, user.Email, user.Isverified}, nil
+		},
+		"VerifyUserByStytchId": func(args []any) ([]any, error) {
+			for _, user := range users {
+				if user.Stytchid == args[0].(string) {
+					user.Isverified = true
+					return rowOf(*user), nil
+				}
+			}
+			return nil, pgx.ErrNoRows
+		},
+	}))
+
+	magicLinks := &fakeMagicLinks{stytchUsers: map[string]string{}}
+	return &AuthServer{
+		DB:         queries,
+		MagicLinks: magicLinks,
+		AppURL:     "https://app.example.com",
+		Logger:     echo.New().Logger,
+	}, magicLinks
+}
+
+func TestSendMagicLinkToUnknownEmail(t *testing.T) {
+	server, magicLinks := newMagicLinkServer(t, map[string]*db.User{
+		"known@example.com": {ID: 1, Stytchid: "user-test-1", Email: "known@example.com"},
+	})
+
+	if err := server.SendMagicLink(context.Background(), "unknown@example.com", api.Login); err != nil {
+		t.Fatalf("sending to an unknown email failed: %v", err)
+	}
+	if len(magicLinks.sent) != 0 {
+		t.Fatalf("links were sent to %v, want none", magicLinks.sent)
+	}
+
+	if err := server.SendMagicLink(context.Background(), "known@example.com", api.Login); err != nil {
+		t.Fatalf("sending to a known email failed: %v", err)
+	}
+	if len(magicLinks.sent) != 1 || magicLinks.sent[0] != "known@example.com" {
+		t.Fatalf("links were sent to %v, want known@example.com", magicLinks.sent)
+	}
+}
+
+func TestSendMagicLinkRateLimit(t *testing.T) {
+	server, magicLinks := newMagicLinkServer(t, map[string]*db.User{
+		"known@example.com": {ID: 1, Stytchid: "user-test-1", Email: "known@example.com"},
+	})
+
+	for i := 1; i <= magicLinkRequestsPerHour; i++ {
+		if err := server.SendMagicLink(context.Background(), "known@example.com", api.Login); err != nil {
+			t.Fatalf("request %d failed: %v", i, err)
+		}
+	}
+
+	// The limit is per address, whatever its case
+	err := server.SendMagicLink(context.Background(), "Known@Example.com", api.Login)
+	var apiErr *apierror.Error
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusTooManyRequests {
+		t.Fatalf("request %d returned %v, want a rate limit error", magicLinkRequestsPerHour+1, err)
+	}
+	if len(magicLinks.sent) != magicLinkRequestsPerHour {
+		t.Fatalf("%d links were sent, want %d", len(magicLinks.sent), magicLinkRequestsPerHour)
+	}
+}
+
+func TestAuthenticateMagicLinkVerifiesEmail(t *testing.T) {
+	user := &db.User{ID: 1, Stytchid: "user-test-1", Email: "known@example.com"}
+	server, magicLinks := newMagicLinkServer(t, map[string]*db.User{user.Email: user})
+	magicLinks.stytchUsers["link-token"] = user.Stytchid
+
+	if _, err := server.AuthenticateMagicLink(context.Background(), "expired-token"); err == nil {
+		t.Fatal("an unknown token was accepted")
+	}
+	if user.Isverified {
+		t.Fatal("an unknown token verified the user")
+	}
+
+	sessionToken, err := server.AuthenticateMagicLink(context.Background(), "link-token")
+	if err != nil {
+		t.Fatalf("authenticating the link failed: %v", err)
+	}
+	if sessionToken != "session-link-token" {
+		t.Fatalf("got session token %q, want the one of the link", sessionToken)
+	}
+	if !user.Isverified {
+		t.Fatal("the user was not verified")
+	}
+}
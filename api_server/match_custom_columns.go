@@ -0,0 +1,286 @@
+package api_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// SeasonMatch is a match listed with the values of its custom columns, keyed
+// by column name
+type SeasonMatch struct {
+	db.Match
+	CustomValues map[string]interface{} `json:"customValues"`
+}
+
+// WithMatchCustomValues attaches the custom values of a season's matches to
+// them, inactive columns are left out
+func WithMatchCustomValues(
+	ctx context.Context,
+	queries *db.Queries,
+	seasonId int32,
+	matches []db.Match,
+) ([]SeasonMatch, error) {
+	values, err := queries.GetSeasonMatchCustomValues(ctx, pgtype.Int4{Int32: seasonId, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match custom values: %w", err)
+	}
+
+	byMatch := make(map[int32]map[string]interface{})
+	for _, value := range values {
+		if !value.Value.Valid {
+			continue
+		}
+		if byMatch[value.MatchID.Int32] == nil {
+			byMatch[value.MatchID.Int32] = make(map[string]interface{})
+		}
+		byMatch[value.MatchID.Int32][value.ColumnName] = DecodeCustomValue(value.FieldType, value.Value)
+	}
+
+	seasonMatches := make([]SeasonMatch, 0, len(matches))
+	for _, match := range matches {
+		customValues := byMatch[match.ID]
+		if customValues == nil {
+			customValues = make(map[string]interface{})
+		}
+		seasonMatches = append(seasonMatches, SeasonMatch{Match: match, CustomValues: customValues})
+	}
+	return seasonMatches, nil
+}
+
+// GetMatchCustomColumn retrieves a custom column of the user
+func (s *MatchesServer) GetMatchCustomColumn(
+	ctx context.Context,
+	userId int32,
+	columnId int32,
+) (*db.MatchCustomColumn, error) {
+	column, err := s.DB.GetMatchCustomColumn(ctx, db.GetMatchCustomColumnParams{
+		ID:     columnId,
+		Userid: userId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound(fmt.Sprintf("Custom column %d not found", columnId))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match custom column: %w", err)
+	}
+	return &column, nil
+}
+
+// DeleteMatchCustomColumn removes a custom column with all of its values
+func (s *MatchesServer) DeleteMatchCustomColumn(
+	ctx context.Context,
+	userId int32,
+	columnId int32,
+) error {
+	if _, err := s.GetMatchCustomColumn(ctx, userId, columnId); err != nil {
+		return err
+	}
+
+	tx, err := s.DBPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.DB.WithTx(tx)
+
+	if err := qtx.DeleteMatchCustomValuesByColumn(ctx, pgtype.Int4{Int32: columnId, Valid: true}); err != nil {
+		return fmt.Errorf("failed to delete match custom values: %w", err)
+	}
+	if err := qtx.DeleteMatchCustomColumn(ctx, db.DeleteMatchCustomColumnParams{
+		ID:     columnId,
+		Userid: userId,
+	}); err != nil {
+		return fmt.Errorf("failed to delete match custom column: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// API endpoint implementations
+
+func (s *MatchesServer) GetUsersUserIdCustomMatchColumns(ctx context.Context, request api.GetUsersUserIdCustomMatchColumnsRequestObject) (api.GetUsersUserIdCustomMatchColumnsResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.DB.GetMatchCustomColumns(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match custom columns: %w", err)
+	}
+
+	columnsMap := map[string]interface{}{
+		"columns": columns,
+	}
+	return api.GetUsersUserIdCustomMatchColumns200JSONResponse(api.ApiResult{
+		Data:      &columnsMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *MatchesServer) PostUsersUserIdCustomMatchColumns(ctx context.Context, request api.PostUsersUserIdCustomMatchColumnsRequestObject) (api.PostUsersUserIdCustomMatchColumnsResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := NewCustomColumn(api.CreatePlayerCustomColumnParams(*request.Body))
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckCustomColumnLimit(ctx, s.DB, userID); err != nil {
+		return nil, err
+	}
+
+	column, err := s.DB.CreateMatchCustomColumn(ctx, db.CreateMatchCustomColumnParams{
+		Userid:       userID,
+		Name:         definition.Name,
+		FieldType:    definition.FieldType,
+		Options:      definition.Options,
+		Description:  definition.Description,
+		IsRequired:   pgtype.Bool{Bool: definition.IsRequired, Valid: true},
+		DisplayOrder: definition.DisplayOrder,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create match custom column: %w", duplicateNameError(err, "custom match column", definition.Name))
+	}
+
+	columnMap := map[string]interface{}{
+		"column": column,
+	}
+	return api.PostUsersUserIdCustomMatchColumns200JSONResponse(api.ApiResult{
+		Data:      &columnMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *MatchesServer) PutUsersUserIdCustomMatchColumnsColumnId(ctx context.Context, request api.PutUsersUserIdCustomMatchColumnsColumnIdRequestObject) (api.PutUsersUserIdCustomMatchColumnsColumnIdResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get current column to preserve unchanged fields
+	current, err := s.GetMatchCustomColumn(ctx, userID, int32(request.ColumnId))
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := MatchCustomColumn(*current).Merge(api.UpdatePlayerCustomColumnParams(*request.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	column, err := s.DB.UpdateMatchCustomColumn(ctx, db.UpdateMatchCustomColumnParams{
+		ID:           current.ID,
+		Userid:       userID,
+		Name:         definition.Name,
+		FieldType:    definition.FieldType,
+		Options:      definition.Options,
+		Description:  definition.Description,
+		IsRequired:   pgtype.Bool{Bool: definition.IsRequired, Valid: true},
+		IsActive:     definition.IsActive,
+		DisplayOrder: definition.DisplayOrder,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update match custom column: %w", duplicateNameError(err, "custom match column", definition.Name))
+	}
+
+	columnMap := map[string]interface{}{
+		"column": column,
+	}
+	return api.PutUsersUserIdCustomMatchColumnsColumnId200JSONResponse(api.ApiResult{
+		Data:      &columnMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *MatchesServer) DeleteUsersUserIdCustomMatchColumnsColumnId(ctx context.Context, request api.DeleteUsersUserIdCustomMatchColumnsColumnIdRequestObject) (api.DeleteUsersUserIdCustomMatchColumnsColumnIdResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DeleteMatchCustomColumn(ctx, userID, int32(request.ColumnId)); err != nil {
+		return nil, err
+	}
+
+	return api.DeleteUsersUserIdCustomMatchColumnsColumnId200JSONResponse(api.ApiResult{
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *MatchesServer) GetMatchesMatchIdCustomColumns(ctx context.Context, request api.GetMatchesMatchIdCustomColumnsRequestObject) (api.GetMatchesMatchIdCustomColumnsResponseObject, error) {
+	values, err := s.DB.GetMatchCustomValues(ctx, pgtype.Int4{Int32: int32(request.MatchId), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match custom values: %w", err)
+	}
+
+	customMap := make(map[string]interface{})
+	for _, cv := range values {
+		if cv.Value.Valid {
+			customMap[cv.ColumnName] = DecodeCustomValue(cv.FieldType, cv.Value)
+		}
+	}
+
+	valuesMap := map[string]interface{}{
+		"values":       values,
+		"customValues": customMap,
+	}
+	return api.GetMatchesMatchIdCustomColumns200JSONResponse(api.ApiResult{
+		Data:      &valuesMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *MatchesServer) PutMatchesMatchIdCustomColumns(ctx context.Context, request api.PutMatchesMatchIdCustomColumnsRequestObject) (api.PutMatchesMatchIdCustomColumnsResponseObject, error) {
+	// Match columns are defined by the owner of the match's season
+	owner, err := s.DB.GetMatchOwner(ctx, int32(request.MatchId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get match owner: %w", err)
+	}
+
+	column, err := s.GetMatchCustomColumn(ctx, owner.Int32, int32(request.Body.ColumnId))
+	if err != nil {
+		return nil, err
+	}
+	if column.IsActive.Valid && !column.IsActive.Bool {
+		return nil, apierror.Validation(apierror.CodeInvalidField, fmt.Sprintf("Custom column %q is inactive", column.Name))
+	}
+
+	var raw interface{}
+	if request.Body.Value != nil {
+		raw = *request.Body.Value
+	}
+	normalized, err := MatchCustomField(*column).Normalize(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := s.DB.UpsertMatchCustomValue(ctx, db.UpsertMatchCustomValueParams{
+		MatchID:  pgtype.Int4{Int32: int32(request.MatchId), Valid: true},
+		ColumnID: pgtype.Int4{Int32: column.ID, Valid: true},
+		Value:    normalized,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to upsert match custom value: %w", err)
+	}
+
+	valueMap := map[string]interface{}{
+		"value": value,
+	}
+	return api.PutMatchesMatchIdCustomColumns200JSONResponse(api.ApiResult{
+		Data:      &valueMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
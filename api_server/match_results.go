@@ -0,0 +1,212 @@
+package api_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ApplyMatchResultRules validates a match about to be saved by the caller and
+// derives its winnerId from the points. The rules are:
+//   - the two players must be different players owned by the owner of the
+//     season, whoever enters the match
+//   - points cannot be negative, and can only be recorded with two players
+//   - results cannot be entered for a match dated after today unless
+//     allowFutureResult is set
+//
+// A match with equal, non-zero points is a draw and has no winner.
+func ApplyMatchResultRules(ctx context.Context, queries *db.Queries, match *db.Match, allowFutureResult bool) error {
+	if match.Playerid1.Valid && match.Playerid2.Valid && match.Playerid1.Int32 == match.Playerid2.Int32 {
+		return apierror.Validation(apierror.CodeSamePlayer, "A match needs two different players")
+	}
+
+	var seasonOwner pgtype.Int4
+	if match.Playerid1.Valid || match.Playerid2.Valid {
+		var err error
+		seasonOwner, err = queries.GetSeasonOwner(ctx, match.Seasonid.Int32)
+		if err != nil {
+			return fmt.Errorf("failed to get season owner: %w", err)
+		}
+	}
+	for _, player := range []pgtype.Int4{match.Playerid1, match.Playerid2} {
+		if !player.Valid {
+			continue
+		}
+		owner, err := queries.GetPlayerOwner(ctx, player.Int32)
+		if errors.Is(err, pgx.ErrNoRows) || (err == nil && (!owner.Valid || owner != seasonOwner)) {
+			return apierror.Validation(apierror.CodeInvalidPlayer, fmt.Sprintf("Player %d is not one of the players of the season's owner", player.Int32))
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get player owner: %w", err)
+		}
+	}
+
+	if match.Playerid1points < 0 || match.Playerid2points < 0 {
+		return apierror.Validation(apierror.CodeInvalidScore, "Points cannot be negative")
+	}
+
+	hasResult := match.Playerid1points != 0 || match.Playerid2points != 0
+	if hasResult && (!match.Playerid1.Valid || !match.Playerid2.Valid) {
+		return apierror.Validation(apierror.CodeInvalidScore, "Points can only be recorded once both players are set")
+	}
+	if hasResult && !allowFutureResult && match.Matchdate.Valid && isAfterToday(match.Matchdate.Time) {
+		return apierror.Validation(
+			apierror.CodeFutureResult,
+			fmt.Sprintf("The match is dated %s, results can only be entered for past matches", match.Matchdate.Time.Format(time.DateOnly)),
+		)
+	}
+
+	match.Winnerid = DeriveWinner(match.Playerid1, match.Playerid2, match.Playerid1points, match.Playerid2points)
+	return nil
+}
+
+// CheckResultOnlyUpdate checks that a caller whose role on the season is below
+// admin, a scorekeeper, only changed the points of a match. API keys limited
+// to the results:write scope are held to the same rule.
+func CheckResultOnlyUpdate(ctx context.Context, role string, current db.Match, updated db.Match) error {
+	principal, _ := api.PrincipalFromContext(ctx)
+	if api.RoleAtLeast(role, api.RoleAdmin) && !api.ResultsOnly(principal) {
+		return nil
+	}
+	if updated.Seasonid != current.Seasonid ||
+		updated.Playerid1 != current.Playerid1 ||
+		updated.Playerid2 != current.Playerid2 ||
+		updated.Group != current.Group ||
+		updated.Isactive != current.Isactive ||
+		updated.Matchdate.Valid != current.Matchdate.Valid ||
+		!updated.Matchdate.Time.Equal(current.Matchdate.Time) {
+		return apierror.Forbidden("Scorekeepers and results:write API keys can only enter the points of a match")
+	}
+	return nil
+}
+
+// DeriveWinner returns the player with more points, or no winner for a draw
+// or a match without a result
+func DeriveWinner(playerId1, playerId2 pgtype.Int4, points1, points2 int32) pgtype.Int4 {
+	switch {
+	case points1 > points2:
+		return playerId1
+	case points2 > points1:
+		return playerId2
+	}
+	return pgtype.Int4{Valid: false}
+}
+
+// isAfterToday compares calendar dates in UTC, which is how match dates are stored
+func isAfterToday(date time.Time) bool {
+	today := time.Now().UTC().Format(time.DateOnly)
+	return date.UTC().Format(time.DateOnly) > today
+}
+
+// mergeMatchUpdate applies the fields present in a batch item to the stored match
+func mergeMatchUpdate(current db.Match, update api.DbMatch) db.Match {
+	merged := current
+	if update.SeasonId != nil {
+		merged.Seasonid = pgtype.Int4{Int32: int32(*update.SeasonId), Valid: true}
+	}
+	if update.PlayerId1 != nil {
+		merged.Playerid1 = pgtype.Int4{Int32: int32(*update.PlayerId1), Valid: true}
+	}
+	if update.PlayerId2 != nil {
+		merged.Playerid2 = pgtype.Int4{Int32: int32(*update.PlayerId2), Valid: true}
+	}
+	if update.PlayerId1Points != nil {
+		merged.Playerid1points = int32(*update.PlayerId1Points)
+	}
+	if update.PlayerId2Points != nil {
+		merged.Playerid2points = int32(*update.PlayerId2Points)
+	}
+	if update.Group != nil {
+		merged.Group = int32(*update.Group)
+	}
+	if update.MatchDate != nil {
+		merged.Matchdate = pgtype.Date{Time: update.MatchDate.Time, Valid: true}
+	}
+	return merged
+}
+
+// applyMatchField sets a single match field from a key/value update, where
+// the value has been decoded from JSON
+func applyMatchField(match *db.Match, key string, value interface{}) error {
+	invalid := apierror.Validation(apierror.CodeInvalidField, fmt.Sprintf("Invalid value for %s", key))
+
+	optionalId := func() (pgtype.Int4, error) {
+		if value == nil {
+			return pgtype.Int4{Valid: false}, nil
+		}
+		n, ok := value.(float64)
+		if !ok || n != float64(int32(n)) {
+			return pgtype.Int4{}, invalid
+		}
+		return pgtype.Int4{Int32: int32(n), Valid: true}, nil
+	}
+	integer := func() (int32, error) {
+		n, ok := value.(float64)
+		if !ok || n != float64(int32(n)) {
+			return 0, invalid
+		}
+		return int32(n), nil
+	}
+
+	var err error
+	switch key {
+	case "seasonId":
+		match.Seasonid, err = optionalId()
+		if err == nil && !match.Seasonid.Valid {
+			err = invalid
+		}
+	case "playerId1":
+		match.Playerid1, err = optionalId()
+	case "playerId2":
+		match.Playerid2, err = optionalId()
+	case "playerId1Points":
+		match.Playerid1points, err = integer()
+	case "playerId2Points":
+		match.Playerid2points, err = integer()
+	case "group":
+		match.Group, err = integer()
+	case "matchDate":
+		raw, ok := value.(string)
+		if !ok {
+			return invalid
+		}
+		date, parseErr := time.Parse(time.DateOnly, raw)
+		if parseErr != nil {
+			return invalid
+		}
+		match.Matchdate = pgtype.Date{Time: date, Valid: true}
+	case "isActive":
+		active, ok := value.(bool)
+		if !ok {
+			return invalid
+		}
+		match.Isactive = active
+	default:
+		return apierror.Validation(apierror.CodeInvalidField, fmt.Sprintf("Unknown match field %s", key))
+	}
+	return err
+}
+
+// matchUpdateParams copies a validated match into the parameters of UpdateMatch
+func matchUpdateParams(match db.Match, userId int32) db.UpdateMatchParams {
+	return db.UpdateMatchParams{
+		Seasonid:        match.Seasonid,
+		Playerid1:       match.Playerid1,
+		Playerid1points: match.Playerid1points,
+		Playerid2:       match.Playerid2,
+		Playerid2points: match.Playerid2points,
+		Matchdate:       match.Matchdate,
+		Winnerid:        match.Winnerid,
+		Group:           match.Group,
+		Isactive:        match.Isactive,
+		ID:              match.ID,
+		Userid:          pgtype.Int4{Int32: userId, Valid: true},
+	}
+}
@@ -2,11 +2,16 @@ package api_server
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 
 	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
 	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/mailgun/mailgun-go/v4"
 	"github.com/stripe/stripe-go/v81/client"
 	"github.com/stytchauth/stytch-go/v16/stytch/consumer/stytchapi"
@@ -16,36 +21,197 @@ type MatchesServer struct {
 	StytchClient *stytchapi.API
 	StripeClient *client.API
 	DB           *db.Queries
+	DBPool       *pgxpool.Pool
 	Emailer      *mailgun.MailgunImpl
+	// Events streams the changes of matches to the watchers of their season
+	Events *SeasonEvents
+}
+
+// BatchItemError reports why one item of a batch update was not saved
+type BatchItemError struct {
+	Index   int    `json:"index"`
+	MatchId *int   `json:"matchId,omitempty"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// publishMatchEvents sends the events of a committed change to a match. The
+// change is saved either way, so failures are only logged.
+func (s *MatchesServer) publishMatchEvents(ctx context.Context, eventType string, match db.Match, previousSeasonId int32) {
+	events, err := MatchEvents(eventType, match, previousSeasonId)
+	if err == nil {
+		err = s.Events.Publish(ctx, events...)
+	}
+	if err != nil {
+		s.Events.Logger.Errorf("Failed to publish %s event for match %d: %v", eventType, match.ID, err)
+	}
+}
+
+// UpdateMatchBatch saves a batch of match updates in one transaction. Every
+// item runs in its own savepoint so that all failures can be reported: in
+// atomic mode any failure rolls back the whole batch, otherwise the failed
+// items are skipped and the others are committed.
+func (s *MatchesServer) UpdateMatchBatch(
+	ctx context.Context,
+	userId int32,
+	items []api.DbMatch,
+	atomic bool,
+	allowFutureResults bool,
+) (int32, []BatchItemError, error) {
+	tx, err := s.DBPool.Begin(ctx)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	var updatedMatchesCount int32 = 0
+	itemErrors := []BatchItemError{}
+	var updated, previous []db.Match
+	for index, item := range items {
+		savepoint, err := tx.Begin(ctx)
+		if err != nil {
+			return 0, nil, fmt.Errorf("failed to create savepoint: %w", err)
+		}
+
+		before, after, err := s.updateBatchItem(ctx, s.DB.WithTx(savepoint), userId, item, allowFutureResults)
+		if err != nil {
+			if rollbackErr := savepoint.Rollback(ctx); rollbackErr != nil {
+				return 0, nil, fmt.Errorf("failed to roll back savepoint: %w", rollbackErr)
+			}
+			// Unexpected errors abort the batch, rule violations are reported per item
+			itemErr := apierror.From(err)
+			if itemErr.Status >= http.StatusInternalServerError {
+				return 0, nil, err
+			}
+			itemErrors = append(itemErrors, BatchItemError{
+				Index:   index,
+				MatchId: item.Id,
+				Code:    itemErr.Code,
+				Message: itemErr.Message,
+			})
+			continue
+		}
+		if err := savepoint.Commit(ctx); err != nil {
+			return 0, nil, fmt.Errorf("failed to release savepoint: %w", err)
+		}
+		updatedMatchesCount++
+		previous = append(previous, before)
+		updated = append(updated, after)
+	}
+
+	if atomic && len(itemErrors) > 0 {
+		return 0, itemErrors, nil
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, nil, fmt.Errorf("failed to commit batch: %w", err)
+	}
+
+	for i, match := range updated {
+		s.publishMatchEvents(ctx, EventScoreUpdated, match, previous[i].Seasonid.Int32)
+	}
+	return updatedMatchesCount, itemErrors, nil
+}
+
+// updateBatchItem validates and saves one item of a batch update, returning
+// the match before and after it
+func (s *MatchesServer) updateBatchItem(
+	ctx context.Context,
+	queries *db.Queries,
+	userId int32,
+	item api.DbMatch,
+	allowFutureResults bool,
+) (db.Match, db.Match, error) {
+	if item.Id == nil {
+		return db.Match{}, db.Match{}, apierror.Validation(apierror.CodeInvalidField, "Every match in the batch needs an id")
+	}
+
+	// Moving a match is only allowed into a season the caller administers
+	if item.SeasonId != nil {
+		if err := api.AuthorizeSeason(ctx, queries, int32(*item.SeasonId), api.RoleAdmin); err != nil {
+			return db.Match{}, db.Match{}, err
+		}
+	}
+
+	current, err := queries.GetMatch(ctx, db.GetMatchParams{
+		ID:     int32(*item.Id),
+		Userid: pgtype.Int4{Int32: userId, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return db.Match{}, db.Match{}, apierror.NotFound(fmt.Sprintf("Match %d not found", *item.Id))
+	}
+	if err != nil {
+		return db.Match{}, db.Match{}, fmt.Errorf("failed to get match: %w", err)
+	}
+	role, err := api.SeasonRole(ctx, queries, current.Seasonid.Int32)
+	if err == nil {
+		err = api.CheckRole(role, api.RoleScorekeeper)
+	}
+	if err != nil {
+		return db.Match{}, db.Match{}, err
+	}
+
+	match := mergeMatchUpdate(current, item)
+	if err := CheckResultOnlyUpdate(ctx, role, current, match); err != nil {
+		return db.Match{}, db.Match{}, err
+	}
+	if err := ApplyMatchResultRules(ctx, queries, &match, allowFutureResults); err != nil {
+		return db.Match{}, db.Match{}, err
+	}
+
+	updated, err := queries.UpdateMatch(ctx, matchUpdateParams(match, userId))
+	if err != nil {
+		return db.Match{}, db.Match{}, fmt.Errorf("failed to update match: %w", err)
+	}
+
+	// Elimination seasons move the result into the next round
+	if err := AdvanceBracket(ctx, queries, updated); err != nil {
+		return db.Match{}, db.Match{}, err
+	}
+	return current, updated, nil
 }
 
 func (s *MatchesServer) PostMatches(ctx context.Context, request api.PostMatchesRequestObject) (api.PostMatchesResponseObject, error) {
+	if err := api.AuthorizeSeason(ctx, s.DB, int32(request.Body.SeasonId), api.RoleAdmin); err != nil {
+		return nil, err
+	}
+
+	match := db.Match{
+		Seasonid:  pgtype.Int4{Int32: int32(request.Body.SeasonId), Valid: true},
+		Playerid1: pgtype.Int4{Int32: int32(request.Body.PlayerId1), Valid: true},
+		Playerid2: pgtype.Int4{Int32: int32(request.Body.PlayerId2), Valid: true},
+		Group:     int32(request.Body.Group),
+		Matchdate: pgtype.Date{Time: request.Body.MatchDate.Time, Valid: true},
+	}
+	if request.Body.PlayerId1Points != nil {
+		match.Playerid1points = int32(*request.Body.PlayerId1Points)
+	}
+	if request.Body.PlayerId2Points != nil {
+		match.Playerid2points = int32(*request.Body.PlayerId2Points)
+	}
+
+	allowFutureResult := request.Body.AllowFutureResult != nil && *request.Body.AllowFutureResult
+	if err := ApplyMatchResultRules(ctx, s.DB, &match, allowFutureResult); err != nil {
+		return nil, err
+	}
+
 	// Prepare database parameters with proper pgtype conversions
 	params := db.CreateMatchParams{
-		Seasonid:        pgtype.Int4{Int32: int32(request.Body.SeasonId), Valid: true},
-		Playerid1:       pgtype.Int4{Int32: int32(request.Body.PlayerId1), Valid: true},
-		Playerid2:       pgtype.Int4{Int32: int32(request.Body.PlayerId2), Valid: true},
-		Playerid1points: 0,
-		Playerid2points: 0,
-		Winnerid:        pgtype.Int4{Valid: false},
-		Group:           int32(request.Body.Group),
-		Matchdate:       pgtype.Date{Time: request.Body.MatchDate.Time, Valid: true},
+		Seasonid:        match.Seasonid,
+		Playerid1:       match.Playerid1,
+		Playerid2:       match.Playerid2,
+		Playerid1points: match.Playerid1points,
+		Playerid2points: match.Playerid2points,
+		Winnerid:        match.Winnerid,
+		Group:           match.Group,
+		Matchdate:       match.Matchdate,
 	}
 
 	// Create match in database
-	_, err := s.DB.CreateMatch(ctx, params)
+	created, err := s.DB.CreateMatch(ctx, params)
 	if err != nil {
-		return api.PostMatches200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to create match: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
+	s.publishMatchEvents(ctx, EventMatchCreated, created, 0)
 
 	return api.PostMatches200JSONResponse(api.ApiResult{
 		IsSuccess: Ptr(true),
@@ -53,65 +219,136 @@ func (s *MatchesServer) PostMatches(ctx context.Context, request api.PostMatches
 }
 
 func (s *MatchesServer) PutMatchesBatches(ctx context.Context, request api.PutMatchesBatchesRequestObject) (api.PutMatchesBatchesResponseObject, error) {
-	var updatedMatchesCount int32 = 0
-	for _, match := range *request.Body {
-		params := db.UpdateMatchParams{
-			Seasonid:        pgtype.Int4{Int32: int32(*match.SeasonId), Valid: match.SeasonId != nil},
-			Playerid1:       pgtype.Int4{Int32: int32(*match.PlayerId1), Valid: match.PlayerId1 != nil},
-			Playerid2:       pgtype.Int4{Int32: int32(*match.PlayerId2), Valid: match.PlayerId2 != nil},
-			Playerid1points: int32(*match.PlayerId1Points),
-			Playerid2points: int32(*match.PlayerId2Points),
-			Group:           int32(*match.Group),
-			Matchdate:       pgtype.Date{Time: match.MatchDate.Time, Valid: match.MatchDate != nil},
-			ID:              int32(*match.Id),
-		}
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-		_, err := s.DB.UpdateMatch(ctx, params)
-		if err != nil {
-			fmt.Printf("Failed to update match: %v\n", err)
-			continue
-		}
-		updatedMatchesCount++
+	atomic := request.Params.Mode == nil || *request.Params.Mode == api.Atomic
+	allowFutureResults := request.Params.AllowFutureResults != nil && *request.Params.AllowFutureResults
+	updatedMatchesCount, itemErrors, err := s.UpdateMatchBatch(ctx, userID, *request.Body, atomic, allowFutureResults)
+	if err != nil {
+		return nil, err
+	}
+
+	batchData := map[string]interface{}{
+		"updatedMatchesCount": updatedMatchesCount,
+		"errors":              itemErrors,
+	}
+	if atomic && len(itemErrors) > 0 {
+		return nil, apierror.Validation(
+			apierror.CodeBatchFailed,
+			fmt.Sprintf("%d of %d matches failed, no match was updated", len(itemErrors), len(*request.Body)),
+		).WithDetails(batchData)
 	}
 
 	return api.PutMatchesBatches200JSONResponse(api.ApiResult{
-		Data: &map[string]interface{}{
-			"updatedMatchesCount": updatedMatchesCount,
-		},
+		Data:      &batchData,
 		IsSuccess: Ptr(true),
 	}), nil
 }
 
 func (s *MatchesServer) PostMatchesUnassignPlayerFromMatch(ctx context.Context, request api.PostMatchesUnassignPlayerFromMatchRequestObject) (api.PostMatchesUnassignPlayerFromMatchResponseObject, error) {
-	return api.PostMatchesUnassignPlayerFromMatch200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("PostMatchesUnassignPlayerFromMatch not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
-	}), nil
+	return nil, apierror.NotImplemented("PostMatchesUnassignPlayerFromMatch not implemented yet")
 }
 
 func (s *MatchesServer) DeleteMatchesMatchId(ctx context.Context, request api.DeleteMatchesMatchIdRequestObject) (api.DeleteMatchesMatchIdResponseObject, error) {
-	// TODO: Implement the logic to delete the match from the database.
-	// For now, return a successful response.
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The match is read first, its season gets the events
+	match, err := s.DB.GetMatch(ctx, db.GetMatchParams{
+		ID:     int32(request.MatchId),
+		Userid: pgtype.Int4{Int32: userID, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound(fmt.Sprintf("Match %d not found", request.MatchId))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.DB.DeleteMatch(ctx, db.DeleteMatchParams{
+		ID:     match.ID,
+		Userid: pgtype.Int4{Int32: userID, Valid: true},
+	}); err != nil {
+		return nil, err
+	}
+	s.publishMatchEvents(ctx, EventMatchDeleted, match, 0)
+
 	return api.DeleteMatchesMatchId200JSONResponse(api.ApiResult{
 		IsSuccess: Ptr(true),
 	}), nil
 }
 
 func (s *MatchesServer) PutMatchesMatchId(ctx context.Context, request api.PutMatchesMatchIdRequestObject) (api.PutMatchesMatchIdResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	match, err := s.DB.GetMatch(ctx, db.GetMatchParams{
+		ID:     int32(request.MatchId),
+		Userid: pgtype.Int4{Int32: userID, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound(fmt.Sprintf("Match %d not found", request.MatchId))
+	}
+	if err != nil {
+		return nil, err
+	}
+	previous := match
+	role, err := api.SeasonRole(ctx, s.DB, previous.Seasonid.Int32)
+	if err != nil {
+		return nil, err
+	}
+
+	err = applyMatchField(&match, request.Body.Key, request.Body.Value)
+	if err == nil {
+		err = CheckResultOnlyUpdate(ctx, role, previous, match)
+	}
+	if err == nil && request.Body.Key == "seasonId" {
+		// Moving a match is only allowed into a season the caller administers
+		if err := api.AuthorizeSeason(ctx, s.DB, match.Seasonid.Int32, api.RoleAdmin); err != nil {
+			return nil, err
+		}
+	}
+	if err == nil {
+		allowFutureResult := request.Body.AllowFutureResult != nil && *request.Body.AllowFutureResult
+		err = ApplyMatchResultRules(ctx, s.DB, &match, allowFutureResult)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The result and its move into the next round are saved together
+	tx, err := s.DBPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := s.DB.WithTx(tx)
+	updated, err := qtx.UpdateMatch(ctx, matchUpdateParams(match, userID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update match: %w", err)
+	}
+	// Elimination seasons move the result into the next round
+	if err := AdvanceBracket(ctx, qtx, updated); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit match: %w", err)
+	}
+	s.publishMatchEvents(ctx, EventScoreUpdated, updated, previous.Seasonid.Int32)
+
+	matchMap := map[string]interface{}{
+		"match": updated,
+	}
 	return api.PutMatchesMatchId200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("PutMatchesMatchId not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
+		Data:      &matchMap,
+		IsSuccess: Ptr(true),
 	}), nil
 }
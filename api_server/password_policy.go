@@ -0,0 +1,111 @@
+package api_server
+
+import (
+	_ "embed"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gameplan-backend/apierror"
+)
+
+// Bounds of the password length, in characters
+const (
+	PasswordMinLength = 10
+	PasswordMaxLength = 128
+)
+
+// passphraseLength is the length from which a password needs no mix of
+// character classes
+const passphraseLength = 16
+
+//go:embed common_passwords.txt
+var commonPasswordList string
+
+// commonPasswords are refused whatever the rules say, compared in lower case
+var commonPasswords = parseCommonPasswords(commonPasswordList)
+
+// parseCommonPasswords reads a list of one password per line, skipping blank
+// lines and # comments
+func parseCommonPasswords(list string) map[string]bool {
+	passwords := make(map[string]bool)
+	for _, line := range strings.Split(list, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		passwords[strings.ToLower(line)] = true
+	}
+	return passwords
+}
+
+// ValidatePassword applies the password policy shared by sign up, reset and
+// change. The password needs PasswordMinLength characters and a mix of three
+// of lower case, upper case, digits and symbols, unless it is a passphrase of
+// passphraseLength characters. It cannot contain the name of the email or be
+// a common password. Every broken rule is listed in the details.
+func ValidatePassword(password string, email string) error {
+	var problems []string
+
+	length := utf8.RuneCountInString(password)
+	if length < PasswordMinLength {
+		problems = append(problems, fmt.Sprintf("Password must be at least %d characters", PasswordMinLength))
+	}
+	if length > PasswordMaxLength {
+		problems = append(problems, fmt.Sprintf("Password must be at most %d characters", PasswordMaxLength))
+	}
+	if length < passphraseLength && passwordClasses(password) < 3 {
+		problems = append(problems, fmt.Sprintf("Password must mix three of lower case letters, upper case letters, digits and symbols, or be at least %d characters", passphraseLength))
+	}
+
+	lower := strings.ToLower(password)
+	name, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(email)), "@")
+	if len(name) >= 3 && strings.Contains(lower, name) {
+		problems = append(problems, "Password must not contain your email")
+	}
+	if commonPasswords[lower] {
+		problems = append(problems, "Password is too common")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return apierror.Validation(apierror.CodeWeakPassword, problems[0]).WithDetails(map[string]interface{}{
+		"problems": problems,
+	})
+}
+
+// passwordClasses counts the character classes used by a password
+func passwordClasses(password string) int {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := 0
+	for _, used := range []bool{lower, upper, digit, symbol} {
+		if used {
+			classes++
+		}
+	}
+	return classes
+}
+
+// ValidatePasswordConfirmation checks that the password was typed the same
+// twice
+func ValidatePasswordConfirmation(password string, confirmation string) error {
+	if password != confirmation {
+		return apierror.Validation(apierror.CodeInvalidField, "Password confirmation does not match")
+	}
+	return nil
+}
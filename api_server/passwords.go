@@ -0,0 +1,192 @@
+package api_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/jackc/pgx/v5"
+	"github.com/stytchauth/stytch-go/v16/stytch/consumer/passwords/email"
+	"github.com/stytchauth/stytch-go/v16/stytch/consumer/passwords/existingpassword"
+	"github.com/stytchauth/stytch-go/v16/stytch/stytcherror"
+)
+
+// resetPasswordExpirationMinutes is how long a password reset link can be used
+const resetPasswordExpirationMinutes = 30
+
+// ResetPasswordPath is the page of the app reset links open, with the token
+// in the query. It must be allowed as a reset password redirect URL in Stytch.
+const ResetPasswordPath = "/auth/reset-password"
+
+// passwordError maps the Stytch error of a password reset, rejected is
+// reported when the credentials were refused
+func passwordError(err error, rejected *apierror.Error) error {
+	var stytchErr stytcherror.Error
+	if !errors.As(err, &stytchErr) || stytchErr.StatusCode >= http.StatusInternalServerError {
+		return apierror.Upstream(apierror.CodeStytchError, "Failed to reset password", err)
+	}
+	// Stytch also checks the strength, against breached passwords among others
+	if stytchErr.ErrorType == "weak_password" {
+		return apierror.Validation(apierror.CodeWeakPassword, "Password is too weak")
+	}
+	return rejected
+}
+
+// SendPasswordResetLink emails a link to reset the password. Like magic
+// links, unknown addresses are not reported and the links count against the
+// same rate limit.
+func (s *AuthServer) SendPasswordResetLink(ctx context.Context, address string) error {
+	address = strings.TrimSpace(address)
+	if !strings.Contains(address, "@") {
+		return apierror.Validation(apierror.CodeInvalidEmail, "Invalid email address format")
+	}
+	if err := s.checkEmailLinkRateLimit(ctx, address); err != nil {
+		return err
+	}
+
+	if _, err := s.DB.GetUserByEmail(ctx, address); errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get user by email: %w", err)
+	}
+
+	_, err := s.StytchClient.Passwords.Email.ResetStart(ctx, &email.ResetStartParams{
+		Email:                          address,
+		ResetPasswordRedirectURL:       s.AppURL + ResetPasswordPath,
+		ResetPasswordExpirationMinutes: resetPasswordExpirationMinutes,
+	})
+	var stytchErr stytcherror.Error
+	if errors.As(err, &stytchErr) && stytchErr.StatusCode < http.StatusInternalServerError {
+		s.Logger.Warnf("Password reset link not sent: %v", err)
+		return nil
+	}
+	if err != nil {
+		return apierror.Upstream(apierror.CodeStytchError, "Failed to send password reset link", err)
+	}
+	return nil
+}
+
+// ResetPassword sets the password of the owner of a reset link, returning a
+// new session token. Stytch revokes all their other sessions.
+func (s *AuthServer) ResetPassword(ctx context.Context, token string, password string) (string, error) {
+	// The owner is unknown until the reset, so the email rule cannot be checked
+	if err := ValidatePassword(password, ""); err != nil {
+		return "", err
+	}
+
+	resp, err := s.StytchClient.Passwords.Email.Reset(ctx, &email.ResetParams{
+		Token:                  token,
+		Password:               password,
+		SessionDurationMinutes: api.SessionDurationMinutes,
+	})
+	if err != nil {
+		return "", passwordError(err, apierror.New(http.StatusUnauthorized, apierror.CodeInvalidCredentials, "Invalid or expired reset link"))
+	}
+
+	if err := s.DB.UpdateUserPassword(ctx, resp.UserID); err != nil {
+		return "", fmt.Errorf("failed to update user password: %w", err)
+	}
+	if resp.Session != nil {
+		s.Sessions.EvictUser(resp.UserID, resp.Session.SessionID)
+	}
+	return resp.SessionToken, nil
+}
+
+// ChangePassword replaces the password of the caller, who must know the
+// current one. Every other session of the caller is revoked and the returned
+// session token replaces the one of the request.
+func (s *AuthServer) ChangePassword(ctx context.Context, principal api.Principal, currentPassword string, newPassword string) (string, error) {
+	user, err := s.DB.GetUserByStytchId(ctx, principal.StytchUserID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get user: %w", err)
+	}
+	if newPassword == currentPassword {
+		return "", apierror.Validation(apierror.CodeWeakPassword, "The new password must differ from the current one")
+	}
+	if err := ValidatePassword(newPassword, user.Email); err != nil {
+		return "", err
+	}
+
+	resp, err := s.StytchClient.Passwords.ExistingPassword.Reset(ctx, &existingpassword.ResetParams{
+		Email:                  user.Email,
+		ExistingPassword:       currentPassword,
+		NewPassword:            newPassword,
+		SessionDurationMinutes: api.SessionDurationMinutes,
+	})
+	if err != nil {
+		return "", passwordError(err, apierror.New(http.StatusUnauthorized, apierror.CodeInvalidCredentials, "Current password is incorrect"))
+	}
+
+	if err := s.DB.UpdateUserPassword(ctx, principal.StytchUserID); err != nil {
+		return "", fmt.Errorf("failed to update user password: %w", err)
+	}
+	keep := ""
+	if resp.Session != nil {
+		keep = resp.Session.SessionID
+	}
+	if err := s.RevokeOtherSessions(ctx, principal, keep); err != nil {
+		return "", err
+	}
+	return resp.SessionToken, nil
+}
+
+// API endpoint implementations
+
+func (s *AuthServer) PostUsersSendResetPasswordLink(ctx context.Context, request api.PostUsersSendResetPasswordLinkRequestObject) (api.PostUsersSendResetPasswordLinkResponseObject, error) {
+	if err := s.SendPasswordResetLink(ctx, request.Body.Email); err != nil {
+		return nil, err
+	}
+
+	return api.PostUsersSendResetPasswordLink200JSONResponse(api.ApiResult{
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *AuthServer) PostUsersUpdateUserPassword(ctx context.Context, request api.PostUsersUpdateUserPasswordRequestObject) (api.PostUsersUpdateUserPasswordResponseObject, error) {
+	if err := ValidatePasswordConfirmation(request.Body.Password, request.Body.PasswordConfirmation); err != nil {
+		return nil, err
+	}
+
+	sessionToken, err := s.ResetPassword(ctx, request.Body.Token, request.Body.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	responseData := map[string]any{
+		"message": "Password reset",
+		"token":   sessionToken,
+	}
+	return api.PostUsersUpdateUserPassword200JSONResponse(api.ApiResult{
+		Data:      &responseData,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *AuthServer) PostUsersUserIdResetCurrentUserPassword(ctx context.Context, request api.PostUsersUserIdResetCurrentUserPasswordRequestObject) (api.PostUsersUserIdResetCurrentUserPasswordResponseObject, error) {
+	principal, ok := api.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, apierror.ErrUnauthenticated
+	}
+
+	if err := ValidatePasswordConfirmation(request.Body.NewPassword, request.Body.NewPasswordConfirm); err != nil {
+		return nil, err
+	}
+
+	sessionToken, err := s.ChangePassword(ctx, principal, request.Body.CurrentPassword, request.Body.NewPassword)
+	if err != nil {
+		return nil, err
+	}
+
+	responseData := map[string]any{
+		"message": "Password changed",
+		"token":   sessionToken,
+	}
+	return api.PostUsersUserIdResetCurrentUserPassword200JSONResponse(api.ApiResult{
+		Data:      &responseData,
+		IsSuccess: Ptr(true),
+	}), nil
+}
@@ -0,0 +1,182 @@
+package api_server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Subscription tiers stored in users.subscriptionTier
+const (
+	TierFree = "free"
+	TierPro  = "pro"
+)
+
+// Plan limits, as named in QUOTA_EXCEEDED errors and in the quota reports
+const (
+	LimitActiveSeasons    = "activeSeasons"
+	LimitPlayers          = "players"
+	LimitPlayersPerSeason = "playersPerSeason"
+	LimitCustomColumns    = "customColumns"
+	LimitPublicLinks      = "publicLinks"
+)
+
+// Plan declares what a subscription tier includes. A nil limit is unlimited.
+type Plan struct {
+	Tier                string `json:"tier"`
+	MaxActiveSeasons    *int32 `json:"maxActiveSeasons"`
+	MaxPlayers          *int32 `json:"maxPlayers"`
+	MaxPlayersPerSeason *int32 `json:"maxPlayersPerSeason"`
+	MaxCustomColumns    *int32 `json:"maxCustomColumns"`
+	MaxPublicLinks      *int32 `json:"maxPublicLinks"`
+}
+
+var plans = map[string]Plan{
+	TierFree: {
+		Tier:                TierFree,
+		MaxActiveSeasons:    Ptr(int32(2)),
+		MaxPlayers:          Ptr(int32(32)),
+		MaxPlayersPerSeason: Ptr(int32(16)),
+		MaxCustomColumns:    Ptr(int32(2)),
+		MaxPublicLinks:      Ptr(int32(1)),
+	},
+	TierPro: {
+		Tier: TierPro,
+	},
+}
+
+// QuotaStatus is the usage of one limit, Remaining is nil when unlimited
+type QuotaStatus struct {
+	Max       *int32 `json:"max"`
+	Used      int64  `json:"used"`
+	Remaining *int64 `json:"remaining"`
+}
+
+// PlanForTier returns the plan of a tier, unknown tiers get the free plan
+func PlanForTier(tier string) Plan {
+	if plan, ok := plans[tier]; ok {
+		return plan
+	}
+	return plans[TierFree]
+}
+
+// UserPlan returns the plan of the user's current subscription tier
+func UserPlan(ctx context.Context, queries *db.Queries, userId int32) (Plan, error) {
+	subscription, err := queries.GetUserSubscription(ctx, userId)
+	if err != nil {
+		return Plan{}, fmt.Errorf("failed to get user subscription: %w", err)
+	}
+	return PlanForTier(subscription.Subscriptiontier), nil
+}
+
+// Max returns the value of a limit, nil when the plan has none
+func (p Plan) Max(limit string) *int32 {
+	switch limit {
+	case LimitActiveSeasons:
+		return p.MaxActiveSeasons
+	case LimitPlayers:
+		return p.MaxPlayers
+	case LimitPlayersPerSeason:
+		return p.MaxPlayersPerSeason
+	case LimitCustomColumns:
+		return p.MaxCustomColumns
+	case LimitPublicLinks:
+		return p.MaxPublicLinks
+	}
+	return nil
+}
+
+// Check returns a QUOTA_EXCEEDED error when used plus adding goes over the
+// limit. The limit is sent in its data, so the client can offer an upgrade.
+func (p Plan) Check(limit string, used int64, adding int64) error {
+	allowed := p.Max(limit)
+	if allowed == nil || used+adding <= int64(*allowed) {
+		return nil
+	}
+	return apierror.QuotaExceeded(
+		fmt.Sprintf("The %s plan allows up to %d %s, upgrade to add more", p.Tier, *allowed, quotaNoun(limit)),
+		map[string]interface{}{
+			"limit": limit,
+			"max":   *allowed,
+			"tier":  p.Tier,
+		},
+	)
+}
+
+// Status reports the usage of a limit against the plan
+func (p Plan) Status(limit string, used int64) QuotaStatus {
+	status := QuotaStatus{Max: p.Max(limit), Used: used}
+	if status.Max != nil {
+		status.Remaining = Ptr(max(int64(*status.Max)-used, 0))
+	}
+	return status
+}
+
+// LoadQuota reports the usage of the limits that are counted per user. The
+// players per season limit is checked against each season instead.
+func LoadQuota(ctx context.Context, queries *db.Queries, userId int32, plan Plan) (map[string]QuotaStatus, error) {
+	owner := pgtype.Int4{Int32: userId, Valid: true}
+
+	seasons, err := queries.CountActiveSeasons(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count seasons: %w", err)
+	}
+	players, err := queries.CountActivePlayers(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count players: %w", err)
+	}
+	columns, err := queries.CountUserCustomColumns(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count custom columns: %w", err)
+	}
+	links, err := queries.CountActiveSeasonShareLinks(ctx, owner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count public links: %w", err)
+	}
+
+	return map[string]QuotaStatus{
+		LimitActiveSeasons: plan.Status(LimitActiveSeasons, seasons),
+		LimitPlayers:       plan.Status(LimitPlayers, players),
+		LimitCustomColumns: plan.Status(LimitCustomColumns, columns),
+		LimitPublicLinks:   plan.Status(LimitPublicLinks, links),
+	}, nil
+}
+
+// CheckPlanLimit checks one limit against the plan of the user
+func CheckPlanLimit(ctx context.Context, queries *db.Queries, userId int32, limit string, used int64, adding int64) error {
+	plan, err := UserPlan(ctx, queries, userId)
+	if err != nil {
+		return err
+	}
+	return plan.Check(limit, used, adding)
+}
+
+// CheckCustomColumnLimit checks the custom columns limit of the user's plan
+// before a column is defined. Player and match columns count alike, inactive
+// ones included.
+func CheckCustomColumnLimit(ctx context.Context, queries *db.Queries, userId int32) error {
+	columns, err := queries.CountUserCustomColumns(ctx, userId)
+	if err != nil {
+		return fmt.Errorf("failed to count custom columns: %w", err)
+	}
+	return CheckPlanLimit(ctx, queries, userId, LimitCustomColumns, columns, 1)
+}
+
+func quotaNoun(limit string) string {
+	switch limit {
+	case LimitActiveSeasons:
+		return "active seasons"
+	case LimitPlayers:
+		return "active players"
+	case LimitPlayersPerSeason:
+		return "players per season"
+	case LimitCustomColumns:
+		return "custom columns"
+	case LimitPublicLinks:
+		return "public links"
+	}
+	return limit
+}
@@ -0,0 +1,314 @@
+package api_server
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Results of a played match, from the point of view of the player
+const (
+	ResultWin  = "win"
+	ResultLoss = "loss"
+	ResultDraw = "draw"
+)
+
+// calendarTokenBytes is the entropy of a calendar feed token, which is all
+// that protects the feed
+const calendarTokenBytes = 32
+
+// PlayerScheduleEntry is a match of a player, seen from their side
+type PlayerScheduleEntry struct {
+	MatchId        int32              `json:"matchId"`
+	SeasonId       int32              `json:"seasonId"`
+	SeasonName     string             `json:"seasonName"`
+	MatchDate      openapi_types.Date `json:"matchDate"`
+	Group          int32              `json:"group"`
+	OpponentId     *int32             `json:"opponentId"`
+	OpponentName   *string            `json:"opponentName"`
+	Result         *string            `json:"result"`
+	PlayerPoints   int32              `json:"playerPoints"`
+	OpponentPoints int32              `json:"opponentPoints"`
+}
+
+// PlayerSchedule splits the matches of a player into the past ones, played or
+// dated before today, and the upcoming ones. Inactive matches are left out.
+func PlayerSchedule(playerId int32, rows []db.GetPlayerScheduleRow, today time.Time) (past, upcoming []PlayerScheduleEntry) {
+	past = make([]PlayerScheduleEntry, 0)
+	upcoming = make([]PlayerScheduleEntry, 0)
+	todayDate := today.UTC().Format(time.DateOnly)
+
+	for _, row := range rows {
+		if !row.Isactive {
+			continue
+		}
+		entry := playerScheduleEntry(playerId, row)
+		if entry.Result != nil || row.Matchdate.Time.UTC().Format(time.DateOnly) < todayDate {
+			past = append(past, entry)
+		} else {
+			upcoming = append(upcoming, entry)
+		}
+	}
+	return past, upcoming
+}
+
+func playerScheduleEntry(playerId int32, row db.GetPlayerScheduleRow) PlayerScheduleEntry {
+	entry := PlayerScheduleEntry{
+		MatchId:        row.ID,
+		SeasonId:       row.Seasonid.Int32,
+		SeasonName:     row.SeasonName,
+		MatchDate:      openapi_types.Date{Time: row.Matchdate.Time},
+		Group:          row.Group,
+		PlayerPoints:   row.Playerid1points,
+		OpponentPoints: row.Playerid2points,
+	}
+	if row.Playerid2.Valid && row.Playerid2.Int32 == playerId {
+		entry.PlayerPoints, entry.OpponentPoints = row.Playerid2points, row.Playerid1points
+	}
+	if row.OpponentID.Valid {
+		entry.OpponentId = Ptr(row.OpponentID.Int32)
+		entry.OpponentName = Ptr(row.OpponentName.String)
+	}
+
+	played := row.Winnerid.Valid || row.Playerid1points != 0 || row.Playerid2points != 0
+	switch {
+	case !played:
+	case row.Winnerid.Valid && row.Winnerid.Int32 == playerId:
+		entry.Result = Ptr(ResultWin)
+	case row.Winnerid.Valid:
+		entry.Result = Ptr(ResultLoss)
+	default:
+		entry.Result = Ptr(ResultDraw)
+	}
+	return entry
+}
+
+// RenderPlayerCalendar writes the matches of a player as an RFC 5545
+// calendar. Matches are all-day events whose UID stays the same when they are
+// rescheduled, with a SEQUENCE bumped by each change. Inactive matches and the
+// ones the player was taken off are sent as cancelled events, so subscribed
+// calendars remove them.
+func RenderPlayerCalendar(
+	player db.Player,
+	rows []db.GetPlayerScheduleRow,
+	cancellations []db.GetPlayerCalendarCancellationsRow,
+	now time.Time,
+) []byte {
+	var c calendarWriter
+	c.line("BEGIN", "VCALENDAR")
+	c.line("VERSION", "2.0")
+	c.line("PRODID", "-//Gameplan//Player Schedule//EN")
+	c.line("CALSCALE", "GREGORIAN")
+	c.line("METHOD", "PUBLISH")
+	c.text("X-WR-CALNAME", player.Name+" matches")
+	c.line("REFRESH-INTERVAL;VALUE=DURATION", "PT1H")
+	c.line("X-PUBLISHED-TTL", "PT1H")
+
+	for _, row := range rows {
+		entry := playerScheduleEntry(player.ID, row)
+		opponent := "TBD"
+		if entry.OpponentName != nil {
+			opponent = *entry.OpponentName
+		}
+		description := fmt.Sprintf("%s, group %d", row.SeasonName, row.Group)
+		if entry.Result != nil {
+			description += fmt.Sprintf("\nResult: %s %d-%d", *entry.Result, entry.PlayerPoints, entry.OpponentPoints)
+		}
+		status := "CONFIRMED"
+		if !row.Isactive {
+			status = "CANCELLED"
+		}
+
+		c.event(calendarEvent{
+			MatchId:      row.ID,
+			Sequence:     row.Calendarsequence,
+			Date:         row.Matchdate.Time,
+			Summary:      fmt.Sprintf("%s vs %s", player.Name, opponent),
+			Description:  description,
+			Status:       status,
+			LastModified: timestampOr(row.Updatedat, now),
+		})
+	}
+
+	for _, cancellation := range cancellations {
+		summary := "Cancelled match"
+		if cancellation.SeasonName.Valid {
+			summary = fmt.Sprintf("Cancelled %s match", cancellation.SeasonName.String)
+		}
+		c.event(calendarEvent{
+			MatchId:      cancellation.Matchid,
+			Sequence:     cancellation.Calendarsequence,
+			Date:         cancellation.Matchdate.Time,
+			Summary:      summary,
+			Status:       "CANCELLED",
+			LastModified: timestampOr(cancellation.Cancelledat, now),
+		})
+	}
+
+	c.line("END", "VCALENDAR")
+	return c.Bytes()
+}
+
+type calendarEvent struct {
+	MatchId      int32
+	Sequence     int32
+	Date         time.Time
+	Summary      string
+	Description  string
+	Status       string
+	LastModified time.Time
+}
+
+// calendarWriter writes content lines with CRLF endings, folded at 75 octets
+type calendarWriter struct {
+	bytes.Buffer
+}
+
+func (c *calendarWriter) event(e calendarEvent) {
+	c.line("BEGIN", "VEVENT")
+	c.line("UID", fmt.Sprintf("match-%d@gameplan", e.MatchId))
+	c.line("DTSTAMP", e.LastModified.UTC().Format("20060102T150405Z"))
+	c.line("LAST-MODIFIED", e.LastModified.UTC().Format("20060102T150405Z"))
+	c.line("SEQUENCE", fmt.Sprint(e.Sequence))
+	c.line("DTSTART;VALUE=DATE", e.Date.Format("20060102"))
+	c.line("DTEND;VALUE=DATE", e.Date.AddDate(0, 0, 1).Format("20060102"))
+	c.text("SUMMARY", e.Summary)
+	if e.Description != "" {
+		c.text("DESCRIPTION", e.Description)
+	}
+	c.line("STATUS", e.Status)
+	c.line("TRANSP", "TRANSPARENT")
+	c.line("END", "VEVENT")
+}
+
+// text writes a TEXT value, escaped as RFC 5545 section 3.3.11 requires
+func (c *calendarWriter) text(name, value string) {
+	c.line(name, strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(value))
+}
+
+func (c *calendarWriter) line(name, value string) {
+	content := name + ":" + value
+	// Folds never split a UTF-8 sequence, continuation lines start with a space
+	width := 75
+	for len(content) > width {
+		cut := width
+		for cut > 0 && content[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		c.WriteString(content[:cut] + "\r\n ")
+		content = content[cut:]
+		width = 74
+	}
+	c.WriteString(content + "\r\n")
+}
+
+func timestampOr(timestamp pgtype.Timestamp, fallback time.Time) time.Time {
+	if timestamp.Valid {
+		return timestamp.Time
+	}
+	return fallback
+}
+
+// newCalendarToken returns a random URL-safe token
+func newCalendarToken() (string, error) {
+	token := make([]byte, calendarTokenBytes)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate calendar token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// hashCalendarToken returns the hex SHA-256 of a token, which is what gets
+// stored. Tokens are random enough that an unsalted hash cannot be reversed.
+func hashCalendarToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CalendarFeedPath is where the feed of a token is served
+func CalendarFeedPath(token string) string {
+	return "/calendars/" + token + ".ics"
+}
+
+// API endpoint implementations
+
+func (s *PlayersServer) PostPlayersPlayerIdCalendarFeed(ctx context.Context, request api.PostPlayersPlayerIdCalendarFeedRequestObject) (api.PostPlayersPlayerIdCalendarFeedResponseObject, error) {
+	token, err := newCalendarToken()
+	if err != nil {
+		return nil, err
+	}
+
+	// A new token replaces the previous one, whose URL stops working. Only its
+	// hash is stored, so the token is only ever returned here.
+	feed, err := s.DB.UpsertPlayerCalendarFeed(ctx, db.UpsertPlayerCalendarFeedParams{
+		Playerid:  int32(request.PlayerId),
+		Tokenhash: hashCalendarToken(token),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to save calendar feed: %w", err)
+	}
+
+	feedMap := map[string]interface{}{
+		"token":     token,
+		"url":       s.APIURL + CalendarFeedPath(token),
+		"createdAt": feed.Createdat,
+	}
+	return api.PostPlayersPlayerIdCalendarFeed200JSONResponse(api.ApiResult{
+		Data:      &feedMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *PlayersServer) DeletePlayersPlayerIdCalendarFeed(ctx context.Context, request api.DeletePlayersPlayerIdCalendarFeedRequestObject) (api.DeletePlayersPlayerIdCalendarFeedResponseObject, error) {
+	if err := s.DB.DeletePlayerCalendarFeed(ctx, int32(request.PlayerId)); err != nil {
+		return nil, fmt.Errorf("failed to delete calendar feed: %w", err)
+	}
+
+	return api.DeletePlayersPlayerIdCalendarFeed200JSONResponse(api.ApiResult{
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+// GetCalendarsToken serves the feed to calendar apps, the token is the only
+// credential. Calendar apps often want the URL to end in .ics, which is
+// accepted but not part of the token.
+func (s *PlayersServer) GetCalendarsToken(ctx context.Context, request api.GetCalendarsTokenRequestObject) (api.GetCalendarsTokenResponseObject, error) {
+	token := strings.TrimSuffix(request.Token, ".ics")
+
+	player, err := s.DB.GetPlayerByCalendarTokenHash(ctx, hashCalendarToken(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound("Calendar not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player by calendar token: %w", err)
+	}
+
+	rows, err := s.DB.GetPlayerSchedule(ctx, pgtype.Int4{Int32: player.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player schedule: %w", err)
+	}
+	cancellations, err := s.DB.GetPlayerCalendarCancellations(ctx, player.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get calendar cancellations: %w", err)
+	}
+
+	calendar := RenderPlayerCalendar(player, rows, cancellations, time.Now())
+	return api.GetCalendarsToken200TextcalendarResponse{
+		Body:          bytes.NewReader(calendar),
+		ContentLength: int64(len(calendar)),
+	}, nil
+}
@@ -0,0 +1,282 @@
+package api_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// GetPlayerCustomColumn retrieves a custom column of the user
+func (s *PlayersServer) GetPlayerCustomColumn(
+	ctx context.Context,
+	userId int32,
+	columnId int32,
+) (*db.PlayerCustomColumn, error) {
+	column, err := s.DB.GetPlayerCustomColumn(ctx, db.GetPlayerCustomColumnParams{
+		ID:     columnId,
+		Userid: userId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound(fmt.Sprintf("Custom column %d not found", columnId))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player custom column: %w", err)
+	}
+	return &column, nil
+}
+
+// ReorderPlayerCustomColumns sets the display order of the user's columns to
+// their position in columnIds, which must list every column once
+func (s *PlayersServer) ReorderPlayerCustomColumns(
+	ctx context.Context,
+	userId int32,
+	columnIds []int32,
+) ([]db.PlayerCustomColumn, error) {
+	columns, err := s.DB.GetAllPlayerCustomColumns(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player custom columns: %w", err)
+	}
+
+	owned := make(map[int32]bool, len(columns))
+	for _, column := range columns {
+		owned[column.ID] = true
+	}
+	listed := make(map[int32]bool, len(columnIds))
+	for _, columnId := range columnIds {
+		if !owned[columnId] {
+			return nil, apierror.NotFound(fmt.Sprintf("Custom column %d not found", columnId))
+		}
+		if listed[columnId] {
+			return nil, apierror.Validation(apierror.CodeInvalidCustomColumn, fmt.Sprintf("Custom column %d is listed twice", columnId))
+		}
+		listed[columnId] = true
+	}
+	if len(listed) != len(columns) {
+		return nil, apierror.Validation(apierror.CodeInvalidCustomColumn, "Every custom column must be listed, inactive ones included")
+	}
+
+	tx, err := s.DBPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.DB.WithTx(tx)
+
+	for position, columnId := range columnIds {
+		if err := qtx.UpdatePlayerCustomColumnOrder(ctx, db.UpdatePlayerCustomColumnOrderParams{
+			ID:           columnId,
+			Userid:       userId,
+			DisplayOrder: pgtype.Int4{Int32: int32(position + 1), Valid: true},
+		}); err != nil {
+			return nil, fmt.Errorf("failed to update player custom column order: %w", err)
+		}
+	}
+	columns, err = qtx.GetAllPlayerCustomColumns(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player custom columns: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return columns, nil
+}
+
+// DeletePlayerCustomColumn removes a custom column with all of its values.
+// Without confirm, the values of a required column are not deleted.
+func (s *PlayersServer) DeletePlayerCustomColumn(
+	ctx context.Context,
+	userId int32,
+	columnId int32,
+	confirm bool,
+) error {
+	column, err := s.GetPlayerCustomColumn(ctx, userId, columnId)
+	if err != nil {
+		return err
+	}
+
+	tx, err := s.DBPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.DB.WithTx(tx)
+
+	columnRef := pgtype.Int4{Int32: columnId, Valid: true}
+	if column.IsRequired.Valid && column.IsRequired.Bool && !confirm {
+		values, err := qtx.CountPlayerCustomValuesByColumn(ctx, columnRef)
+		if err != nil {
+			return fmt.Errorf("failed to count player custom values: %w", err)
+		}
+		if values > 0 {
+			return apierror.Conflict(
+				apierror.CodeConfirmationRequired,
+				fmt.Sprintf("Deleting the required column %q removes %d values, confirm to delete it", column.Name, values),
+			).WithDetails(map[string]interface{}{
+				"columnId": column.ID,
+				"values":   values,
+			})
+		}
+	}
+
+	if err := qtx.DeletePlayerCustomValuesByColumn(ctx, columnRef); err != nil {
+		return fmt.Errorf("failed to delete player custom values: %w", err)
+	}
+	if err := qtx.DeletePlayerCustomColumn(ctx, db.DeletePlayerCustomColumnParams{
+		ID:     columnId,
+		Userid: userId,
+	}); err != nil {
+		return fmt.Errorf("failed to delete player custom column: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// API endpoint implementations
+
+func (s *PlayersServer) GetUsersUserIdCustomPlayerColumns(ctx context.Context, request api.GetUsersUserIdCustomPlayerColumnsRequestObject) (api.GetUsersUserIdCustomPlayerColumnsResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	columns, err := s.DB.GetAllPlayerCustomColumns(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player custom columns: %w", err)
+	}
+
+	columnsMap := map[string]interface{}{
+		"columns": columns,
+	}
+	return api.GetUsersUserIdCustomPlayerColumns200JSONResponse(api.ApiResult{
+		Data:      &columnsMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *PlayersServer) PostUsersUserIdCustomPlayerColumns(ctx context.Context, request api.PostUsersUserIdCustomPlayerColumnsRequestObject) (api.PostUsersUserIdCustomPlayerColumnsResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := NewCustomColumn(*request.Body)
+	if err != nil {
+		return nil, err
+	}
+	if err := CheckCustomColumnLimit(ctx, s.DB, userID); err != nil {
+		return nil, err
+	}
+
+	column, err := s.DB.CreatePlayerCustomColumn(ctx, db.CreatePlayerCustomColumnParams{
+		Userid:       userID,
+		Name:         definition.Name,
+		FieldType:    definition.FieldType,
+		Options:      definition.Options,
+		Description:  definition.Description,
+		IsRequired:   pgtype.Bool{Bool: definition.IsRequired, Valid: true},
+		DisplayOrder: definition.DisplayOrder,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create player custom column: %w", duplicateNameError(err, "custom player column", definition.Name))
+	}
+
+	columnMap := map[string]interface{}{
+		"column": column,
+	}
+	return api.PostUsersUserIdCustomPlayerColumns200JSONResponse(api.ApiResult{
+		Data:      &columnMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *PlayersServer) PutUsersUserIdCustomPlayerColumns(ctx context.Context, request api.PutUsersUserIdCustomPlayerColumnsRequestObject) (api.PutUsersUserIdCustomPlayerColumnsResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	columnIds := make([]int32, 0, len(request.Body.ColumnIds))
+	for _, columnId := range request.Body.ColumnIds {
+		columnIds = append(columnIds, int32(columnId))
+	}
+
+	columns, err := s.ReorderPlayerCustomColumns(ctx, userID, columnIds)
+	if err != nil {
+		return nil, err
+	}
+
+	columnsMap := map[string]interface{}{
+		"columns": columns,
+	}
+	return api.PutUsersUserIdCustomPlayerColumns200JSONResponse(api.ApiResult{
+		Data:      &columnsMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *PlayersServer) PutUsersUserIdCustomPlayerColumnsColumnId(ctx context.Context, request api.PutUsersUserIdCustomPlayerColumnsColumnIdRequestObject) (api.PutUsersUserIdCustomPlayerColumnsColumnIdResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get current column to preserve unchanged fields
+	current, err := s.GetPlayerCustomColumn(ctx, userID, int32(request.ColumnId))
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := PlayerCustomColumn(*current).Merge(*request.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	column, err := s.DB.UpdatePlayerCustomColumn(ctx, db.UpdatePlayerCustomColumnParams{
+		ID:           current.ID,
+		Userid:       userID,
+		Name:         definition.Name,
+		FieldType:    definition.FieldType,
+		Options:      definition.Options,
+		Description:  definition.Description,
+		IsRequired:   pgtype.Bool{Bool: definition.IsRequired, Valid: true},
+		IsActive:     definition.IsActive,
+		DisplayOrder: definition.DisplayOrder,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update player custom column: %w", duplicateNameError(err, "custom player column", definition.Name))
+	}
+
+	columnMap := map[string]interface{}{
+		"column": column,
+	}
+	return api.PutUsersUserIdCustomPlayerColumnsColumnId200JSONResponse(api.ApiResult{
+		Data:      &columnMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *PlayersServer) DeleteUsersUserIdCustomPlayerColumnsColumnId(ctx context.Context, request api.DeleteUsersUserIdCustomPlayerColumnsColumnIdRequestObject) (api.DeleteUsersUserIdCustomPlayerColumnsColumnIdResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	confirm := request.Params.Confirm != nil && *request.Params.Confirm
+	if err := s.DeletePlayerCustomColumn(ctx, userID, int32(request.ColumnId), confirm); err != nil {
+		return nil, err
+	}
+
+	return api.DeleteUsersUserIdCustomPlayerColumnsColumnId200JSONResponse(api.ApiResult{
+		IsSuccess: Ptr(true),
+	}), nil
+}
@@ -3,24 +3,33 @@ package api_server
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
 	"github.com/gameplan-backend/db"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
 // PlayersServer handles player-related operations
 type PlayersServer struct {
-	DB *db.Queries
+	DB     *db.Queries
+	DBPool *pgxpool.Pool
+	// APIURL is the public base URL of the API, calendar feed links start with it
+	APIURL string
 }
 
-// CreatePlayer creates a new player record based on API params
+// CreatePlayer creates a new player record based on API params, with its
+// normalized custom values keyed by column ID
 func (s *PlayersServer) CreatePlayer(
 	ctx context.Context,
 	userId int32,
 	name string,
 	email pgtype.Text,
 	emailNotificationsEnabled bool,
+	customValues map[int32]pgtype.Text,
 ) (*db.Player, error) {
 	params := db.CreatePlayerParams{
 		Userid:                    pgtype.Int4{Int32: userId, Valid: true},
@@ -30,9 +39,23 @@ func (s *PlayersServer) CreatePlayer(
 		Emailnotificationsenabled: emailNotificationsEnabled,
 	}
 
-	player, err := s.DB.CreatePlayer(ctx, params)
+	tx, err := s.DBPool.Begin(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create player: %w", err)
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.DB.WithTx(tx)
+
+	player, err := qtx.CreatePlayer(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create player: %w", duplicateNameError(err, "player", name))
+	}
+	if err := upsertPlayerCustomValues(ctx, qtx, player.ID, customValues); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 	return &player, nil
 }
@@ -53,12 +76,14 @@ func (s *PlayersServer) GetPlayer(
 	return &player, nil
 }
 
-// UpdatePlayer updates player information via key-value pairs
+// UpdatePlayer updates player information via key-value pairs, and the
+// normalized custom values keyed by column ID
 func (s *PlayersServer) UpdatePlayer(
 	ctx context.Context,
 	userId int32,
 	playerId int32,
 	updates map[string]interface{},
+	customValues map[int32]pgtype.Text,
 ) (*db.Player, error) {
 	// Get current player to preserve unchanged fields
 	current, err := s.GetPlayer(ctx, userId, playerId)
@@ -88,9 +113,23 @@ func (s *PlayersServer) UpdatePlayer(
 		}
 	}
 
-	player, err := s.DB.UpdatePlayer(ctx, params)
+	tx, err := s.DBPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.DB.WithTx(tx)
+
+	player, err := qtx.UpdatePlayer(ctx, params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update player: %w", err)
+		return nil, fmt.Errorf("failed to update player: %w", duplicateNameError(err, "player", params.Name))
+	}
+	if err := upsertPlayerCustomValues(ctx, qtx, player.ID, customValues); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 	return &player, nil
 }
@@ -122,17 +161,35 @@ func (s *PlayersServer) ListPlayers(
 	return players, nil
 }
 
-// GetPlayerCustomColumns retrieves active custom columns
+// GetPlayerCustomColumns retrieves the user's active custom columns
 func (s *PlayersServer) GetPlayerCustomColumns(
 	ctx context.Context,
+	userId int32,
 ) ([]db.PlayerCustomColumn, error) {
-	columns, err := s.DB.GetPlayerCustomColumns(ctx)
+	columns, err := s.DB.GetPlayerCustomColumns(ctx, userId)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get player custom columns: %w", err)
 	}
 	return columns, nil
 }
 
+// GetPlayerCustomFields retrieves the definitions of the user's active custom
+// columns
+func (s *PlayersServer) GetPlayerCustomFields(
+	ctx context.Context,
+	userId int32,
+) ([]CustomField, error) {
+	columns, err := s.GetPlayerCustomColumns(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]CustomField, 0, len(columns))
+	for _, column := range columns {
+		fields = append(fields, PlayerCustomField(column))
+	}
+	return fields, nil
+}
+
 // GetPlayerCustomValues retrieves custom values for a player
 func (s *PlayersServer) GetPlayerCustomValues(
 	ctx context.Context,
@@ -145,17 +202,17 @@ func (s *PlayersServer) GetPlayerCustomValues(
 	return values, nil
 }
 
-// UpsertPlayerCustomValue updates or inserts a custom value
+// UpsertPlayerCustomValue updates or inserts a normalized custom value
 func (s *PlayersServer) UpsertPlayerCustomValue(
 	ctx context.Context,
 	playerId int32,
 	columnId int32,
-	value string,
+	value pgtype.Text,
 ) (*db.PlayerCustomValue, error) {
 	val, err := s.DB.UpsertPlayerCustomValue(ctx, db.UpsertPlayerCustomValueParams{
 		PlayerID: pgtype.Int4{Int32: playerId, Valid: true},
 		ColumnID: pgtype.Int4{Int32: columnId, Valid: true},
-		Value:    pgtype.Text{String: value, Valid: true},
+		Value:    value,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to upsert player custom value: %w", err)
@@ -163,24 +220,77 @@ func (s *PlayersServer) UpsertPlayerCustomValue(
 	return &val, nil
 }
 
+func upsertPlayerCustomValues(ctx context.Context, queries *db.Queries, playerId int32, values map[int32]pgtype.Text) error {
+	for columnId, value := range values {
+		_, err := queries.UpsertPlayerCustomValue(ctx, db.UpsertPlayerCustomValueParams{
+			PlayerID: pgtype.Int4{Int32: playerId, Valid: true},
+			ColumnID: pgtype.Int4{Int32: columnId, Valid: true},
+			Value:    value,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to upsert player custom value: %w", err)
+		}
+	}
+	return nil
+}
+
+// checkPlayerLimit checks the active players limit of the user's plan
+func (s *PlayersServer) checkPlayerLimit(
+	ctx context.Context,
+	userId int32,
+) error {
+	playerCount, err := s.DB.CountActivePlayers(ctx, pgtype.Int4{Int32: userId, Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to count players: %w", err)
+	}
+	return CheckPlanLimit(ctx, s.DB, userId, LimitPlayers, playerCount, 1)
+}
+
+// normalizeCustomValues checks the custom values sent for a player, keyed by
+// column name. Every required column must end up with a value, in values or
+// among the player's stored values.
+func (s *PlayersServer) normalizeCustomValues(
+	ctx context.Context,
+	userId int32,
+	playerId *int32,
+	values map[string]interface{},
+) (map[int32]pgtype.Text, error) {
+	fields, err := s.GetPlayerCustomFields(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := make(map[int32]bool)
+	if playerId != nil {
+		stored, err := s.GetPlayerCustomValues(ctx, *playerId)
+		if err != nil {
+			return nil, err
+		}
+		for _, value := range stored {
+			if value.Value.Valid {
+				existing[value.ColumnID.Int32] = true
+			}
+		}
+	}
+
+	normalized, err := NormalizeCustomValues(fields, values, existing, true)
+	if err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
+
 // API endpoint implementations
 
 func (s *PlayersServer) GetPlayers(ctx context.Context, request api.GetPlayersRequestObject) (api.GetPlayersResponseObject, error) {
-	// Extract user ID from context (would come from JWT in middleware)
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	players, err := s.ListPlayers(ctx, userID)
 	if err != nil {
-		return api.GetPlayers200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to list players: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	playersMap := map[string]interface{}{
@@ -193,26 +303,38 @@ func (s *PlayersServer) GetPlayers(ctx context.Context, request api.GetPlayersRe
 }
 
 func (s *PlayersServer) PostPlayers(ctx context.Context, request api.PostPlayersRequestObject) (api.PostPlayersResponseObject, error) {
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	if err := s.checkPlayerLimit(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	var values map[string]interface{}
+	if request.Body.CustomValues != nil {
+		values = *request.Body.CustomValues
+	}
+	customValues, err := s.normalizeCustomValues(ctx, userID, nil, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var email pgtype.Text
+	if request.Body.Email != nil {
+		email = pgtype.Text{String: *request.Body.Email, Valid: true}
+	}
 	player, err := s.CreatePlayer(
 		ctx,
 		userID,
 		request.Body.Name,
-		pgtype.Text{String: *request.Body.Email, Valid: request.Body.Email != nil},
+		email,
 		request.Body.EmailNotificationsEnabled,
+		customValues,
 	)
 	if err != nil {
-		return api.PostPlayers200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to create player: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	playerMap := map[string]interface{}{
@@ -225,19 +347,13 @@ func (s *PlayersServer) PostPlayers(ctx context.Context, request api.PostPlayers
 }
 
 func (s *PlayersServer) DeletePlayersPlayerId(ctx context.Context, request api.DeletePlayersPlayerIdRequestObject) (api.DeletePlayersPlayerIdResponseObject, error) {
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := s.DeletePlayer(ctx, userID, int32(request.PlayerId)); err != nil {
-		return api.DeletePlayersPlayerId200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to delete player: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	return api.DeletePlayersPlayerId200JSONResponse(api.ApiResult{
@@ -246,20 +362,14 @@ func (s *PlayersServer) DeletePlayersPlayerId(ctx context.Context, request api.D
 }
 
 func (s *PlayersServer) GetPlayersPlayerId(ctx context.Context, request api.GetPlayersPlayerIdRequestObject) (api.GetPlayersPlayerIdResponseObject, error) {
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	player, err := s.GetPlayer(ctx, userID, int32(request.PlayerId))
 	if err != nil {
-		return api.GetPlayersPlayerId200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to get player: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	customValues, err := s.GetPlayerCustomValues(ctx, int32(request.PlayerId))
@@ -268,10 +378,10 @@ func (s *PlayersServer) GetPlayersPlayerId(ctx context.Context, request api.GetP
 		fmt.Printf("Failed to get custom values: %v\n", err)
 	}
 
-	customMap := make(map[string]string)
+	customMap := make(map[string]interface{})
 	for _, cv := range customValues {
 		if cv.Value.Valid {
-			customMap[cv.ColumnName] = cv.Value.String
+			customMap[cv.ColumnName] = DecodeCustomValue(cv.FieldType, cv.Value)
 		}
 	}
 
@@ -286,24 +396,27 @@ func (s *PlayersServer) GetPlayersPlayerId(ctx context.Context, request api.GetP
 }
 
 func (s *PlayersServer) PutPlayersPlayerId(ctx context.Context, request api.PutPlayersPlayerIdRequestObject) (api.PutPlayersPlayerIdResponseObject, error) {
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	updates := map[string]interface{}{
 		request.Body.Key: request.Body.Value,
 	}
 
-	player, err := s.UpdatePlayer(ctx, userID, int32(request.PlayerId), updates)
+	var values map[string]interface{}
+	if request.Body.CustomValues != nil {
+		values = *request.Body.CustomValues
+	}
+	customValues, err := s.normalizeCustomValues(ctx, userID, Ptr(int32(request.PlayerId)), values)
+	if err != nil {
+		return nil, err
+	}
+
+	player, err := s.UpdatePlayer(ctx, userID, int32(request.PlayerId), updates, customValues)
 	if err != nil {
-		return api.PutPlayersPlayerId200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to update player: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	playerMap := map[string]interface{}{
@@ -316,25 +429,31 @@ func (s *PlayersServer) PutPlayersPlayerId(ctx context.Context, request api.PutP
 }
 
 func (s *PlayersServer) GetPlayersPlayerIdSchedule(ctx context.Context, request api.GetPlayersPlayerIdScheduleRequestObject) (api.GetPlayersPlayerIdScheduleResponseObject, error) {
-	// TODO: Implement schedule retrieval
+	rows, err := s.DB.GetPlayerSchedule(ctx, pgtype.Int4{Int32: int32(request.PlayerId), Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get player schedule: %w", err)
+	}
+
+	past, upcoming := PlayerSchedule(int32(request.PlayerId), rows, time.Now())
+	scheduleMap := map[string]interface{}{
+		"past":     past,
+		"upcoming": upcoming,
+	}
 	return api.GetPlayersPlayerIdSchedule200JSONResponse(api.ApiResult{
+		Data:      &scheduleMap,
 		IsSuccess: Ptr(true),
 	}), nil
 }
 
 func (s *PlayersServer) GetPlayersPlayerIdCustomColumns(ctx context.Context, request api.GetPlayersPlayerIdCustomColumnsRequestObject) (api.GetPlayersPlayerIdCustomColumnsResponseObject, error) {
-	columns, err := s.GetPlayerCustomColumns(ctx)
+	userID, err := api.UserIDFromContext(ctx)
 	if err != nil {
-		return api.GetPlayersPlayerIdCustomColumns200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to get custom columns: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
+	}
+
+	columns, err := s.GetPlayerCustomColumns(ctx, userID)
+	if err != nil {
+		return nil, err
 	}
 
 	columnsMap := map[string]interface{}{
@@ -347,25 +466,112 @@ func (s *PlayersServer) GetPlayersPlayerIdCustomColumns(ctx context.Context, req
 }
 
 func (s *PlayersServer) PutPlayersPlayerIdCustomColumns(ctx context.Context, request api.PutPlayersPlayerIdCustomColumnsRequestObject) (api.PutPlayersPlayerIdCustomColumnsResponseObject, error) {
+	// The body carries its own playerId, which must also belong to the caller
+	if err := api.AuthorizePlayer(ctx, s.DB, int32(request.Body.PlayerId)); err != nil {
+		return nil, err
+	}
+
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	column, err := s.GetPlayerCustomColumn(ctx, userID, int32(request.Body.ColumnId))
+	if err != nil {
+		return nil, err
+	}
+	if column.IsActive.Valid && !column.IsActive.Bool {
+		return nil, apierror.Validation(apierror.CodeInvalidField, fmt.Sprintf("Custom column %q is inactive", column.Name))
+	}
+
+	var raw interface{}
+	if request.Body.Value != nil {
+		raw = *request.Body.Value
+	}
+	value, err := PlayerCustomField(*column).Normalize(raw)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update custom column value
-	_, err := s.UpsertPlayerCustomValue(ctx,
+	_, err = s.UpsertPlayerCustomValue(ctx,
 		int32(request.Body.PlayerId),
-		int32(request.Body.ColumnId),
-		request.Body.Value)
+		column.ID,
+		value)
 	if err != nil {
-		return api.PutPlayersPlayerIdCustomColumns200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to update custom column: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	return api.PutPlayersPlayerIdCustomColumns200JSONResponse(api.ApiResult{
 		IsSuccess: Ptr(true),
 	}), nil
 }
+
+func (s *PlayersServer) GetPlayersRatings(ctx context.Context, request api.GetPlayersRatingsRequestObject) (api.GetPlayersRatingsResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The ratings of a season are those of its owner's players
+	var seasonId *int32
+	if request.Params.SeasonId != nil {
+		if err := api.AuthorizeSeason(ctx, s.DB, int32(*request.Params.SeasonId), api.RoleViewer); err != nil {
+			return nil, err
+		}
+		seasonId = Ptr(int32(*request.Params.SeasonId))
+		owner, err := s.DB.GetSeasonOwner(ctx, *seasonId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get season owner: %w", err)
+		}
+		userID = owner.Int32
+	}
+
+	opts, err := ParseRatingOptions((*string)(request.Params.System), request.Params.KFactor)
+	if err != nil {
+		return nil, apierror.Validation(apierror.CodeInvalidRatingOptions, err.Error())
+	}
+
+	ratings, err := LoadRatings(ctx, s.DB, userID, seasonId, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	players, err := s.DB.GetPlayers(ctx, pgtype.Int4{Int32: userID, Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	includeHistory := request.Params.History != nil && *request.Params.History
+	playerRatings := make([]map[string]interface{}, 0, len(players))
+	for _, player := range players {
+		rating, ok := ratings[player.ID]
+		if !ok {
+			rating = InitialPlayerRating(player.ID, opts)
+		}
+		if !includeHistory {
+			rating.History = nil
+		}
+		playerRatings = append(playerRatings, map[string]interface{}{
+			"playerName": player.Name,
+			"rating":     rating,
+		})
+	}
+	sort.SliceStable(playerRatings, func(i, j int) bool {
+		return playerRatings[i]["rating"].(*PlayerRating).Rating > playerRatings[j]["rating"].(*PlayerRating).Rating
+	})
+
+	ratingsData := map[string]interface{}{
+		"system":  opts.System,
+		"ratings": playerRatings,
+	}
+	if opts.System == RatingSystemElo {
+		ratingsData["kFactor"] = opts.KFactor
+	}
+	if seasonId != nil {
+		ratingsData["seasonId"] = *seasonId
+	}
+	return api.GetPlayersRatings200JSONResponse(api.ApiResult{
+		Data:      &ratingsData,
+		IsSuccess: Ptr(true),
+	}), nil
+}
@@ -0,0 +1,329 @@
+package api_server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// shareTokenBytes is the entropy of a public schedule token
+const shareTokenBytes = 32
+
+// PublicScheduleLink describes a share link without its token, which is only
+// returned when the link is created
+type PublicScheduleLink struct {
+	Id        int32      `json:"id"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ExpiresAt *time.Time `json:"expiresAt"`
+	Expired   bool       `json:"expired"`
+}
+
+// PublicSeason is a season as shown through a public link, without its owner
+type PublicSeason struct {
+	Name          string             `json:"name"`
+	SeasonType    string             `json:"seasonType"`
+	StartDate     openapi_types.Date `json:"startDate"`
+	Frequency     string             `json:"frequency"`
+	Format        string             `json:"format"`
+	IsActive      bool               `json:"isActive"`
+	PointsPerWin  int32              `json:"pointsPerWin"`
+	PointsPerDraw int32              `json:"pointsPerDraw"`
+	PointsPerLoss int32              `json:"pointsPerLoss"`
+	Tiebreakers   []string           `json:"tiebreakers"`
+}
+
+// PublicPlayer is a player as shown through a public link, by name only
+type PublicPlayer struct {
+	Id   int32  `json:"id"`
+	Name string `json:"name"`
+}
+
+// PublicMatch is a match as shown through a public link, without custom values
+type PublicMatch struct {
+	Id              int32              `json:"id"`
+	PlayerId1       *int32             `json:"playerId1"`
+	PlayerId2       *int32             `json:"playerId2"`
+	PlayerId1Points int32              `json:"playerId1Points"`
+	PlayerId2Points int32              `json:"playerId2Points"`
+	WinnerId        *int32             `json:"winnerId"`
+	MatchDate       openapi_types.Date `json:"matchDate"`
+	Group           int32              `json:"group"`
+}
+
+func publicScheduleLink(link db.SeasonShareLink, now time.Time) PublicScheduleLink {
+	public := PublicScheduleLink{
+		Id:        link.ID,
+		CreatedAt: link.Createdat.Time,
+	}
+	if link.Expiresat.Valid {
+		public.ExpiresAt = Ptr(link.Expiresat.Time)
+		public.Expired = !link.Expiresat.Time.After(now)
+	}
+	return public
+}
+
+func publicSeason(season db.Season) PublicSeason {
+	return PublicSeason{
+		Name:          season.Name,
+		SeasonType:    season.Seasontype,
+		StartDate:     openapi_types.Date{Time: season.Startdate.Time},
+		Frequency:     season.Frequency,
+		Format:        season.Format,
+		IsActive:      season.Isactive,
+		PointsPerWin:  season.Pointsperwin,
+		PointsPerDraw: season.Pointsperdraw,
+		PointsPerLoss: season.Pointsperloss,
+		Tiebreakers:   season.Tiebreakers,
+	}
+}
+
+func publicMatches(matches []db.Match) []PublicMatch {
+	public := make([]PublicMatch, 0, len(matches))
+	for _, match := range matches {
+		if !match.Isactive {
+			continue
+		}
+		public = append(public, publicMatch(match))
+	}
+	return public
+}
+
+func publicMatch(match db.Match) PublicMatch {
+	return PublicMatch{
+		Id:              match.ID,
+		PlayerId1:       optionalInt32(match.Playerid1),
+		PlayerId2:       optionalInt32(match.Playerid2),
+		PlayerId1Points: match.Playerid1points,
+		PlayerId2Points: match.Playerid2points,
+		WinnerId:        optionalInt32(match.Winnerid),
+		MatchDate:       openapi_types.Date{Time: match.Matchdate.Time},
+		Group:           match.Group,
+	}
+}
+
+func optionalInt32(value pgtype.Int4) *int32 {
+	if !value.Valid {
+		return nil
+	}
+	return Ptr(value.Int32)
+}
+
+// hashShareToken signs a token with the server secret. Only the signature is
+// stored, so a copy of the database is not enough to open the links.
+func (s *SeasonsServer) hashShareToken(token string) string {
+	mac := hmac.New(sha256.New, s.ShareLinkSecret)
+	mac.Write([]byte(token))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// CreatePublicScheduleLink creates a share link for a season, counted against
+// the public links limit of the user's plan, and returns it with its token
+func (s *SeasonsServer) CreatePublicScheduleLink(
+	ctx context.Context,
+	userId int32,
+	seasonId int32,
+	expiresAt *time.Time,
+) (*db.SeasonShareLink, string, error) {
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, "", apierror.Validation(apierror.CodeValidation, "The expiry of a public link must be in the future")
+	}
+
+	links, err := s.DB.CountActiveSeasonShareLinks(ctx, pgtype.Int4{Int32: userId, Valid: true})
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to count public links: %w", err)
+	}
+	if err := CheckPlanLimit(ctx, s.DB, userId, LimitPublicLinks, links, 1); err != nil {
+		return nil, "", err
+	}
+
+	raw := make([]byte, shareTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("failed to generate share token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	params := db.CreateSeasonShareLinkParams{
+		Seasonid:  seasonId,
+		Tokenhash: s.hashShareToken(token),
+	}
+	if expiresAt != nil {
+		params.Expiresat = pgtype.Timestamp{Time: expiresAt.UTC(), Valid: true}
+	}
+	link, err := s.DB.CreateSeasonShareLink(ctx, params)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to create public link: %w", err)
+	}
+	return &link, token, nil
+}
+
+// GetSharedSeason retrieves the season of a token that is neither revoked
+// nor expired
+func (s *SeasonsServer) GetSharedSeason(ctx context.Context, token string) (*db.Season, error) {
+	season, err := s.DB.GetSeasonByShareToken(ctx, s.hashShareToken(token))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound("This link does not exist, has expired or was revoked")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season by share token: %w", err)
+	}
+	return &season, nil
+}
+
+// PublicSchedulePath is where the schedule of a token is served
+func PublicSchedulePath(token string) string {
+	return "/public/schedules/" + token
+}
+
+// API endpoint implementations
+
+func (s *SeasonsServer) GetSeasonsSeasonIdPublicScheduleLinks(ctx context.Context, request api.GetSeasonsSeasonIdPublicScheduleLinksRequestObject) (api.GetSeasonsSeasonIdPublicScheduleLinksResponseObject, error) {
+	links, err := s.DB.GetSeasonShareLinks(ctx, int32(request.SeasonId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public links: %w", err)
+	}
+
+	now := time.Now()
+	publicLinks := make([]PublicScheduleLink, 0, len(links))
+	for _, link := range links {
+		publicLinks = append(publicLinks, publicScheduleLink(link, now))
+	}
+
+	linksMap := map[string]interface{}{
+		"links": publicLinks,
+	}
+	return api.GetSeasonsSeasonIdPublicScheduleLinks200JSONResponse(api.ApiResult{
+		Data:      &linksMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *SeasonsServer) PostSeasonsSeasonIdPublicScheduleLinks(ctx context.Context, request api.PostSeasonsSeasonIdPublicScheduleLinksRequestObject) (api.PostSeasonsSeasonIdPublicScheduleLinksResponseObject, error) {
+	// Links count against the plan of the season's owner
+	owner, err := s.DB.GetSeasonOwner(ctx, int32(request.SeasonId))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season owner: %w", err)
+	}
+
+	link, token, err := s.CreatePublicScheduleLink(ctx, owner.Int32, int32(request.SeasonId), request.Body.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	linkMap := map[string]interface{}{
+		"link":  publicScheduleLink(*link, time.Now()),
+		"token": token,
+		"url":   s.APIURL + PublicSchedulePath(token),
+	}
+	return api.PostSeasonsSeasonIdPublicScheduleLinks200JSONResponse(api.ApiResult{
+		Data:      &linkMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *SeasonsServer) DeleteSeasonsSeasonIdPublicScheduleLinksLinkId(ctx context.Context, request api.DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdRequestObject) (api.DeleteSeasonsSeasonIdPublicScheduleLinksLinkIdResponseObject, error) {
+	_, err := s.DB.RevokeSeasonShareLink(ctx, db.RevokeSeasonShareLinkParams{
+		ID:       int32(request.LinkId),
+		Seasonid: int32(request.SeasonId),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound(fmt.Sprintf("Public link %d not found", request.LinkId))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to revoke public link: %w", err)
+	}
+
+	return api.DeleteSeasonsSeasonIdPublicScheduleLinksLinkId200JSONResponse(api.ApiResult{
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *SeasonsServer) GetPublicSchedulesToken(ctx context.Context, request api.GetPublicSchedulesTokenRequestObject) (api.GetPublicSchedulesTokenResponseObject, error) {
+	season, err := s.GetSharedSeason(ctx, request.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	seasonId := pgtype.Int4{Int32: season.ID, Valid: true}
+	participants, err := s.DB.GetSeasonParticipants(ctx, seasonId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season participants: %w", err)
+	}
+	matches, err := s.DB.GetSeasonMatches(ctx, seasonId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season matches: %w", err)
+	}
+
+	players := make([]PublicPlayer, 0, len(participants))
+	for _, participant := range participants {
+		players = append(players, PublicPlayer{Id: participant.ID, Name: participant.Name})
+	}
+
+	scheduleData := map[string]interface{}{
+		"season":  publicSeason(*season),
+		"players": players,
+		"matches": publicMatches(matches),
+	}
+	return api.GetPublicSchedulesToken200JSONResponse(api.ApiResult{
+		Data:      &scheduleData,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *SeasonsServer) GetPublicSchedulesTokenScoreboard(ctx context.Context, request api.GetPublicSchedulesTokenScoreboardRequestObject) (api.GetPublicSchedulesTokenScoreboardResponseObject, error) {
+	season, err := s.GetSharedSeason(ctx, request.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	byGroup := request.Params.ByGroup != nil && *request.Params.ByGroup
+	scoreboard, groups, err := s.GetSeasonStandings(ctx, season, byGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	scoreboardData := map[string]interface{}{
+		"scoreboard":  scoreboard,
+		"seasonName":  season.Name,
+		"tiebreakers": season.Tiebreakers,
+	}
+	if byGroup {
+		scoreboardData["groups"] = groups
+	}
+	return api.GetPublicSchedulesTokenScoreboard200JSONResponse(api.ApiResult{
+		Data:      &scoreboardData,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *SeasonsServer) GetPublicSchedulesTokenUpcoming(ctx context.Context, request api.GetPublicSchedulesTokenUpcomingRequestObject) (api.GetPublicSchedulesTokenUpcomingResponseObject, error) {
+	season, err := s.GetSharedSeason(ctx, request.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.DB.GetSeasonUpcomingMatches(ctx, pgtype.Int4{Int32: season.ID, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upcoming matches: %w", err)
+	}
+
+	matchesData := map[string]interface{}{
+		"matches": publicMatches(matches),
+	}
+	return api.GetPublicSchedulesTokenUpcoming200JSONResponse(api.ApiResult{
+		Data:      &matchesData,
+		IsSuccess: Ptr(true),
+	}), nil
+}
@@ -0,0 +1,310 @@
+package api_server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5/pgtype"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// Rating systems supported by ComputeRatings
+const (
+	RatingSystemElo     = "elo"
+	RatingSystemGlicko2 = "glicko2"
+)
+
+const (
+	defaultKFactor    = 32.0
+	initialRating     = 1500.0
+	initialDeviation  = 350.0
+	initialVolatility = 0.06
+	// glicko2Tau constrains how quickly volatility changes between periods
+	glicko2Tau   = 0.5
+	glicko2Scale = 173.7178
+)
+
+// RatingOptions selects the rating system and its parameters
+type RatingOptions struct {
+	System  string
+	KFactor float64
+}
+
+// PlayerRating is the rating of a player after replaying their matches
+type PlayerRating struct {
+	PlayerId   int32                `json:"playerId"`
+	Rating     float64              `json:"rating"`
+	Deviation  float64              `json:"deviation,omitempty"`
+	Volatility float64              `json:"volatility,omitempty"`
+	Matches    int                  `json:"matches"`
+	History    []RatingHistoryEntry `json:"history,omitempty"`
+}
+
+// RatingHistoryEntry records a player's rating after a match (Elo) or after
+// a rating period of all matches played on the same date (Glicko-2)
+type RatingHistoryEntry struct {
+	MatchDate openapi_types.Date `json:"matchDate"`
+	MatchId   int32              `json:"matchId,omitempty"`
+	Rating    float64            `json:"rating"`
+	Deviation float64            `json:"deviation,omitempty"`
+	Change    float64            `json:"change"`
+}
+
+// ratedResult is a decided match from the point of view of player one
+type ratedResult struct {
+	matchId   int32
+	matchDate time.Time
+	playerId1 int32
+	playerId2 int32
+	score1    float64
+}
+
+// ParseRatingOptions validates the rating system and K-factor of a request,
+// falling back to Elo with a K-factor of 32.
+func ParseRatingOptions(system *string, kFactor *float32) (RatingOptions, error) {
+	opts := RatingOptions{System: RatingSystemElo, KFactor: defaultKFactor}
+	if system != nil {
+		opts.System = *system
+	}
+	if opts.System != RatingSystemElo && opts.System != RatingSystemGlicko2 {
+		return opts, fmt.Errorf("unknown rating system %q", opts.System)
+	}
+	if kFactor != nil {
+		if *kFactor <= 0 || *kFactor > 100 {
+			return opts, fmt.Errorf("kFactor must be between 0 and 100, got %v", *kFactor)
+		}
+		opts.KFactor = float64(*kFactor)
+	}
+	return opts, nil
+}
+
+// InitialPlayerRating is the rating of a player without rated matches
+func InitialPlayerRating(playerId int32, opts RatingOptions) *PlayerRating {
+	rating := &PlayerRating{PlayerId: playerId, Rating: initialRating}
+	if opts.System == RatingSystemGlicko2 {
+		rating.Deviation = initialDeviation
+		rating.Volatility = initialVolatility
+	}
+	return rating
+}
+
+// LoadRatings rates the players of a user from the decided matches of one
+// season, or of all the user's seasons when seasonId is nil
+func LoadRatings(
+	ctx context.Context,
+	queries *db.Queries,
+	userId int32,
+	seasonId *int32,
+	opts RatingOptions,
+) (map[int32]*PlayerRating, error) {
+	var matches []db.Match
+	var err error
+	if seasonId != nil {
+		matches, err = queries.GetSeasonRatedMatches(ctx, pgtype.Int4{Int32: *seasonId, Valid: true})
+	} else {
+		matches, err = queries.GetUserRatedMatches(ctx, pgtype.Int4{Int32: userId, Valid: true})
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rated matches: %w", err)
+	}
+	return ComputeRatings(matches, opts), nil
+}
+
+// ComputeRatings replays matches in matchDate order and returns the final
+// rating of every player that took part, together with their history.
+// Results are read with matchScore.
+func ComputeRatings(matches []db.Match, opts RatingOptions) map[int32]*PlayerRating {
+	results := make([]ratedResult, 0, len(matches))
+	for _, match := range matches {
+		score, ok := matchScore(match)
+		if !ok {
+			continue
+		}
+		results = append(results, ratedResult{
+			matchId:   match.ID,
+			matchDate: match.Matchdate.Time,
+			playerId1: match.Playerid1.Int32,
+			playerId2: match.Playerid2.Int32,
+			score1:    score,
+		})
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if !results[i].matchDate.Equal(results[j].matchDate) {
+			return results[i].matchDate.Before(results[j].matchDate)
+		}
+		return results[i].matchId < results[j].matchId
+	})
+
+	var ratings map[int32]*PlayerRating
+	if opts.System == RatingSystemGlicko2 {
+		ratings = replayGlicko2(results, opts)
+	} else {
+		ratings = replayElo(results, opts)
+	}
+
+	for _, rating := range ratings {
+		rating.Rating = roundRating(rating.Rating)
+		rating.Deviation = roundRating(rating.Deviation)
+	}
+	return ratings
+}
+
+func replayElo(results []ratedResult, opts RatingOptions) map[int32]*PlayerRating {
+	ratings := make(map[int32]*PlayerRating)
+	get := func(playerId int32) *PlayerRating {
+		if _, ok := ratings[playerId]; !ok {
+			ratings[playerId] = InitialPlayerRating(playerId, opts)
+		}
+		return ratings[playerId]
+	}
+
+	for _, result := range results {
+		one, two := get(result.playerId1), get(result.playerId2)
+		expected1 := 1 / (1 + math.Pow(10, (two.Rating-one.Rating)/400))
+		change := opts.KFactor * (result.score1 - expected1)
+
+		one.Rating += change
+		two.Rating -= change
+		for _, side := range []struct {
+			rating *PlayerRating
+			change float64
+		}{{one, change}, {two, -change}} {
+			side.rating.Matches++
+			side.rating.History = append(side.rating.History, RatingHistoryEntry{
+				MatchDate: openapi_types.Date{Time: result.matchDate},
+				MatchId:   result.matchId,
+				Rating:    roundRating(side.rating.Rating),
+				Change:    roundRating(side.change),
+			})
+		}
+	}
+	return ratings
+}
+
+// glicko2Game is one game of a rating period on the Glicko-2 scale
+type glicko2Game struct {
+	opponentMu  float64
+	opponentPhi float64
+	score       float64
+}
+
+// replayGlicko2 treats every match date as a rating period. Players who sit
+// out a period keep their rating but their deviation grows.
+func replayGlicko2(results []ratedResult, opts RatingOptions) map[int32]*PlayerRating {
+	ratings := make(map[int32]*PlayerRating)
+	for start := 0; start < len(results); {
+		end := start
+		for end < len(results) && results[end].matchDate.Equal(results[start].matchDate) {
+			end++
+		}
+		period := results[start:end]
+		periodDate := openapi_types.Date{Time: period[0].matchDate}
+
+		games := make(map[int32][]glicko2Game)
+		for _, result := range period {
+			for _, id := range []int32{result.playerId1, result.playerId2} {
+				if _, ok := ratings[id]; !ok {
+					ratings[id] = InitialPlayerRating(id, opts)
+				}
+			}
+			one, two := ratings[result.playerId1], ratings[result.playerId2]
+			games[result.playerId1] = append(games[result.playerId1], glicko2Game{
+				opponentMu:  (two.Rating - initialRating) / glicko2Scale,
+				opponentPhi: two.Deviation / glicko2Scale,
+				score:       result.score1,
+			})
+			games[result.playerId2] = append(games[result.playerId2], glicko2Game{
+				opponentMu:  (one.Rating - initialRating) / glicko2Scale,
+				opponentPhi: one.Deviation / glicko2Scale,
+				score:       1 - result.score1,
+			})
+		}
+
+		// Opponents are rated as they stood at the start of the period, so
+		// every update is computed before any is applied
+		updated := make(map[int32][3]float64, len(ratings))
+		for id, rating := range ratings {
+			mu := (rating.Rating - initialRating) / glicko2Scale
+			phi := rating.Deviation / glicko2Scale
+			mu, phi, sigma := glicko2Update(mu, phi, rating.Volatility, games[id])
+			updated[id] = [3]float64{initialRating + glicko2Scale*mu, math.Min(glicko2Scale*phi, initialDeviation), sigma}
+		}
+		for id, rating := range ratings {
+			next := updated[id]
+			if played := len(games[id]); played > 0 {
+				rating.Matches += played
+				rating.History = append(rating.History, RatingHistoryEntry{
+					MatchDate: periodDate,
+					Rating:    roundRating(next[0]),
+					Deviation: roundRating(next[1]),
+					Change:    roundRating(next[0] - rating.Rating),
+				})
+			}
+			rating.Rating, rating.Deviation, rating.Volatility = next[0], next[1], next[2]
+		}
+		start = end
+	}
+	return ratings
+}
+
+// glicko2Update applies one rating period to a player following Glickman's
+// "Example of the Glicko-2 system". Without games only the deviation grows.
+func glicko2Update(mu, phi, sigma float64, games []glicko2Game) (float64, float64, float64) {
+	if len(games) == 0 {
+		return mu, math.Sqrt(phi*phi + sigma*sigma), sigma
+	}
+
+	var vInverse, deltaSum float64
+	for _, game := range games {
+		g := 1 / math.Sqrt(1+3*game.opponentPhi*game.opponentPhi/(math.Pi*math.Pi))
+		e := 1 / (1 + math.Exp(-g*(mu-game.opponentMu)))
+		vInverse += g * g * e * (1 - e)
+		deltaSum += g * (game.score - e)
+	}
+	v := 1 / vInverse
+	delta := v * deltaSum
+
+	// Solve for the new volatility with the Illinois algorithm
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(glicko2Tau*glicko2Tau)
+	}
+	A := a
+	var B float64
+	if delta*delta > phi*phi+v {
+		B = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*glicko2Tau) < 0 {
+			k++
+		}
+		B = a - k*glicko2Tau
+	}
+	fA, fB := f(A), f(B)
+	for math.Abs(B-A) > 1e-6 {
+		C := A + (A-B)*fA/(fB-fA)
+		fC := f(C)
+		if fC*fB <= 0 {
+			A, fA = B, fB
+		} else {
+			fA /= 2
+		}
+		B, fB = C, fC
+	}
+	newSigma := math.Exp(A / 2)
+
+	phiStar := math.Sqrt(phi*phi + newSigma*newSigma)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	newMu := mu + newPhi*newPhi*deltaSum
+	return newMu, newPhi, newSigma
+}
+
+func roundRating(value float64) float64 {
+	return math.Round(value*100) / 100
+}
@@ -0,0 +1,131 @@
+package api_server
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ratedMatch is a decided match between two players, player one winning with
+// score 1, losing with 0 and drawing with 0.5
+func ratedMatch(id int32, day int, playerId1 int32, playerId2 int32, score1 float64) db.Match {
+	match := db.Match{
+		ID:        id,
+		Playerid1: pgtype.Int4{Int32: playerId1, Valid: true},
+		Playerid2: pgtype.Int4{Int32: playerId2, Valid: true},
+		Matchdate: pgtype.Date{Time: time.Date(2026, time.March, day, 0, 0, 0, 0, time.UTC), Valid: true},
+	}
+	switch score1 {
+	case 1:
+		match.Playerid1points, match.Playerid2points = 3, 1
+	case 0:
+		match.Playerid1points, match.Playerid2points = 1, 3
+	default:
+		match.Playerid1points, match.Playerid2points = 2, 2
+	}
+	return match
+}
+
+func TestComputeRatingsElo(t *testing.T) {
+	// Listed out of order, replayed by date
+	matches := []db.Match{
+		ratedMatch(3, 3, 2, 3, 0),
+		ratedMatch(1, 1, 1, 2, 1),
+		ratedMatch(2, 2, 1, 3, 0.5),
+		// Undecided matches are not rated
+		{ID: 4, Playerid1: pgtype.Int4{Int32: 1, Valid: true}, Playerid2: pgtype.Int4{Int32: 4, Valid: true}},
+	}
+
+	tests := []struct {
+		name    string
+		kFactor float64
+		want    map[int32]float64
+	}{
+		{"default K-factor", defaultKFactor, map[int32]float64{1: 1515.26, 2: 1468.77, 3: 1515.97}},
+		{"lower K-factor", 16, map[int32]float64{1: 1507.82, 2: 1484.19, 3: 1508}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratings := ComputeRatings(matches, RatingOptions{System: RatingSystemElo, KFactor: tt.kFactor})
+			if len(ratings) != len(tt.want) {
+				t.Fatalf("got ratings for %d players, want %d", len(ratings), len(tt.want))
+			}
+			for playerId, want := range tt.want {
+				if got := ratings[playerId].Rating; got != want {
+					t.Errorf("player %d is rated %v, want %v", playerId, got, want)
+				}
+			}
+			if history := ratings[1].History; len(history) != 2 || history[0].MatchId != 1 || history[1].MatchId != 2 {
+				t.Fatalf("player 1 has history %+v, want matches 1 and 2", history)
+			}
+		})
+	}
+}
+
+func TestGlicko2Update(t *testing.T) {
+	// The worked example of Glickman's "Example of the Glicko-2 system": a
+	// player rated 1500 with deviation 200 beats a 1400 and loses to a 1550
+	// and a 1700 in one rating period. The paper rounds intermediate steps, so
+	// ratings agree to a hundredth.
+	games := []glicko2Game{
+		{opponentMu: (1400 - initialRating) / glicko2Scale, opponentPhi: 30 / glicko2Scale, score: 1},
+		{opponentMu: (1550 - initialRating) / glicko2Scale, opponentPhi: 100 / glicko2Scale, score: 0},
+		{opponentMu: (1700 - initialRating) / glicko2Scale, opponentPhi: 300 / glicko2Scale, score: 0},
+	}
+
+	tests := []struct {
+		name          string
+		games         []glicko2Game
+		wantRating    float64
+		wantDeviation float64
+		wantSigma     float64
+	}{
+		{"rating period", games, 1464.06, 151.52, 0.05999},
+		{"no games", nil, 1500, 200.27, 0.06},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mu, phi, sigma := glicko2Update(0, 200/glicko2Scale, initialVolatility, tt.games)
+			if got := initialRating + glicko2Scale*mu; math.Abs(got-tt.wantRating) > 0.01 {
+				t.Errorf("got rating %v, want %v", got, tt.wantRating)
+			}
+			if got := glicko2Scale * phi; math.Abs(got-tt.wantDeviation) > 0.01 {
+				t.Errorf("got deviation %v, want %v", got, tt.wantDeviation)
+			}
+			if math.Abs(sigma-tt.wantSigma) > 1e-5 {
+				t.Errorf("got volatility %v, want %v", sigma, tt.wantSigma)
+			}
+		})
+	}
+}
+
+func TestComputeRatingsGlicko2(t *testing.T) {
+	matches := []db.Match{
+		ratedMatch(1, 1, 1, 2, 1),
+		ratedMatch(2, 2, 3, 4, 0.5),
+	}
+	ratings := ComputeRatings(matches, RatingOptions{System: RatingSystemGlicko2})
+
+	// Two new players move symmetrically after a decisive first game
+	winner, loser := ratings[1], ratings[2]
+	if winner.Rating <= initialRating || winner.Rating+loser.Rating != 2*initialRating {
+		t.Fatalf("got ratings %v and %v, want a symmetric gain for the winner", winner.Rating, loser.Rating)
+	}
+	if winner.Deviation >= initialDeviation || winner.Deviation != loser.Deviation {
+		t.Fatalf("got deviations %v and %v, want equal and below %v", winner.Deviation, loser.Deviation, initialDeviation)
+	}
+
+	// A draw between equals keeps the rating, and sitting out a period does
+	// not change it either
+	for _, playerId := range []int32{3, 4} {
+		if ratings[playerId].Rating != initialRating {
+			t.Errorf("player %d is rated %v after a draw, want %v", playerId, ratings[playerId].Rating, initialRating)
+		}
+	}
+	if len(winner.History) != 1 || winner.Matches != 1 {
+		t.Fatalf("player 1 has %d matches and history %+v, want the first period only", winner.Matches, winner.History)
+	}
+}
@@ -0,0 +1,192 @@
+package api_server
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gameplan-backend/db"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// byePlayer marks the empty slot added to a round-robin with an odd number of players.
+const byePlayer int32 = 0
+
+// ScheduleOptions configures the round-robin generator
+type ScheduleOptions struct {
+	StartDate        time.Time
+	Frequency        string
+	GroupCount       int
+	DoubleRoundRobin bool
+}
+
+// ScheduledMatch is a fixture proposed by the round-robin generator
+type ScheduledMatch struct {
+	Round     int                `json:"round"`
+	Group     int32              `json:"group"`
+	PlayerId1 int32              `json:"playerId1"`
+	PlayerId2 int32              `json:"playerId2"`
+	MatchDate openapi_types.Date `json:"matchDate"`
+}
+
+// ScheduledBye records a player sitting out a round
+type ScheduledBye struct {
+	Round    int   `json:"round"`
+	Group    int32 `json:"group"`
+	PlayerId int32 `json:"playerId"`
+}
+
+// GenerateRoundRobin builds a single or double round-robin for every group of
+// players using the circle method. All groups play round n on the same date,
+// which is derived from the start date and the season frequency.
+func GenerateRoundRobin(players []db.Player, opts ScheduleOptions) ([]ScheduledMatch, []ScheduledBye, error) {
+	if len(players) < 2 {
+		return nil, nil, errors.New("at least two players are required to generate a schedule")
+	}
+	if opts.GroupCount < 1 {
+		opts.GroupCount = 1
+	}
+	if opts.GroupCount > len(players) {
+		return nil, nil, fmt.Errorf("cannot split %d players into %d groups", len(players), opts.GroupCount)
+	}
+
+	groups := assignGroups(players, opts.GroupCount)
+
+	var matches []ScheduledMatch
+	var byes []ScheduledBye
+	for group := int32(1); group <= int32(opts.GroupCount); group++ {
+		for roundIndex, pairings := range roundRobinRounds(groups[group], opts.DoubleRoundRobin) {
+			matchDate, err := roundDate(opts.StartDate, opts.Frequency, roundIndex)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, pairing := range pairings {
+				if pairing[0] == byePlayer || pairing[1] == byePlayer {
+					sittingOut := pairing[0]
+					if sittingOut == byePlayer {
+						sittingOut = pairing[1]
+					}
+					byes = append(byes, ScheduledBye{Round: roundIndex + 1, Group: group, PlayerId: sittingOut})
+					continue
+				}
+				matches = append(matches, ScheduledMatch{
+					Round:     roundIndex + 1,
+					Group:     group,
+					PlayerId1: pairing[0],
+					PlayerId2: pairing[1],
+					MatchDate: openapi_types.Date{Time: matchDate},
+				})
+			}
+		}
+	}
+	return matches, byes, nil
+}
+
+// assignGroups splits players into groups numbered 1..groupCount. Players whose
+// preferredMatchGroup names one of the groups are placed there first, the
+// others fill the smallest group, lowest number first.
+func assignGroups(players []db.Player, groupCount int) map[int32][]int32 {
+	sorted := make([]db.Player, len(players))
+	copy(sorted, players)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	groups := make(map[int32][]int32, groupCount)
+	var unassigned []int32
+	for _, player := range sorted {
+		preferred := player.Preferredmatchgroup
+		if preferred.Valid && preferred.Int32 >= 1 && preferred.Int32 <= int32(groupCount) {
+			groups[preferred.Int32] = append(groups[preferred.Int32], player.ID)
+			continue
+		}
+		unassigned = append(unassigned, player.ID)
+	}
+
+	for _, playerId := range unassigned {
+		smallest := int32(1)
+		for group := int32(2); group <= int32(groupCount); group++ {
+			if len(groups[group]) < len(groups[smallest]) {
+				smallest = group
+			}
+		}
+		groups[smallest] = append(groups[smallest], playerId)
+	}
+	return groups
+}
+
+// roundRobinRounds pairs every player with every other player using the circle
+// method: the first slot stays fixed while the others rotate one step per round.
+// An odd field gets a byePlayer slot. The double variant replays every round
+// with home and away swapped.
+func roundRobinRounds(playerIds []int32, double bool) [][][2]int32 {
+	if len(playerIds) < 2 {
+		return nil
+	}
+
+	slots := make([]int32, len(playerIds))
+	copy(slots, playerIds)
+	if len(slots)%2 == 1 {
+		slots = append(slots, byePlayer)
+	}
+
+	n := len(slots)
+	rounds := make([][][2]int32, 0, n-1)
+	for round := 0; round < n-1; round++ {
+		pairings := make([][2]int32, 0, n/2)
+		for i := 0; i < n/2; i++ {
+			home, away := slots[i], slots[n-1-i]
+			// Alternate the fixed slot between home and away
+			if i == 0 && round%2 == 1 {
+				home, away = away, home
+			}
+			pairings = append(pairings, [2]int32{home, away})
+		}
+		rounds = append(rounds, pairings)
+
+		// Rotate every slot but the first one step clockwise
+		last := slots[n-1]
+		copy(slots[2:], slots[1:n-1])
+		slots[1] = last
+	}
+
+	if double {
+		firstLeg := len(rounds)
+		for round := 0; round < firstLeg; round++ {
+			reversed := make([][2]int32, len(rounds[round]))
+			for i, pairing := range rounds[round] {
+				reversed[i] = [2]int32{pairing[1], pairing[0]}
+			}
+			rounds = append(rounds, reversed)
+		}
+	}
+	return rounds
+}
+
+// roundDate returns the date of the zero-based round for a season frequency
+func roundDate(start time.Time, frequency string, round int) (time.Time, error) {
+	switch frequency {
+	case "weekly":
+		return start.AddDate(0, 0, 7*round), nil
+	case "biweekly":
+		return start.AddDate(0, 0, 14*round), nil
+	case "monthly":
+		return addMonths(start, round), nil
+	case "quarterly":
+		return addMonths(start, 3*round), nil
+	case "yearly":
+		return addMonths(start, 12*round), nil
+	}
+	return time.Time{}, fmt.Errorf("unknown season frequency %q", frequency)
+}
+
+// addMonths adds whole months, clamping to the end of shorter months instead
+// of overflowing into the next one like time.AddDate does (Jan 31 + 1 month).
+func addMonths(start time.Time, months int) time.Time {
+	firstOfMonth := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location()).AddDate(0, months, 0)
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	day := start.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), day, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+}
@@ -0,0 +1,151 @@
+package api_server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gameplan-backend/db"
+)
+
+func schedulePlayers(count int) []db.Player {
+	players := make([]db.Player, count)
+	for i := range players {
+		players[i] = db.Player{ID: int32(i + 1)}
+	}
+	return players
+}
+
+func TestGenerateRoundRobin(t *testing.T) {
+	start := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		players    int
+		double     bool
+		wantRounds int
+		wantByes   int
+	}{
+		{"two players", 2, false, 1, 0},
+		{"odd field", 3, false, 3, 3},
+		{"even field", 4, false, 3, 0},
+		{"larger odd field", 7, false, 7, 7},
+		{"double even field", 4, true, 6, 0},
+		{"double odd field", 5, true, 10, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matches, byes, err := GenerateRoundRobin(schedulePlayers(tt.players), ScheduleOptions{
+				StartDate:        start,
+				Frequency:        "weekly",
+				DoubleRoundRobin: tt.double,
+			})
+			if err != nil {
+				t.Fatalf("generating the schedule failed: %v", err)
+			}
+			if len(byes) != tt.wantByes {
+				t.Fatalf("got %d byes, want %d", len(byes), tt.wantByes)
+			}
+
+			// Every player plays or sits out exactly once per round
+			appearances := map[int]map[int32]int{}
+			for round := 1; round <= tt.wantRounds; round++ {
+				appearances[round] = map[int32]int{}
+			}
+			for _, match := range matches {
+				if appearances[match.Round] == nil {
+					t.Fatalf("match %+v is outside of the %d rounds", match, tt.wantRounds)
+				}
+				appearances[match.Round][match.PlayerId1]++
+				appearances[match.Round][match.PlayerId2]++
+				want := start.AddDate(0, 0, 7*(match.Round-1))
+				if !match.MatchDate.Time.Equal(want) {
+					t.Fatalf("round %d is on %s, want %s", match.Round, match.MatchDate, want.Format(time.DateOnly))
+				}
+			}
+			for _, bye := range byes {
+				appearances[bye.Round][bye.PlayerId]++
+			}
+			for round, players := range appearances {
+				for id := int32(1); id <= int32(tt.players); id++ {
+					if players[id] != 1 {
+						t.Fatalf("player %d appears %d times in round %d, want once", id, players[id], round)
+					}
+				}
+			}
+
+			// Every pair meets once per leg, the second leg with home and away swapped
+			legs := 1
+			if tt.double {
+				legs = 2
+			}
+			meetings := map[[2]int32]int{}
+			for _, match := range matches {
+				meetings[[2]int32{match.PlayerId1, match.PlayerId2}]++
+			}
+			for a := int32(1); a <= int32(tt.players); a++ {
+				for b := a + 1; b <= int32(tt.players); b++ {
+					home, away := meetings[[2]int32{a, b}], meetings[[2]int32{b, a}]
+					if home+away != legs || (tt.double && home != 1) {
+						t.Fatalf("players %d and %d meet %d times at home and %d away, want %d legs", a, b, home, away, legs)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateRoundRobinGroups(t *testing.T) {
+	players := schedulePlayers(5)
+	players[4].Preferredmatchgroup.Int32, players[4].Preferredmatchgroup.Valid = 1, true
+
+	matches, _, err := GenerateRoundRobin(players, ScheduleOptions{Frequency: "weekly", GroupCount: 2})
+	if err != nil {
+		t.Fatalf("generating the schedule failed: %v", err)
+	}
+	groups := map[int32]int32{}
+	for _, match := range matches {
+		for _, id := range []int32{match.PlayerId1, match.PlayerId2} {
+			if group, ok := groups[id]; ok && group != match.Group {
+				t.Fatalf("player %d plays in groups %d and %d", id, group, match.Group)
+			}
+			groups[id] = match.Group
+		}
+	}
+	if groups[5] != 1 {
+		t.Fatalf("player 5 plays in group %d, want the preferred group 1", groups[5])
+	}
+
+	if _, _, err := GenerateRoundRobin(schedulePlayers(2), ScheduleOptions{Frequency: "weekly", GroupCount: 3}); err == nil {
+		t.Fatal("more groups than players were accepted")
+	}
+}
+
+func TestRoundDate(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		frequency string
+		round     int
+		want      time.Time
+	}{
+		{"weekly", 2, time.Date(2026, time.February, 14, 0, 0, 0, 0, time.UTC)},
+		{"biweekly", 1, time.Date(2026, time.February, 14, 0, 0, 0, 0, time.UTC)},
+		{"monthly", 1, time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC)},
+		{"monthly", 2, time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC)},
+		{"quarterly", 1, time.Date(2026, time.April, 30, 0, 0, 0, 0, time.UTC)},
+		{"yearly", 1, time.Date(2027, time.January, 31, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got, err := roundDate(start, tt.frequency, tt.round)
+		if err != nil {
+			t.Fatalf("%s round %d failed: %v", tt.frequency, tt.round, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%s round %d is on %s, want %s", tt.frequency, tt.round, got.Format(time.DateOnly), tt.want.Format(time.DateOnly))
+		}
+	}
+
+	if _, err := roundDate(start, "daily", 1); err == nil {
+		t.Fatal("an unknown frequency was accepted")
+	}
+}
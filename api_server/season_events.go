@@ -0,0 +1,416 @@
+package api_server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+)
+
+// Types of the events sent on the event stream of a season
+const (
+	EventMatchCreated     = "match-created"
+	EventScoreUpdated     = "score-updated"
+	EventMatchDeleted     = "match-deleted"
+	EventStandingsChanged = "standings-changed"
+)
+
+// seasonEventsChannel is the Postgres channel events are relayed on between
+// API instances
+const seasonEventsChannel = "season_events"
+
+const (
+	// seasonEventsBuffer is how many events a stream can fall behind before it
+	// is closed, the client then reconnects and refetches
+	seasonEventsBuffer = 32
+	// seasonEventsHeartbeat keeps idle streams open through proxies
+	seasonEventsHeartbeat = 25 * time.Second
+	// seasonEventsRetry is how long clients wait before reconnecting
+	seasonEventsRetry = 5 * time.Second
+)
+
+// SeasonEvent is a change to a season, sent to the streams watching it
+type SeasonEvent struct {
+	SeasonId int32           `json:"seasonId"`
+	Type     string          `json:"type"`
+	Data     json.RawMessage `json:"data,omitempty"`
+}
+
+// seasonNotification is the payload of a NOTIFY on seasonEventsChannel
+type seasonNotification struct {
+	Origin string      `json:"origin"`
+	Event  SeasonEvent `json:"event"`
+}
+
+// SeasonEvents fans events out to the streams of this instance and relays
+// them to the other instances through Postgres LISTEN/NOTIFY
+type SeasonEvents struct {
+	DBPool *pgxpool.Pool
+	Logger echo.Logger
+	// Scoreboard loads the standings sent with standings-changed events, set
+	// before Listen runs
+	Scoreboard func(ctx context.Context, seasonId int32) ([]StandingsRow, error)
+
+	// origin tells the notifications of this instance apart, they were
+	// already delivered locally
+	origin string
+
+	mu          sync.Mutex
+	subscribers map[int32]map[chan SeasonEvent]struct{}
+}
+
+// NewSeasonEvents creates the event hub of this instance, Listen must run for
+// it to receive the events of other instances
+func NewSeasonEvents(dbPool *pgxpool.Pool, logger echo.Logger) (*SeasonEvents, error) {
+	origin := make([]byte, 8)
+	if _, err := rand.Read(origin); err != nil {
+		return nil, fmt.Errorf("failed to generate event origin: %w", err)
+	}
+	return &SeasonEvents{
+		DBPool:      dbPool,
+		Logger:      logger,
+		origin:      hex.EncodeToString(origin),
+		subscribers: make(map[int32]map[chan SeasonEvent]struct{}),
+	}, nil
+}
+
+// NewSeasonEvent creates an event with data encoded as JSON
+func NewSeasonEvent(seasonId int32, eventType string, data interface{}) (SeasonEvent, error) {
+	event := SeasonEvent{SeasonId: seasonId, Type: eventType}
+	if data != nil {
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return event, fmt.Errorf("failed to encode %s event: %w", eventType, err)
+		}
+		event.Data = encoded
+	}
+	return event, nil
+}
+
+// Subscribe returns the events of a season until unsubscribe is called. The
+// channel is closed early when the subscriber falls behind.
+func (e *SeasonEvents) Subscribe(seasonId int32) (<-chan SeasonEvent, func()) {
+	events := make(chan SeasonEvent, seasonEventsBuffer)
+
+	e.mu.Lock()
+	if e.subscribers[seasonId] == nil {
+		e.subscribers[seasonId] = make(map[chan SeasonEvent]struct{})
+	}
+	e.subscribers[seasonId][events] = struct{}{}
+	e.mu.Unlock()
+
+	unsubscribe := func() {
+		e.mu.Lock()
+		defer e.mu.Unlock()
+		e.remove(seasonId, events)
+	}
+	return events, unsubscribe
+}
+
+// remove closes a subscriber, the caller holds the lock
+func (e *SeasonEvents) remove(seasonId int32, events chan SeasonEvent) {
+	if _, ok := e.subscribers[seasonId][events]; !ok {
+		return
+	}
+	delete(e.subscribers[seasonId], events)
+	if len(e.subscribers[seasonId]) == 0 {
+		delete(e.subscribers, seasonId)
+	}
+	close(events)
+}
+
+// watched tells whether a season has subscribers on this instance
+func (e *SeasonEvents) watched(seasonId int32) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.subscribers[seasonId]) > 0
+}
+
+// deliver sends an event to the subscribers of its season on this instance.
+// The scoreboard of a standings change is loaded once for all of them, only
+// when there are any.
+func (e *SeasonEvents) deliver(ctx context.Context, event SeasonEvent) {
+	if event.Type == EventStandingsChanged && e.watched(event.SeasonId) {
+		scoreboard, err := e.Scoreboard(ctx, event.SeasonId)
+		if err == nil {
+			event, err = NewSeasonEvent(event.SeasonId, event.Type, map[string]interface{}{
+				"scoreboard": scoreboard,
+			})
+		}
+		if err != nil {
+			// The streams end, their clients reconnect and refetch
+			e.Logger.Errorf("Failed to load the scoreboard of season %d: %v", event.SeasonId, err)
+			e.closeSeason(event.SeasonId)
+			return
+		}
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for events := range e.subscribers[event.SeasonId] {
+		select {
+		case events <- event:
+		default:
+			e.remove(event.SeasonId, events)
+		}
+	}
+}
+
+// closeSeason closes the subscribers of a season
+func (e *SeasonEvents) closeSeason(seasonId int32) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for events := range e.subscribers[seasonId] {
+		e.remove(seasonId, events)
+	}
+}
+
+// closeAll closes every subscriber, whose clients reconnect and refetch
+func (e *SeasonEvents) closeAll() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for seasonId, seasonSubscribers := range e.subscribers {
+		for events := range seasonSubscribers {
+			e.remove(seasonId, events)
+		}
+	}
+}
+
+// Publish delivers events on this instance and notifies the other ones. Call
+// it once the change is committed.
+func (e *SeasonEvents) Publish(ctx context.Context, events ...SeasonEvent) error {
+	for _, event := range events {
+		e.deliver(ctx, event)
+
+		payload, err := json.Marshal(seasonNotification{Origin: e.origin, Event: event})
+		if err != nil {
+			return fmt.Errorf("failed to encode season event: %w", err)
+		}
+		if _, err := e.DBPool.Exec(ctx, "SELECT pg_notify($1, $2)", seasonEventsChannel, string(payload)); err != nil {
+			return fmt.Errorf("failed to notify season event: %w", err)
+		}
+	}
+	return nil
+}
+
+// Listen delivers the events of other instances until ctx is done,
+// reconnecting when the connection is lost
+func (e *SeasonEvents) Listen(ctx context.Context) {
+	connected := false
+	for {
+		err := e.listen(ctx, func() {
+			// Events sent while disconnected are lost, streams start over
+			if connected {
+				e.closeAll()
+			}
+			connected = true
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		e.Logger.Errorf("Season events listener stopped: %v", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(seasonEventsRetry):
+		}
+	}
+}
+
+func (e *SeasonEvents) listen(ctx context.Context, onConnect func()) error {
+	pooled, err := e.DBPool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	// The connection keeps listening, so it never goes back to the pool
+	conn := pooled.Hijack()
+	defer conn.Close(context.Background())
+
+	if _, err := conn.Exec(ctx, "LISTEN "+seasonEventsChannel); err != nil {
+		return fmt.Errorf("failed to listen: %w", err)
+	}
+	onConnect()
+
+	for {
+		notification, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to wait for notification: %w", err)
+		}
+
+		var payload seasonNotification
+		if err := json.Unmarshal([]byte(notification.Payload), &payload); err != nil {
+			e.Logger.Warnf("Ignoring malformed season event: %v", err)
+			continue
+		}
+		if payload.Origin != e.origin {
+			e.deliver(ctx, payload.Event)
+		}
+	}
+}
+
+// seasonEventStream streams the events of a season as Server-Sent Events.
+// When authorize is set it is checked on every heartbeat, the stream ends once
+// it fails.
+type seasonEventStream struct {
+	ctx       context.Context
+	events    *SeasonEvents
+	seasonId  int32
+	authorize func(ctx context.Context) error
+}
+
+func (s seasonEventStream) VisitGetSeasonsSeasonIdEventsResponse(w http.ResponseWriter) error {
+	return s.serve(w)
+}
+
+func (s seasonEventStream) VisitGetPublicSchedulesTokenEventsResponse(w http.ResponseWriter) error {
+	return s.serve(w)
+}
+
+func (s seasonEventStream) serve(w http.ResponseWriter) error {
+	events, unsubscribe := s.events.Subscribe(s.seasonId)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// Keeps nginx from buffering the stream
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	flusher := http.NewResponseController(w)
+	if _, err := fmt.Fprintf(w, "retry: %d\n\n", seasonEventsRetry.Milliseconds()); err != nil {
+		return err
+	}
+	if err := flusher.Flush(); err != nil {
+		return err
+	}
+
+	heartbeat := time.NewTicker(seasonEventsHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return nil
+		case <-heartbeat.C:
+			if s.authorize != nil {
+				if err := s.authorize(s.ctx); err != nil {
+					return nil
+				}
+			}
+			if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+				return nil
+			}
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if err := writeServerSentEvent(w, event); err != nil {
+				return nil
+			}
+		}
+		if err := flusher.Flush(); err != nil {
+			return nil
+		}
+	}
+}
+
+func writeServerSentEvent(w http.ResponseWriter, event SeasonEvent) error {
+	data := event.Data
+	if data == nil {
+		data = json.RawMessage("{}")
+	}
+	_, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event.Type, data)
+	return err
+}
+
+// MatchEvents lists the events of a change to a match. The match is sent
+// without its custom values, like on public links. A match moved to another
+// season is deleted from the previous one, an inactive match is deleted.
+func MatchEvents(eventType string, match db.Match, previousSeasonId int32) ([]SeasonEvent, error) {
+	var events []SeasonEvent
+	seasonId := match.Seasonid.Int32
+
+	if previousSeasonId != 0 && previousSeasonId != seasonId {
+		deleted, err := NewSeasonEvent(previousSeasonId, EventMatchDeleted, map[string]interface{}{"matchId": match.ID})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, deleted, SeasonEvent{SeasonId: previousSeasonId, Type: EventStandingsChanged})
+		eventType = EventMatchCreated
+	}
+
+	if !match.Isactive {
+		eventType = EventMatchDeleted
+	}
+	if eventType == EventMatchDeleted {
+		deleted, err := NewSeasonEvent(seasonId, EventMatchDeleted, map[string]interface{}{"matchId": match.ID})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, deleted)
+	} else {
+		changed, err := NewSeasonEvent(seasonId, eventType, map[string]interface{}{"match": publicMatch(match)})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, changed)
+	}
+
+	// Creating an undecided match leaves the standings as they are
+	if _, decided := matchScore(match); eventType != EventMatchCreated || decided {
+		events = append(events, SeasonEvent{SeasonId: seasonId, Type: EventStandingsChanged})
+	}
+	return events, nil
+}
+
+// API endpoint implementations
+
+func (s *SeasonsServer) GetSeasonsSeasonIdEvents(ctx context.Context, request api.GetSeasonsSeasonIdEventsRequestObject) (api.GetSeasonsSeasonIdEventsResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	season, err := s.GetSeason(ctx, userID, int32(request.SeasonId))
+	if err != nil {
+		return nil, err
+	}
+
+	return seasonEventStream{
+		ctx:      ctx,
+		events:   s.Events,
+		seasonId: season.ID,
+	}, nil
+}
+
+// GetPublicSchedulesTokenEvents streams the same events to anyone with the
+// link, the stream ends once the link expires or is revoked
+func (s *SeasonsServer) GetPublicSchedulesTokenEvents(ctx context.Context, request api.GetPublicSchedulesTokenEventsRequestObject) (api.GetPublicSchedulesTokenEventsResponseObject, error) {
+	season, err := s.GetSharedSeason(ctx, request.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	return seasonEventStream{
+		ctx:      ctx,
+		events:   s.Events,
+		seasonId: season.ID,
+		authorize: func(ctx context.Context) error {
+			_, err := s.GetSharedSeason(ctx, request.Token)
+			return err
+		},
+	}, nil
+}
@@ -0,0 +1,258 @@
+package api_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Status of a season member: pending until an account with the invited email
+// is verified, which is when the role starts to apply
+const (
+	MemberStatusActive  = "active"
+	MemberStatusPending = "pending"
+)
+
+// SeasonMember is the owner or a member of a season. The owner has no id, it
+// is not an invitation.
+type SeasonMember struct {
+	Id        *int32     `json:"id"`
+	Email     string     `json:"email"`
+	Role      string     `json:"role"`
+	Status    string     `json:"status"`
+	UserId    *int32     `json:"userId"`
+	Name      *string    `json:"name"`
+	InvitedAt *time.Time `json:"invitedAt"`
+}
+
+func seasonMemberInfo(member db.GetSeasonMembersRow) SeasonMember {
+	info := SeasonMember{
+		Id:     Ptr(member.ID),
+		Email:  member.Email,
+		Role:   member.Role,
+		Status: MemberStatusPending,
+	}
+	if member.MemberUserID.Valid {
+		info.Status = MemberStatusActive
+		info.UserId = Ptr(member.MemberUserID.Int32)
+	}
+	if member.MemberName.Valid {
+		info.Name = Ptr(member.MemberName.String)
+	}
+	if member.Createdat.Valid {
+		info.InvitedAt = Ptr(member.Createdat.Time)
+	}
+	return info
+}
+
+// ListSeasonMembers lists the owner of a season followed by its members in
+// the order they were invited
+func (s *SeasonsServer) ListSeasonMembers(ctx context.Context, seasonId int32) ([]SeasonMember, error) {
+	ownerId, err := s.DB.GetSeasonOwner(ctx, seasonId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season owner: %w", err)
+	}
+	owner, err := s.DB.GetUserById(ctx, ownerId.Int32)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season owner: %w", err)
+	}
+	members, err := s.DB.GetSeasonMembers(ctx, seasonId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season members: %w", err)
+	}
+
+	infos := make([]SeasonMember, 0, len(members)+1)
+	infos = append(infos, SeasonMember{
+		Email:  strings.ToLower(owner.Email),
+		Role:   api.RoleOwner,
+		Status: MemberStatusActive,
+		UserId: Ptr(owner.ID),
+		Name:   Ptr(owner.Name),
+	})
+	for _, member := range members {
+		infos = append(infos, seasonMemberInfo(member))
+	}
+	return infos, nil
+}
+
+// seasonMember describes one member of a season with its status
+func (s *SeasonsServer) seasonMember(ctx context.Context, seasonId int32, memberId int32) (SeasonMember, error) {
+	members, err := s.ListSeasonMembers(ctx, seasonId)
+	if err != nil {
+		return SeasonMember{}, err
+	}
+	for _, member := range members {
+		if member.Id != nil && *member.Id == memberId {
+			return member, nil
+		}
+	}
+	return SeasonMember{}, apierror.NotFound(fmt.Sprintf("Season member %d not found", memberId))
+}
+
+// InviteSeasonMember gives a role on a season to whoever verifies an account
+// with the email, now or later. Invitations are identified by email only, no
+// email is sent.
+func (s *SeasonsServer) InviteSeasonMember(
+	ctx context.Context,
+	userId int32,
+	seasonId int32,
+	email string,
+	role string,
+) (*db.SeasonMember, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if !strings.Contains(email, "@") {
+		return nil, apierror.Validation(apierror.CodeInvalidEmail, "Invalid email address format")
+	}
+
+	owner, err := s.DB.GetUserById(ctx, userId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user: %w", err)
+	}
+	if strings.EqualFold(owner.Email, email) {
+		return nil, apierror.Validation(apierror.CodeInvalidEmail, "You already own this season")
+	}
+
+	member, err := s.DB.CreateSeasonMember(ctx, db.CreateSeasonMemberParams{
+		Seasonid:  seasonId,
+		Email:     email,
+		Role:      role,
+		Invitedby: pgtype.Int4{Int32: userId, Valid: true},
+	})
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+		return nil, apierror.Conflict(apierror.CodeConflict, fmt.Sprintf("%s is already a member of this season", email))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create season member: %w", err)
+	}
+	return &member, nil
+}
+
+// RemoveSeasonMember removes a member from a season. Only the owner removes
+// other members, any member can leave by themselves.
+func (s *SeasonsServer) RemoveSeasonMember(ctx context.Context, userId int32, seasonId int32, memberId int32) error {
+	member, err := s.DB.GetSeasonMember(ctx, db.GetSeasonMemberParams{
+		ID:       memberId,
+		Seasonid: seasonId,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apierror.NotFound(fmt.Sprintf("Season member %d not found", memberId))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get season member: %w", err)
+	}
+
+	role, err := api.SeasonRole(ctx, s.DB, seasonId)
+	if err != nil {
+		return err
+	}
+	if role != api.RoleOwner {
+		user, err := s.DB.GetUserById(ctx, userId)
+		if err != nil {
+			return fmt.Errorf("failed to get user: %w", err)
+		}
+		if !strings.EqualFold(user.Email, member.Email) {
+			return apierror.Forbidden("Only the owner of the season can remove other members")
+		}
+	}
+
+	if err := s.DB.DeleteSeasonMember(ctx, db.DeleteSeasonMemberParams{
+		ID:       memberId,
+		Seasonid: seasonId,
+	}); err != nil {
+		return fmt.Errorf("failed to delete season member: %w", err)
+	}
+	return nil
+}
+
+// API endpoint implementations
+
+func (s *SeasonsServer) GetSeasonsSeasonIdMembers(ctx context.Context, request api.GetSeasonsSeasonIdMembersRequestObject) (api.GetSeasonsSeasonIdMembersResponseObject, error) {
+	members, err := s.ListSeasonMembers(ctx, int32(request.SeasonId))
+	if err != nil {
+		return nil, err
+	}
+
+	membersMap := map[string]interface{}{
+		"members": members,
+	}
+	return api.GetSeasonsSeasonIdMembers200JSONResponse(api.ApiResult{
+		Data:      &membersMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *SeasonsServer) PostSeasonsSeasonIdMembers(ctx context.Context, request api.PostSeasonsSeasonIdMembersRequestObject) (api.PostSeasonsSeasonIdMembersResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.InviteSeasonMember(ctx, userID, int32(request.SeasonId), request.Body.Email, string(request.Body.Role))
+	if err != nil {
+		return nil, err
+	}
+
+	// Active at once when the email belongs to a verified account
+	info, err := s.seasonMember(ctx, member.Seasonid, member.ID)
+	if err != nil {
+		return nil, err
+	}
+	memberMap := map[string]interface{}{
+		"member": info,
+	}
+	return api.PostSeasonsSeasonIdMembers200JSONResponse(api.ApiResult{
+		Data:      &memberMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *SeasonsServer) PutSeasonsSeasonIdMembersMemberId(ctx context.Context, request api.PutSeasonsSeasonIdMembersMemberIdRequestObject) (api.PutSeasonsSeasonIdMembersMemberIdResponseObject, error) {
+	member, err := s.DB.UpdateSeasonMemberRole(ctx, db.UpdateSeasonMemberRoleParams{
+		Role:     string(request.Body.Role),
+		ID:       int32(request.MemberId),
+		Seasonid: int32(request.SeasonId),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apierror.NotFound(fmt.Sprintf("Season member %d not found", request.MemberId))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update season member: %w", err)
+	}
+
+	info, err := s.seasonMember(ctx, member.Seasonid, member.ID)
+	if err != nil {
+		return nil, err
+	}
+	memberMap := map[string]interface{}{
+		"member": info,
+	}
+	return api.PutSeasonsSeasonIdMembersMemberId200JSONResponse(api.ApiResult{
+		Data:      &memberMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *SeasonsServer) DeleteSeasonsSeasonIdMembersMemberId(ctx context.Context, request api.DeleteSeasonsSeasonIdMembersMemberIdRequestObject) (api.DeleteSeasonsSeasonIdMembersMemberIdResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.RemoveSeasonMember(ctx, userID, int32(request.SeasonId), int32(request.MemberId)); err != nil {
+		return nil, err
+	}
+
+	return api.DeleteSeasonsSeasonIdMembersMemberId200JSONResponse(api.ApiResult{
+		IsSuccess: Ptr(true),
+	}), nil
+}
@@ -3,15 +3,36 @@ package api_server
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
 	"github.com/gameplan-backend/db"
 	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+var errBracketExists = apierror.Conflict(apierror.CodeBracketExists, "The season already has a bracket, set replaceExisting to rebuild it")
+
+var errBracketPlayed = apierror.Conflict(apierror.CodeBracketExists, "The bracket has played matches and can no longer be rebuilt")
+
+// GroupStandings holds the standings of one match group
+type GroupStandings struct {
+	Group     int32          `json:"group"`
+	Standings []StandingsRow `json:"standings"`
+}
+
 // SeasonsServer handles season-related operations
 type SeasonsServer struct {
-	DB *db.Queries
+	DB     *db.Queries
+	DBPool *pgxpool.Pool
+	// ShareLinkSecret signs the tokens of public schedule links
+	ShareLinkSecret []byte
+	// APIURL is the public base URL of the API, public links start with it
+	APIURL string
+	// Events streams the changes of seasons to their watchers
+	Events *SeasonEvents
 }
 
 // CreateSeason creates a new season record based on API params
@@ -33,7 +54,7 @@ func (s *SeasonsServer) CreateSeason(
 
 	season, err := s.DB.CreateSeason(ctx, params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create season: %w", err)
+		return nil, fmt.Errorf("failed to create season: %w", duplicateNameError(err, "season", name))
 	}
 	return &season, nil
 }
@@ -68,13 +89,17 @@ func (s *SeasonsServer) UpdateSeason(
 	}
 
 	params := db.UpdateSeasonParams{
-		ID:         seasonId,
-		Userid:     pgtype.Int4{Int32: userId, Valid: true},
-		Name:       current.Name,
-		Startdate:  current.Startdate,
-		Seasontype: current.Seasontype,
-		Frequency:  current.Frequency,
-		Isactive:   current.Isactive,
+		ID:            seasonId,
+		Userid:        pgtype.Int4{Int32: userId, Valid: true},
+		Name:          current.Name,
+		Startdate:     current.Startdate,
+		Seasontype:    current.Seasontype,
+		Frequency:     current.Frequency,
+		Isactive:      current.Isactive,
+		Pointsperwin:  current.Pointsperwin,
+		Pointsperdraw: current.Pointsperdraw,
+		Pointsperloss: current.Pointsperloss,
+		Tiebreakers:   current.Tiebreakers,
 	}
 
 	// Apply updates from the key-value map
@@ -90,12 +115,20 @@ func (s *SeasonsServer) UpdateSeason(
 			params.Frequency = value.(string)
 		case "isActive":
 			params.Isactive = value.(bool)
+		case "pointsPerWin":
+			params.Pointsperwin = value.(int32)
+		case "pointsPerDraw":
+			params.Pointsperdraw = value.(int32)
+		case "pointsPerLoss":
+			params.Pointsperloss = value.(int32)
+		case "tiebreakers":
+			params.Tiebreakers = value.([]string)
 		}
 	}
 
 	season, err := s.DB.UpdateSeason(ctx, params)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update season: %w", err)
+		return nil, fmt.Errorf("failed to update season: %w", duplicateNameError(err, "season", params.Name))
 	}
 	return &season, nil
 }
@@ -115,11 +148,12 @@ func (s *SeasonsServer) DeleteSeason(
 	return nil
 }
 
-// ListSeasons retrieves all seasons for a user
+// ListSeasons retrieves all seasons a user owns or is a member of, with the
+// user's role in each
 func (s *SeasonsServer) ListSeasons(
 	ctx context.Context,
 	userId int32,
-) ([]db.Season, error) {
+) ([]db.GetSeasonsRow, error) {
 	seasons, err := s.DB.GetSeasons(ctx, pgtype.Int4{Int32: userId, Valid: true})
 	if err != nil {
 		return nil, fmt.Errorf("failed to list seasons: %w", err)
@@ -127,52 +161,320 @@ func (s *SeasonsServer) ListSeasons(
 	return seasons, nil
 }
 
-// GetSeasonScoreboard retrieves the scoreboard for a season
-func (s *SeasonsServer) GetSeasonScoreboard(
+// checkNewSeasonLimits checks a new season against the active seasons and
+// players per season limits of the user's plan
+func (s *SeasonsServer) checkNewSeasonLimits(
 	ctx context.Context,
 	userId int32,
-	seasonId int32,
-) ([]db.GetSeasonScoreboardRow, error) {
-	scoreboard, err := s.DB.GetSeasonScoreboard(ctx, db.GetSeasonScoreboardParams{
-		Seasonid: pgtype.Int4{Int32: seasonId, Valid: true},
-		ID:       seasonId,
-	})
+	playerCount int,
+) error {
+	plan, err := UserPlan(ctx, s.DB, userId)
+	if err != nil {
+		return err
+	}
+	seasonCount, err := s.DB.CountActiveSeasons(ctx, pgtype.Int4{Int32: userId, Valid: true})
+	if err != nil {
+		return fmt.Errorf("failed to count seasons: %w", err)
+	}
+	if err := plan.Check(LimitActiveSeasons, seasonCount, 1); err != nil {
+		return err
+	}
+	return plan.Check(LimitPlayersPerSeason, 0, int64(playerCount))
+}
+
+// GetSeasonStandings computes the standings of a season with its scoring and
+// tiebreaker settings, and optionally the standings of every match group
+func (s *SeasonsServer) GetSeasonStandings(
+	ctx context.Context,
+	season *db.Season,
+	byGroup bool,
+) ([]StandingsRow, []GroupStandings, error) {
+	seasonId := pgtype.Int4{Int32: season.ID, Valid: true}
+	participants, err := s.DB.GetSeasonParticipants(ctx, seasonId)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get season participants: %w", err)
+	}
+	matches, err := s.DB.GetSeasonMatches(ctx, seasonId)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get season scoreboard: %w", err)
+		return nil, nil, fmt.Errorf("failed to get season matches: %w", err)
+	}
+
+	standings := ComputeStandings(participants, matches, SeasonStandingsOptions(season, nil))
+	if !byGroup {
+		return standings, nil, nil
+	}
+
+	var groups []GroupStandings
+	for _, group := range MatchGroups(matches) {
+		groups = append(groups, GroupStandings{
+			Group:     group,
+			Standings: ComputeStandings(participants, matches, SeasonStandingsOptions(season, Ptr(group))),
+		})
 	}
-	return scoreboard, nil
+	return standings, groups, nil
 }
 
-// GetSeasonUpcomingMatches retrieves upcoming matches for a season
+// SeasonScoreboard computes the overall standings of a season, which
+// standings-changed events carry
+func (s *SeasonsServer) SeasonScoreboard(ctx context.Context, seasonId int32) ([]StandingsRow, error) {
+	ownerId, err := s.DB.GetSeasonOwner(ctx, seasonId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get season owner: %w", err)
+	}
+	season, err := s.GetSeason(ctx, ownerId.Int32, seasonId)
+	if err != nil {
+		return nil, err
+	}
+	scoreboard, _, err := s.GetSeasonStandings(ctx, season, false)
+	return scoreboard, err
+}
+
+// GetSeasonUpcomingMatches retrieves upcoming matches for a season with their
+// custom values
 func (s *SeasonsServer) GetSeasonUpcomingMatches(
 	ctx context.Context,
 	seasonId int32,
-) ([]db.Match, error) {
+) ([]SeasonMatch, error) {
 	matches, err := s.DB.GetSeasonUpcomingMatches(ctx, pgtype.Int4{Int32: seasonId, Valid: true})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get upcoming matches: %w", err)
 	}
+	return WithMatchCustomValues(ctx, s.DB, seasonId, matches)
+}
+
+// SaveSchedule stores generated matches in a single transaction, optionally
+// replacing the season's matches that have no result yet
+func (s *SeasonsServer) SaveSchedule(
+	ctx context.Context,
+	seasonId int32,
+	schedule []ScheduledMatch,
+	replaceExisting bool,
+) ([]db.Match, error) {
+	tx, err := s.DBPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := s.DB.WithTx(tx)
+	if replaceExisting {
+		if err := qtx.DeleteUnplayedSeasonMatches(ctx, pgtype.Int4{Int32: seasonId, Valid: true}); err != nil {
+			return nil, fmt.Errorf("failed to clear existing matches: %w", err)
+		}
+	}
+
+	matches := make([]db.Match, 0, len(schedule))
+	for _, scheduled := range schedule {
+		match, err := qtx.CreateMatch(ctx, db.CreateMatchParams{
+			Seasonid:        pgtype.Int4{Int32: seasonId, Valid: true},
+			Playerid1:       pgtype.Int4{Int32: scheduled.PlayerId1, Valid: true},
+			Playerid2:       pgtype.Int4{Int32: scheduled.PlayerId2, Valid: true},
+			Playerid1points: 0,
+			Playerid2points: 0,
+			Winnerid:        pgtype.Int4{Valid: false},
+			Group:           scheduled.Group,
+			Matchdate:       pgtype.Date{Time: scheduled.MatchDate.Time, Valid: true},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create match: %w", err)
+		}
+		matches = append(matches, match)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit schedule: %w", err)
+	}
 	return matches, nil
 }
 
+// SaveBracket stores a bracket plan in a single transaction: one match per
+// node, the bracket_matches rows describing its position and the links that
+// carry winners and losers forward. The season's format is switched to the
+// bracket format.
+func (s *SeasonsServer) SaveBracket(
+	ctx context.Context,
+	season *db.Season,
+	format string,
+	nodes []BracketNode,
+	startDate time.Time,
+	replaceExisting bool,
+) ([]db.GetSeasonBracketRow, error) {
+	tx, err := s.DBPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	qtx := s.DB.WithTx(tx)
+	existing, err := qtx.GetSeasonBracket(ctx, season.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get existing bracket: %w", err)
+	}
+	if len(existing) > 0 && !replaceExisting {
+		return nil, errBracketExists
+	}
+	// Only a bracket nobody played yet is replaced, the other matches of the
+	// season are kept
+	for _, row := range existing {
+		if bracketMatchPlayed(row) {
+			return nil, errBracketPlayed
+		}
+	}
+	if err := qtx.DeleteSeasonBracket(ctx, season.ID); err != nil {
+		return nil, fmt.Errorf("failed to clear existing bracket: %w", err)
+	}
+
+	matchIds := make([]int32, len(nodes))
+	for i, node := range nodes {
+		matchDate, err := roundDate(startDate, season.Frequency, node.DateIndex)
+		if err != nil {
+			return nil, err
+		}
+		params := db.CreateMatchParams{
+			Seasonid:  pgtype.Int4{Int32: season.ID, Valid: true},
+			Winnerid:  pgtype.Int4{Valid: false},
+			Group:     1,
+			Matchdate: pgtype.Date{Time: matchDate, Valid: true},
+		}
+		if node.PlayerId1 != nil {
+			params.Playerid1 = pgtype.Int4{Int32: *node.PlayerId1, Valid: true}
+		}
+		if node.PlayerId2 != nil {
+			params.Playerid2 = pgtype.Int4{Int32: *node.PlayerId2, Valid: true}
+		}
+		match, err := qtx.CreateMatch(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create match: %w", err)
+		}
+		if _, err := qtx.CreateBracketMatch(ctx, db.CreateBracketMatchParams{
+			Seasonid: season.ID,
+			Matchid:  match.ID,
+			Bracket:  node.Bracket,
+			Round:    int32(node.Round),
+			Position: int32(node.Position),
+		}); err != nil {
+			return nil, fmt.Errorf("failed to create bracket match: %w", err)
+		}
+		matchIds[i] = match.ID
+	}
+
+	for i, node := range nodes {
+		links := db.SetBracketMatchLinksParams{Matchid: matchIds[i]}
+		if node.WinnerNext != nil {
+			links.Winnernextmatchid = pgtype.Int4{Int32: matchIds[node.WinnerNext.Node], Valid: true}
+			links.Winnernextslot = pgtype.Int4{Int32: int32(node.WinnerNext.Slot), Valid: true}
+		}
+		if node.LoserNext != nil {
+			links.Losernextmatchid = pgtype.Int4{Int32: matchIds[node.LoserNext.Node], Valid: true}
+			links.Losernextslot = pgtype.Int4{Int32: int32(node.LoserNext.Slot), Valid: true}
+		}
+		if err := qtx.SetBracketMatchLinks(ctx, links); err != nil {
+			return nil, fmt.Errorf("failed to link bracket matches: %w", err)
+		}
+	}
+
+	if err := qtx.UpdateSeasonFormat(ctx, db.UpdateSeasonFormatParams{Format: format, ID: season.ID}); err != nil {
+		return nil, fmt.Errorf("failed to update season format: %w", err)
+	}
+
+	bracket, err := qtx.GetSeasonBracket(ctx, season.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bracket: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit bracket: %w", err)
+	}
+	return bracket, nil
+}
+
+// bracketSeeds orders the players of a new bracket from the roster of the
+// season's owner. Explicit player lists are taken as seed order; otherwise
+// players are seeded by their standing in the seeding season, and failing that
+// by id.
+func (s *SeasonsServer) bracketSeeds(
+	ctx context.Context,
+	ownerId int32,
+	players []int,
+	seedingSeasonId *int,
+) ([]int32, error) {
+	active, err := s.DB.GetPlayers(ctx, pgtype.Int4{Int32: ownerId, Valid: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get players: %w", err)
+	}
+	owned := make(map[int32]bool, len(active))
+	for _, player := range active {
+		owned[player.ID] = true
+	}
+
+	var field []int32
+	if players != nil {
+		seen := make(map[int32]bool, len(players))
+		for _, playerId := range players {
+			if !owned[int32(playerId)] || seen[int32(playerId)] {
+				return nil, apierror.Validation(
+					apierror.CodeInvalidPlayer,
+					fmt.Sprintf("Player %d is not one of the season owner's active players or is listed twice", playerId),
+				)
+			}
+			seen[int32(playerId)] = true
+			field = append(field, int32(playerId))
+		}
+	} else {
+		for _, player := range active {
+			field = append(field, player.ID)
+		}
+		sort.Slice(field, func(i, j int) bool { return field[i] < field[j] })
+	}
+
+	if seedingSeasonId == nil {
+		return field, nil
+	}
+
+	// The seeding season may belong to another owner, the caller only needs to
+	// see it
+	if err := api.AuthorizeSeason(ctx, s.DB, int32(*seedingSeasonId), api.RoleViewer); err != nil {
+		return nil, err
+	}
+	callerId, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	seedingSeason, err := s.GetSeason(ctx, callerId, int32(*seedingSeasonId))
+	if err != nil {
+		return nil, err
+	}
+	standings, _, err := s.GetSeasonStandings(ctx, seedingSeason, false)
+	if err != nil {
+		return nil, err
+	}
+	rank := make(map[int32]int, len(standings))
+	for i, row := range standings {
+		rank[row.PlayerId] = i
+	}
+	// Players missing from the seeding season go to the bottom, in field order
+	sort.SliceStable(field, func(i, j int) bool {
+		ri, iok := rank[field[i]]
+		rj, jok := rank[field[j]]
+		if iok != jok {
+			return iok
+		}
+		return iok && ri < rj
+	})
+	return field, nil
+}
+
 // API endpoint implementations
 
 func (s *SeasonsServer) GetSeasons(ctx context.Context, request api.GetSeasonsRequestObject) (api.GetSeasonsResponseObject, error) {
-	// Extract user ID from context (would come from JWT in middleware)
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	seasons, err := s.ListSeasons(ctx, userID)
 	if err != nil {
-		return api.GetSeasons200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to list seasons: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	seasonsMap := map[string]interface{}{
@@ -185,7 +487,14 @@ func (s *SeasonsServer) GetSeasons(ctx context.Context, request api.GetSeasonsRe
 }
 
 func (s *SeasonsServer) PostSeasons(ctx context.Context, request api.PostSeasonsRequestObject) (api.PostSeasonsResponseObject, error) {
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkNewSeasonLimits(ctx, userID, len(request.Body.Players)); err != nil {
+		return nil, err
+	}
 
 	season, err := s.CreateSeason(
 		ctx,
@@ -196,16 +505,7 @@ func (s *SeasonsServer) PostSeasons(ctx context.Context, request api.PostSeasons
 		"weekly", // Default frequency
 	)
 	if err != nil {
-		return api.PostSeasons200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to create season: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	seasonMap := map[string]interface{}{
@@ -218,24 +518,26 @@ func (s *SeasonsServer) PostSeasons(ctx context.Context, request api.PostSeasons
 }
 
 func (s *SeasonsServer) GetSeasonsTotalAmount(ctx context.Context, request api.GetSeasonsTotalAmountRequestObject) (api.GetSeasonsTotalAmountResponseObject, error) {
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	seasons, err := s.ListSeasons(ctx, userID)
+	// Only owned seasons count against the plan, not those shared with the user
+	seasonCount, err := s.DB.CountActiveSeasons(ctx, pgtype.Int4{Int32: userID, Valid: true})
 	if err != nil {
-		return api.GetSeasonsTotalAmount200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to count seasons: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, fmt.Errorf("failed to count seasons: %w", err)
+	}
+
+	plan, err := UserPlan(ctx, s.DB, userID)
+	if err != nil {
+		return nil, err
 	}
 
 	countMap := map[string]interface{}{
-		"totalSeasons": len(seasons),
+		"totalSeasons": seasonCount,
+		"tier":         plan.Tier,
+		"quota":        plan.Status(LimitActiveSeasons, seasonCount),
 	}
 	return api.GetSeasonsTotalAmount200JSONResponse(api.ApiResult{
 		Data:      &countMap,
@@ -244,19 +546,13 @@ func (s *SeasonsServer) GetSeasonsTotalAmount(ctx context.Context, request api.G
 }
 
 func (s *SeasonsServer) DeleteSeasonsSeasonId(ctx context.Context, request api.DeleteSeasonsSeasonIdRequestObject) (api.DeleteSeasonsSeasonIdResponseObject, error) {
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	if err := s.DeleteSeason(ctx, userID, int32(request.SeasonId)); err != nil {
-		return api.DeleteSeasonsSeasonId200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to delete season: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	return api.DeleteSeasonsSeasonId200JSONResponse(api.ApiResult{
@@ -265,50 +561,26 @@ func (s *SeasonsServer) DeleteSeasonsSeasonId(ctx context.Context, request api.D
 }
 
 func (s *SeasonsServer) GetSeasonsSeasonId(ctx context.Context, request api.GetSeasonsSeasonIdRequestObject) (api.GetSeasonsSeasonIdResponseObject, error) {
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	season, err := s.GetSeason(ctx, userID, int32(request.SeasonId))
 	if err != nil {
-		return api.GetSeasonsSeasonId200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to get season: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
-	// Get players for the season
-	players, err := s.DB.GetPlayers(ctx, pgtype.Int4{Int32: userID, Valid: true})
+	// Get players for the season, the roster of its owner
+	players, err := s.DB.GetPlayers(ctx, season.Userid)
 	if err != nil {
-		return api.GetSeasonsSeasonId200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to get players: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	// Get matches for the season
-	matches, err := s.DB.GetSeasonUpcomingMatches(ctx, pgtype.Int4{Int32: int32(request.SeasonId), Valid: true})
-	if err != nil {
-		return api.GetSeasonsSeasonId200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to get matches: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+	matches, err := s.GetSeasonUpcomingMatches(ctx, int32(request.SeasonId))
+	if err != nil {
+		return nil, err
 	}
 
 	seasonDetails := map[string]interface{}{
@@ -323,24 +595,33 @@ func (s *SeasonsServer) GetSeasonsSeasonId(ctx context.Context, request api.GetS
 }
 
 func (s *SeasonsServer) PutSeasonsSeasonId(ctx context.Context, request api.PutSeasonsSeasonIdRequestObject) (api.PutSeasonsSeasonIdResponseObject, error) {
-	userID := int32(1) // TODO: Get from auth context
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	updates := map[string]interface{}{
 		"name": request.Body.Name,
 	}
+	if request.Body.PointsPerWin != nil {
+		updates["pointsPerWin"] = int32(*request.Body.PointsPerWin)
+	}
+	if request.Body.PointsPerDraw != nil {
+		updates["pointsPerDraw"] = int32(*request.Body.PointsPerDraw)
+	}
+	if request.Body.PointsPerLoss != nil {
+		updates["pointsPerLoss"] = int32(*request.Body.PointsPerLoss)
+	}
+	if request.Body.Tiebreakers != nil {
+		if err := ValidateTiebreakers(*request.Body.Tiebreakers); err != nil {
+			return nil, apierror.Validation(apierror.CodeInvalidTiebreakers, err.Error())
+		}
+		updates["tiebreakers"] = *request.Body.Tiebreakers
+	}
 
 	season, err := s.UpdateSeason(ctx, userID, int32(request.SeasonId), updates)
 	if err != nil {
-		return api.PutSeasonsSeasonId200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to update season: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	seasonMap := map[string]interface{}{
@@ -352,53 +633,67 @@ func (s *SeasonsServer) PutSeasonsSeasonId(ctx context.Context, request api.PutS
 	}), nil
 }
 
-func (s *SeasonsServer) GetSeasonsSeasonIdPublicScheduleLink(ctx context.Context, request api.GetSeasonsSeasonIdPublicScheduleLinkRequestObject) (api.GetSeasonsSeasonIdPublicScheduleLinkResponseObject, error) {
-	// TODO: Implement public schedule link generation
-	publicLink := fmt.Sprintf("/public/seasons/%d/schedule", request.SeasonId)
-	publicLinkMap := map[string]interface{}{
-		"publicLink": publicLink,
-	}
-	return api.GetSeasonsSeasonIdPublicScheduleLink200JSONResponse(api.ApiResult{
-		Data:      &publicLinkMap,
-		IsSuccess: Ptr(true),
-	}), nil
-}
-
 func (s *SeasonsServer) GetSeasonsSeasonIdScoreboard(ctx context.Context, request api.GetSeasonsSeasonIdScoreboardRequestObject) (api.GetSeasonsSeasonIdScoreboardResponseObject, error) {
-	userID := int32(1) // TODO: Get from auth context
-
-	scoreboard, err := s.GetSeasonScoreboard(ctx, userID, int32(request.SeasonId))
-	if err != nil {
-		return api.GetSeasonsSeasonIdScoreboard200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to get scoreboard: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	season, err := s.GetSeason(ctx, userID, int32(request.SeasonId))
 	if err != nil {
-		return api.GetSeasonsSeasonIdScoreboard200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to get season name: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
+	}
+
+	byGroup := request.Params.ByGroup != nil && *request.Params.ByGroup
+	scoreboard, groups, err := s.GetSeasonStandings(ctx, season, byGroup)
+	if err != nil {
+		return nil, err
 	}
 
 	scoreboardData := map[string]interface{}{
-		"scoreboard": scoreboard,
-		"seasonName": season.Name,
+		"scoreboard":  scoreboard,
+		"seasonName":  season.Name,
+		"tiebreakers": season.Tiebreakers,
+	}
+	if byGroup {
+		scoreboardData["groups"] = groups
+	}
+
+	// Optional rating column, rated on this season or on all of the owner's seasons
+	if request.Params.Rating != nil {
+		opts, err := ParseRatingOptions((*string)(request.Params.Rating), request.Params.KFactor)
+		if err != nil {
+			return nil, apierror.Validation(apierror.CodeInvalidRatingOptions, err.Error())
+		}
+
+		seasonId := &season.ID
+		if request.Params.RatingScope != nil && *request.Params.RatingScope == api.Overall {
+			seasonId = nil
+		}
+		ratings, err := LoadRatings(ctx, s.DB, season.Userid.Int32, seasonId, opts)
+		if err != nil {
+			return nil, err
+		}
+
+		addRatings := func(rows []StandingsRow) {
+			for i := range rows {
+				rating, ok := ratings[rows[i].PlayerId]
+				if !ok {
+					rating = InitialPlayerRating(rows[i].PlayerId, opts)
+				}
+				rows[i].Rating = Ptr(rating.Rating)
+				if opts.System == RatingSystemGlicko2 {
+					rows[i].RatingDeviation = Ptr(rating.Deviation)
+				}
+			}
+		}
+		addRatings(scoreboard)
+		for _, group := range groups {
+			addRatings(group.Standings)
+		}
+		scoreboardData["ratingSystem"] = opts.System
 	}
+
 	return api.GetSeasonsSeasonIdScoreboard200JSONResponse(api.ApiResult{
 		Data:      &scoreboardData,
 		IsSuccess: Ptr(true),
@@ -408,16 +703,7 @@ func (s *SeasonsServer) GetSeasonsSeasonIdScoreboard(ctx context.Context, reques
 func (s *SeasonsServer) GetSeasonsSeasonIdUpcoming(ctx context.Context, request api.GetSeasonsSeasonIdUpcomingRequestObject) (api.GetSeasonsSeasonIdUpcomingResponseObject, error) {
 	matches, err := s.GetSeasonUpcomingMatches(ctx, int32(request.SeasonId))
 	if err != nil {
-		return api.GetSeasonsSeasonIdUpcoming200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DB_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to get upcoming matches: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	matchesData := map[string]interface{}{
@@ -428,3 +714,182 @@ func (s *SeasonsServer) GetSeasonsSeasonIdUpcoming(ctx context.Context, request
 		IsSuccess: Ptr(true),
 	}), nil
 }
+
+func (s *SeasonsServer) PostSeasonsSeasonIdScheduleGenerate(ctx context.Context, request api.PostSeasonsSeasonIdScheduleGenerateRequestObject) (api.PostSeasonsSeasonIdScheduleGenerateResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	season, err := s.GetSeason(ctx, userID, int32(request.SeasonId))
+	if err != nil {
+		return nil, err
+	}
+	// Replacing unplayed matches would tear the bracket of an elimination season
+	if season.Format != FormatLeague {
+		return nil, apierror.Conflict(
+			apierror.CodeInvalidSchedule,
+			fmt.Sprintf("Round-robin schedules are only generated for league seasons, this season is %s", season.Format),
+		)
+	}
+
+	// The field comes from the roster and the plan of the season's owner
+	players, err := s.DB.GetPlayers(ctx, season.Userid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Restrict the field to the requested players, all active players otherwise
+	if request.Body.Players != nil {
+		byId := make(map[int32]db.Player, len(players))
+		for _, player := range players {
+			byId[player.ID] = player
+		}
+		selected := make([]db.Player, 0, len(*request.Body.Players))
+		seen := make(map[int32]bool, len(*request.Body.Players))
+		for _, playerId := range *request.Body.Players {
+			player, ok := byId[int32(playerId)]
+			if !ok || seen[int32(playerId)] {
+				return nil, apierror.Validation(
+					apierror.CodeInvalidPlayer,
+					fmt.Sprintf("Player %d is not one of the season owner's active players or is listed twice", playerId),
+				)
+			}
+			seen[int32(playerId)] = true
+			selected = append(selected, player)
+		}
+		players = selected
+	}
+
+	if err := CheckPlanLimit(ctx, s.DB, season.Userid.Int32, LimitPlayersPerSeason, 0, int64(len(players))); err != nil {
+		return nil, err
+	}
+
+	opts := ScheduleOptions{
+		StartDate:        season.Startdate.Time,
+		Frequency:        season.Frequency,
+		GroupCount:       1,
+		DoubleRoundRobin: request.Body.DoubleRoundRobin,
+	}
+	if request.Body.StartDate != nil {
+		opts.StartDate = request.Body.StartDate.Time
+	}
+	if request.Body.GroupCount != nil {
+		opts.GroupCount = *request.Body.GroupCount
+	}
+
+	schedule, byes, err := GenerateRoundRobin(players, opts)
+	if err != nil {
+		return nil, apierror.Validation(apierror.CodeInvalidSchedule, err.Error())
+	}
+
+	scheduleData := map[string]interface{}{
+		"dryRun":   request.Body.DryRun,
+		"schedule": schedule,
+		"byes":     byes,
+	}
+	if request.Body.DryRun {
+		return api.PostSeasonsSeasonIdScheduleGenerate200JSONResponse(api.ApiResult{
+			Data:      &scheduleData,
+			IsSuccess: Ptr(true),
+		}), nil
+	}
+
+	replaceExisting := request.Body.ReplaceExisting != nil && *request.Body.ReplaceExisting
+	matches, err := s.SaveSchedule(ctx, season.ID, schedule, replaceExisting)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleData["matches"] = matches
+	return api.PostSeasonsSeasonIdScheduleGenerate200JSONResponse(api.ApiResult{
+		Data:      &scheduleData,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *SeasonsServer) GetSeasonsSeasonIdBracket(ctx context.Context, request api.GetSeasonsSeasonIdBracketRequestObject) (api.GetSeasonsSeasonIdBracketResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	season, err := s.GetSeason(ctx, userID, int32(request.SeasonId))
+	if err != nil {
+		return nil, err
+	}
+
+	bracket, err := s.DB.GetSeasonBracket(ctx, season.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	bracketData := map[string]interface{}{
+		"format":  season.Format,
+		"bracket": bracket,
+	}
+	return api.GetSeasonsSeasonIdBracket200JSONResponse(api.ApiResult{
+		Data:      &bracketData,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *SeasonsServer) PostSeasonsSeasonIdBracket(ctx context.Context, request api.PostSeasonsSeasonIdBracketRequestObject) (api.PostSeasonsSeasonIdBracketResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	season, err := s.GetSeason(ctx, userID, int32(request.SeasonId))
+	if err != nil {
+		return nil, err
+	}
+
+	var players []int
+	if request.Body.Players != nil {
+		players = *request.Body.Players
+	}
+	seeds, err := s.bracketSeeds(ctx, season.Userid.Int32, players, request.Body.SeedingSeasonId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := CheckPlanLimit(ctx, s.DB, season.Userid.Int32, LimitPlayersPerSeason, 0, int64(len(seeds))); err != nil {
+		return nil, err
+	}
+
+	format := string(request.Body.Format)
+	nodes, err := PlanBracket(seeds, format)
+	if err != nil {
+		return nil, apierror.Validation(apierror.CodeInvalidBracket, err.Error())
+	}
+
+	bracketData := map[string]interface{}{
+		"dryRun": request.Body.DryRun,
+		"format": format,
+		"seeds":  seeds,
+		"plan":   nodes,
+	}
+	if request.Body.DryRun {
+		return api.PostSeasonsSeasonIdBracket200JSONResponse(api.ApiResult{
+			Data:      &bracketData,
+			IsSuccess: Ptr(true),
+		}), nil
+	}
+
+	startDate := season.Startdate.Time
+	if request.Body.StartDate != nil {
+		startDate = request.Body.StartDate.Time
+	}
+	replaceExisting := request.Body.ReplaceExisting != nil && *request.Body.ReplaceExisting
+	bracket, err := s.SaveBracket(ctx, season, format, nodes, startDate, replaceExisting)
+	if err != nil {
+		return nil, err
+	}
+
+	bracketData["bracket"] = bracket
+	return api.PostSeasonsSeasonIdBracket200JSONResponse(api.ApiResult{
+		Data:      &bracketData,
+		IsSuccess: Ptr(true),
+	}), nil
+}
@@ -0,0 +1,257 @@
+package api_server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/stytchauth/stytch-go/v16/stytch/consumer/sessions"
+	"github.com/stytchauth/stytch-go/v16/stytch/stytcherror"
+)
+
+// SessionInfo is an active session of the caller, with the device it was
+// last used from when known
+type SessionInfo struct {
+	SessionId      string     `json:"sessionId"`
+	Current        bool       `json:"current"`
+	StartedAt      *time.Time `json:"startedAt"`
+	LastAccessedAt *time.Time `json:"lastAccessedAt"`
+	ExpiresAt      *time.Time `json:"expiresAt"`
+	IpAddress      *string    `json:"ipAddress"`
+	UserAgent      *string    `json:"userAgent"`
+	Device         *string    `json:"device"`
+}
+
+// userAgentPlatforms and userAgentBrowsers are matched in order, the first
+// substring found in the user agent names the platform or the browser
+var (
+	userAgentPlatforms = [][2]string{
+		{"iPhone", "iPhone"},
+		{"iPad", "iPad"},
+		{"Android", "Android"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	}
+	userAgentBrowsers = [][2]string{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"CriOS/", "Chrome"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+		{"okhttp", "Android app"},
+		{"CFNetwork", "iOS app"},
+	}
+)
+
+// DescribeDevice names the browser and platform of a user agent, such as
+// "Firefox on Windows", or returns the user agent itself when neither is known
+func DescribeDevice(userAgent string) string {
+	match := func(names [][2]string) string {
+		for _, name := range names {
+			if strings.Contains(userAgent, name[0]) {
+				return name[1]
+			}
+		}
+		return ""
+	}
+
+	platform, browser := match(userAgentPlatforms), match(userAgentBrowsers)
+	switch {
+	case browser != "" && platform != "":
+		return browser + " on " + platform
+	case browser != "":
+		return browser
+	case platform != "":
+		return platform
+	}
+	return userAgent
+}
+
+// stytchSessions lists the active sessions of the caller in Stytch
+func (s *AuthServer) stytchSessions(ctx context.Context, principal api.Principal) ([]sessions.Session, error) {
+	resp, err := s.StytchClient.Sessions.Get(ctx, &sessions.GetParams{UserID: principal.StytchUserID})
+	if err != nil {
+		return nil, apierror.Upstream(apierror.CodeStytchError, "Failed to list sessions", err)
+	}
+	return resp.Sessions, nil
+}
+
+// ListSessions lists the active sessions of the caller, the current one first
+// and the others by last use. Devices recorded for sessions that are no longer
+// active are forgotten.
+func (s *AuthServer) ListSessions(ctx context.Context, principal api.Principal) ([]SessionInfo, error) {
+	active, err := s.stytchSessions(ctx, principal)
+	if err != nil {
+		return nil, err
+	}
+	recorded, err := s.DB.GetUserSessions(ctx, principal.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user sessions: %w", err)
+	}
+	devices := make(map[string]db.UserSession, len(recorded))
+	for _, device := range recorded {
+		devices[device.Sessionid] = device
+	}
+
+	infos := make([]SessionInfo, 0, len(active))
+	activeIds := make([]string, 0, len(active))
+	for _, session := range active {
+		info := SessionInfo{
+			SessionId:      session.SessionID,
+			Current:        session.SessionID == principal.SessionID,
+			StartedAt:      session.StartedAt,
+			LastAccessedAt: session.LastAccessedAt,
+			ExpiresAt:      session.ExpiresAt,
+		}
+		if device, ok := devices[session.SessionID]; ok {
+			if device.Ipaddress.Valid {
+				info.IpAddress = Ptr(device.Ipaddress.String)
+			}
+			if device.Useragent.Valid {
+				info.UserAgent = Ptr(device.Useragent.String)
+				info.Device = Ptr(DescribeDevice(device.Useragent.String))
+			}
+		}
+		infos = append(infos, info)
+		activeIds = append(activeIds, session.SessionID)
+	}
+
+	sort.SliceStable(infos, func(i, j int) bool {
+		if infos[i].Current != infos[j].Current {
+			return infos[i].Current
+		}
+		if infos[i].LastAccessedAt == nil || infos[j].LastAccessedAt == nil {
+			return infos[j].LastAccessedAt == nil && infos[i].LastAccessedAt != nil
+		}
+		return infos[i].LastAccessedAt.After(*infos[j].LastAccessedAt)
+	})
+
+	if err := s.DB.DeleteStaleUserSessions(ctx, db.DeleteStaleUserSessionsParams{
+		Userid:           principal.UserID,
+		ActiveSessionIds: activeIds,
+	}); err != nil {
+		s.Logger.Errorf("Failed to delete stale user sessions: %v", err)
+	}
+	return infos, nil
+}
+
+// revokeSession revokes a session in Stytch, which is then forgotten here
+func (s *AuthServer) revokeSession(ctx context.Context, principal api.Principal, sessionId string) error {
+	_, err := s.StytchClient.Sessions.Revoke(ctx, &sessions.RevokeParams{SessionID: sessionId})
+	var stytchErr stytcherror.Error
+	// A session that expired meanwhile is as good as revoked
+	if err != nil && !(errors.As(err, &stytchErr) && stytchErr.StatusCode == http.StatusNotFound) {
+		return apierror.Upstream(apierror.CodeStytchError, "Failed to revoke session", err)
+	}
+
+	s.Sessions.Evict(sessionId)
+	if err := s.DB.DeleteUserSession(ctx, db.DeleteUserSessionParams{
+		Sessionid: sessionId,
+		Userid:    principal.UserID,
+	}); err != nil {
+		return fmt.Errorf("failed to delete user session: %w", err)
+	}
+	return nil
+}
+
+// RevokeSession revokes one session of the caller
+func (s *AuthServer) RevokeSession(ctx context.Context, principal api.Principal, sessionId string) error {
+	// Stytch revokes any session by id, so ownership is checked first
+	active, err := s.stytchSessions(ctx, principal)
+	if err != nil {
+		return err
+	}
+	for _, session := range active {
+		if session.SessionID == sessionId {
+			return s.revokeSession(ctx, principal, sessionId)
+		}
+	}
+	return apierror.NotFound("Session not found")
+}
+
+// RevokeOtherSessions revokes every session of the caller but one, all of
+// them when keepSessionId is empty
+func (s *AuthServer) RevokeOtherSessions(ctx context.Context, principal api.Principal, keepSessionId string) error {
+	active, err := s.stytchSessions(ctx, principal)
+	if err != nil {
+		return err
+	}
+	for _, session := range active {
+		if session.SessionID == keepSessionId {
+			continue
+		}
+		if err := s.revokeSession(ctx, principal, session.SessionID); err != nil {
+			return err
+		}
+	}
+	s.Sessions.EvictUser(principal.StytchUserID, keepSessionId)
+	return nil
+}
+
+// API endpoint implementations
+
+func (s *AuthServer) GetSessions(ctx context.Context, request api.GetSessionsRequestObject) (api.GetSessionsResponseObject, error) {
+	principal, ok := api.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, apierror.ErrUnauthenticated
+	}
+
+	infos, err := s.ListSessions(ctx, principal)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionsMap := map[string]interface{}{
+		"sessions": infos,
+	}
+	return api.GetSessions200JSONResponse(api.ApiResult{
+		Data:      &sessionsMap,
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *AuthServer) DeleteSessions(ctx context.Context, request api.DeleteSessionsRequestObject) (api.DeleteSessionsResponseObject, error) {
+	principal, ok := api.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, apierror.ErrUnauthenticated
+	}
+
+	var err error
+	if request.Params.All != nil && *request.Params.All {
+		err = s.RevokeOtherSessions(ctx, principal, "")
+	} else {
+		err = s.revokeSession(ctx, principal, principal.SessionID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return api.DeleteSessions200JSONResponse(api.ApiResult{
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+func (s *AuthServer) DeleteSessionsSessionId(ctx context.Context, request api.DeleteSessionsSessionIdRequestObject) (api.DeleteSessionsSessionIdResponseObject, error) {
+	principal, ok := api.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, apierror.ErrUnauthenticated
+	}
+
+	if err := s.RevokeSession(ctx, principal, request.SessionId); err != nil {
+		return nil, err
+	}
+
+	return api.DeleteSessionsSessionId200JSONResponse(api.ApiResult{
+		IsSuccess: Ptr(true),
+	}), nil
+}
@@ -0,0 +1,261 @@
+package api_server
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/gameplan-backend/db"
+)
+
+// Tiebreaker rules accepted in seasons.tiebreakers
+const (
+	TiebreakerHeadToHead        = "head_to_head"
+	TiebreakerPointDifferential = "point_differential"
+	TiebreakerPointsFor         = "points_for"
+	TiebreakerPointsAgainst     = "points_against"
+	TiebreakerWins              = "wins"
+)
+
+// leaguePointsRule orders the standings before any tiebreaker is applied
+const leaguePointsRule = "league_points"
+
+var tiebreakerRules = map[string]bool{
+	TiebreakerHeadToHead:        true,
+	TiebreakerPointDifferential: true,
+	TiebreakerPointsFor:         true,
+	TiebreakerPointsAgainst:     true,
+	TiebreakerWins:              true,
+}
+
+// StandingsOptions configures how standings are scored and ordered
+type StandingsOptions struct {
+	PointsPerWin  int32
+	PointsPerDraw int32
+	PointsPerLoss int32
+	Tiebreakers   []string
+	// Group restricts the standings to the matches of one "group", nil for all
+	Group *int32
+}
+
+// StandingsRow is a player's line in the season standings
+type StandingsRow struct {
+	Rank              int      `json:"rank"`
+	PlayerId          int32    `json:"playerId"`
+	PlayerName        string   `json:"playerName"`
+	Played            int      `json:"played"`
+	Wins              int      `json:"wins"`
+	Draws             int      `json:"draws"`
+	Losses            int      `json:"losses"`
+	PointsFor         int32    `json:"pointsFor"`
+	PointsAgainst     int32    `json:"pointsAgainst"`
+	PointDifferential int32    `json:"pointDifferential"`
+	LeaguePoints      int32    `json:"leaguePoints"`
+	Rating            *float64 `json:"rating,omitempty"`
+	RatingDeviation   *float64 `json:"ratingDeviation,omitempty"`
+}
+
+// SeasonStandingsOptions reads the scoring and tiebreaker settings of a season
+func SeasonStandingsOptions(season *db.Season, group *int32) StandingsOptions {
+	return StandingsOptions{
+		PointsPerWin:  season.Pointsperwin,
+		PointsPerDraw: season.Pointsperdraw,
+		PointsPerLoss: season.Pointsperloss,
+		Tiebreakers:   season.Tiebreakers,
+		Group:         group,
+	}
+}
+
+// ValidateTiebreakers checks that every rule is known and listed only once
+func ValidateTiebreakers(tiebreakers []string) error {
+	seen := make(map[string]bool, len(tiebreakers))
+	for _, rule := range tiebreakers {
+		if !tiebreakerRules[rule] {
+			return fmt.Errorf("unknown tiebreaker %q", rule)
+		}
+		if seen[rule] {
+			return fmt.Errorf("tiebreaker %q is listed twice", rule)
+		}
+		seen[rule] = true
+	}
+	return nil
+}
+
+// matchScore returns player one's score of a decided match: 1 for a win,
+// 0 for a loss and 0.5 for a draw. The recorded winner takes precedence over
+// the points; a match without a winner and without points is not decided.
+func matchScore(match db.Match) (float64, bool) {
+	if !match.Playerid1.Valid || !match.Playerid2.Valid || match.Playerid1.Int32 == match.Playerid2.Int32 {
+		return 0, false
+	}
+	switch {
+	case match.Winnerid.Valid && match.Winnerid.Int32 == match.Playerid1.Int32:
+		return 1, true
+	case match.Winnerid.Valid && match.Winnerid.Int32 == match.Playerid2.Int32:
+		return 0, true
+	case match.Playerid1points > match.Playerid2points:
+		return 1, true
+	case match.Playerid2points > match.Playerid1points:
+		return 0, true
+	case match.Playerid1points != 0:
+		return 0.5, true
+	}
+	return 0, false
+}
+
+// MatchGroups lists the distinct groups used by the matches, in order
+func MatchGroups(matches []db.Match) []int32 {
+	seen := make(map[int32]bool)
+	var groups []int32
+	for _, match := range matches {
+		if !seen[match.Group] {
+			seen[match.Group] = true
+			groups = append(groups, match.Group)
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i] < groups[j] })
+	return groups
+}
+
+// ComputeStandings builds the standings of the players taking part in the
+// matches. Players are ordered by league points, then by the tiebreakers in
+// order; players still level share a rank and are listed by name.
+func ComputeStandings(participants []db.GetSeasonParticipantsRow, matches []db.Match, opts StandingsOptions) []StandingsRow {
+	names := make(map[int32]string, len(participants))
+	for _, participant := range participants {
+		names[participant.ID] = participant.Name
+	}
+
+	rows := make(map[int32]*StandingsRow)
+	row := func(playerId int32) *StandingsRow {
+		if _, ok := rows[playerId]; !ok {
+			rows[playerId] = &StandingsRow{PlayerId: playerId, PlayerName: names[playerId]}
+		}
+		return rows[playerId]
+	}
+
+	var decided []db.Match
+	for _, match := range matches {
+		if opts.Group != nil && match.Group != *opts.Group {
+			continue
+		}
+		// Scheduled players are listed even before their first result
+		if match.Playerid1.Valid {
+			row(match.Playerid1.Int32)
+		}
+		if match.Playerid2.Valid {
+			row(match.Playerid2.Int32)
+		}
+
+		score, ok := matchScore(match)
+		if !ok {
+			continue
+		}
+		decided = append(decided, match)
+		one, two := row(match.Playerid1.Int32), row(match.Playerid2.Int32)
+		one.recordResult(score, match.Playerid1points, match.Playerid2points, opts)
+		two.recordResult(1-score, match.Playerid2points, match.Playerid1points, opts)
+	}
+
+	block := make([]*StandingsRow, 0, len(rows))
+	for _, r := range rows {
+		block = append(block, r)
+	}
+	sort.Slice(block, func(i, j int) bool { return block[i].PlayerId < block[j].PlayerId })
+
+	rules := append([]string{leaguePointsRule}, opts.Tiebreakers...)
+	standings := make([]StandingsRow, 0, len(block))
+	for _, tied := range orderStandings(block, rules, decided) {
+		sort.SliceStable(tied, func(i, j int) bool {
+			return strings.ToLower(tied[i].PlayerName) < strings.ToLower(tied[j].PlayerName)
+		})
+		rank := len(standings) + 1
+		for _, r := range tied {
+			r.Rank = rank
+			standings = append(standings, *r)
+		}
+	}
+	return standings
+}
+
+func (r *StandingsRow) recordResult(score float64, pointsFor int32, pointsAgainst int32, opts StandingsOptions) {
+	r.Played++
+	r.PointsFor += pointsFor
+	r.PointsAgainst += pointsAgainst
+	r.PointDifferential = r.PointsFor - r.PointsAgainst
+	switch score {
+	case 1:
+		r.Wins++
+		r.LeaguePoints += opts.PointsPerWin
+	case 0:
+		r.Losses++
+		r.LeaguePoints += opts.PointsPerLoss
+	default:
+		r.Draws++
+		r.LeaguePoints += opts.PointsPerDraw
+	}
+}
+
+// orderStandings sorts a block of players by the first rule and splits it into
+// the runs of players the rule cannot separate, which are then ordered by the
+// remaining rules. It returns the blocks no rule could separate, best first.
+func orderStandings(block []*StandingsRow, rules []string, matches []db.Match) [][]*StandingsRow {
+	if len(block) <= 1 || len(rules) == 0 {
+		return [][]*StandingsRow{block}
+	}
+
+	values := standingsRuleValues(block, rules[0], matches)
+	sort.SliceStable(block, func(i, j int) bool {
+		return values[block[i].PlayerId] > values[block[j].PlayerId]
+	})
+
+	var ordered [][]*StandingsRow
+	for start := 0; start < len(block); {
+		end := start + 1
+		for end < len(block) && values[block[end].PlayerId] == values[block[start].PlayerId] {
+			end++
+		}
+		ordered = append(ordered, orderStandings(block[start:end], rules[1:], matches)...)
+		start = end
+	}
+	return ordered
+}
+
+// standingsRuleValues scores every player of a block for one rule, higher is better
+func standingsRuleValues(block []*StandingsRow, rule string, matches []db.Match) map[int32]float64 {
+	values := make(map[int32]float64, len(block))
+	switch rule {
+	case TiebreakerHeadToHead:
+		// Results of the matches between the tied players, a draw counting half
+		inBlock := make(map[int32]bool, len(block))
+		for _, r := range block {
+			inBlock[r.PlayerId] = true
+			values[r.PlayerId] = 0
+		}
+		for _, match := range matches {
+			if !inBlock[match.Playerid1.Int32] || !inBlock[match.Playerid2.Int32] {
+				continue
+			}
+			score, _ := matchScore(match)
+			values[match.Playerid1.Int32] += score
+			values[match.Playerid2.Int32] += 1 - score
+		}
+		return values
+	}
+
+	for _, r := range block {
+		switch rule {
+		case leaguePointsRule:
+			values[r.PlayerId] = float64(r.LeaguePoints)
+		case TiebreakerPointDifferential:
+			values[r.PlayerId] = float64(r.PointDifferential)
+		case TiebreakerPointsFor:
+			values[r.PlayerId] = float64(r.PointsFor)
+		case TiebreakerPointsAgainst:
+			values[r.PlayerId] = -float64(r.PointsAgainst)
+		case TiebreakerWins:
+			values[r.PlayerId] = float64(r.Wins)
+		}
+	}
+	return values
+}
@@ -0,0 +1,129 @@
+package api_server
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// playedMatch is a match of player one against player two with its points,
+// the player with more points winning
+func playedMatch(playerId1 int32, points1 int32, playerId2 int32, points2 int32) db.Match {
+	return db.Match{
+		Playerid1:       pgtype.Int4{Int32: playerId1, Valid: true},
+		Playerid1points: points1,
+		Playerid2:       pgtype.Int4{Int32: playerId2, Valid: true},
+		Playerid2points: points2,
+	}
+}
+
+var standingsParticipants = []db.GetSeasonParticipantsRow{
+	{ID: 1, Name: "Alice"},
+	{ID: 2, Name: "bob"},
+	{ID: 3, Name: "Carol"},
+	{ID: 4, Name: "Dave"},
+}
+
+// cycleMatches leaves Alice, Bob and Carol level on points with a win each
+// against one another, and Dave last
+var cycleMatches = []db.Match{
+	playedMatch(1, 10, 2, 0),
+	playedMatch(2, 2, 3, 1),
+	playedMatch(3, 2, 1, 1),
+	playedMatch(1, 1, 4, 0),
+	playedMatch(2, 5, 4, 0),
+	playedMatch(3, 3, 4, 2),
+}
+
+// headToHeadMatches leaves Alice and Bob level on points, Alice winning their
+// match and Bob having the better point differential
+var headToHeadMatches = []db.Match{
+	playedMatch(1, 1, 2, 0),
+	playedMatch(1, 1, 3, 0),
+	playedMatch(2, 9, 3, 0),
+	playedMatch(2, 9, 4, 0),
+	playedMatch(4, 1, 1, 0),
+}
+
+func TestComputeStandings(t *testing.T) {
+	tests := []struct {
+		name        string
+		matches     []db.Match
+		tiebreakers []string
+		wantOrder   []int32
+		wantRanks   []int
+	}{
+		{"level players share a rank by name", cycleMatches, nil, []int32{1, 2, 3, 4}, []int{1, 1, 1, 4}},
+		{"head to head cycle stays level", cycleMatches, []string{TiebreakerHeadToHead}, []int32{1, 2, 3, 4}, []int{1, 1, 1, 4}},
+		{"point differential", cycleMatches, []string{TiebreakerPointDifferential}, []int32{1, 3, 2, 4}, []int{1, 2, 3, 4}},
+		{"points for", cycleMatches, []string{TiebreakerPointsFor}, []int32{1, 2, 3, 4}, []int{1, 2, 3, 4}},
+		{"points against", cycleMatches, []string{TiebreakerPointsAgainst}, []int32{1, 3, 2, 4}, []int{1, 2, 3, 4}},
+		{"next rule after a level head to head", cycleMatches, []string{TiebreakerHeadToHead, TiebreakerPointsFor}, []int32{1, 2, 3, 4}, []int{1, 2, 3, 4}},
+		{"head to head first", headToHeadMatches, []string{TiebreakerHeadToHead, TiebreakerPointDifferential}, []int32{1, 2, 4, 3}, []int{1, 2, 3, 4}},
+		{"point differential first", headToHeadMatches, []string{TiebreakerPointDifferential, TiebreakerHeadToHead}, []int32{2, 1, 4, 3}, []int{1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			standings := ComputeStandings(standingsParticipants, tt.matches, StandingsOptions{
+				PointsPerWin:  3,
+				PointsPerDraw: 1,
+				Tiebreakers:   tt.tiebreakers,
+			})
+
+			var order []int32
+			var ranks []int
+			for _, row := range standings {
+				order = append(order, row.PlayerId)
+				ranks = append(ranks, row.Rank)
+			}
+			if !reflect.DeepEqual(order, tt.wantOrder) || !reflect.DeepEqual(ranks, tt.wantRanks) {
+				t.Fatalf("got players %v ranked %v, want %v ranked %v", order, ranks, tt.wantOrder, tt.wantRanks)
+			}
+		})
+	}
+}
+
+func TestComputeStandingsRecords(t *testing.T) {
+	matches := []db.Match{
+		playedMatch(1, 3, 2, 1),
+		playedMatch(1, 2, 2, 2),
+		// Scheduled but not played yet
+		{Playerid1: pgtype.Int4{Int32: 1, Valid: true}, Playerid2: pgtype.Int4{Int32: 3, Valid: true}},
+	}
+	matches[1].Group = 2
+
+	standings := ComputeStandings(standingsParticipants, matches, StandingsOptions{PointsPerWin: 2, PointsPerDraw: 1, PointsPerLoss: 0})
+	want := []StandingsRow{
+		{Rank: 1, PlayerId: 1, PlayerName: "Alice", Played: 2, Wins: 1, Draws: 1, PointsFor: 5, PointsAgainst: 3, PointDifferential: 2, LeaguePoints: 3},
+		{Rank: 2, PlayerId: 2, PlayerName: "bob", Played: 2, Draws: 1, Losses: 1, PointsFor: 3, PointsAgainst: 5, PointDifferential: -2, LeaguePoints: 1},
+		{Rank: 3, PlayerId: 3, PlayerName: "Carol"},
+	}
+	if !reflect.DeepEqual(standings, want) {
+		t.Fatalf("got standings %+v, want %+v", standings, want)
+	}
+
+	group := int32(2)
+	standings = ComputeStandings(standingsParticipants, matches, StandingsOptions{PointsPerWin: 2, PointsPerDraw: 1, Group: &group})
+	if len(standings) != 2 || standings[0].Played != 1 || standings[0].Draws != 1 {
+		t.Fatalf("got standings %+v for group 2, want the draw only", standings)
+	}
+}
+
+func TestValidateTiebreakers(t *testing.T) {
+	tests := []struct {
+		tiebreakers []string
+		wantErr     bool
+	}{
+		{nil, false},
+		{[]string{TiebreakerHeadToHead, TiebreakerPointDifferential, TiebreakerWins}, false},
+		{[]string{"coin_toss"}, true},
+		{[]string{TiebreakerPointsFor, TiebreakerPointsFor}, true},
+	}
+	for _, tt := range tests {
+		if err := ValidateTiebreakers(tt.tiebreakers); (err != nil) != tt.wantErr {
+			t.Errorf("ValidateTiebreakers(%v) returned %v, want an error: %v", tt.tiebreakers, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package api_server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stripe/stripe-go/v81"
+	"github.com/stripe/stripe-go/v81/webhook"
+)
+
+// maxStripePayloadBytes bounds the webhook body, Stripe events are far smaller
+const maxStripePayloadBytes = 65536
+
+// proSubscriptionStatuses keep the pro tier. Stripe retries the payment of a
+// past_due subscription, so the tier only drops once it is unpaid or canceled.
+var proSubscriptionStatuses = map[stripe.SubscriptionStatus]bool{
+	stripe.SubscriptionStatusActive:   true,
+	stripe.SubscriptionStatusTrialing: true,
+	stripe.SubscriptionStatusPastDue:  true,
+}
+
+// PostWebhooksStripe receives the events sent by Stripe. The raw body is
+// checked against the Stripe-Signature header before anything is read from it.
+func (s *SubscriptionsServer) PostWebhooksStripe(ctx context.Context, request api.PostWebhooksStripeRequestObject) (api.PostWebhooksStripeResponseObject, error) {
+	payload, err := io.ReadAll(io.LimitReader(request.Body, maxStripePayloadBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook body: %w", err)
+	}
+
+	// Only the fields read below are relied on, so events rendered with the
+	// account's API version are accepted even if it differs from stripe-go's
+	event, err := webhook.ConstructEventWithOptions(payload, request.Params.StripeSignature, s.WebhookSecret, webhook.ConstructEventOptions{
+		IgnoreAPIVersionMismatch: true,
+	})
+	if err != nil {
+		return nil, apierror.BadRequest("Invalid Stripe signature")
+	}
+
+	// Errors are returned as a 500 so that Stripe delivers the event again
+	processed, err := s.HandleStripeEvent(ctx, event)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.PostWebhooksStripe200JSONResponse(api.ApiResult{
+		Data: &map[string]interface{}{
+			"eventId":   event.ID,
+			"processed": processed,
+		},
+		IsSuccess: Ptr(true),
+	}), nil
+}
+
+// HandleStripeEvent applies a verified event once. The event ID is recorded in
+// the same transaction as the changes it makes, so a repeated delivery is
+// skipped and reported as not processed.
+func (s *SubscriptionsServer) HandleStripeEvent(ctx context.Context, event stripe.Event) (bool, error) {
+	tx, err := s.DBPool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.DB.WithTx(tx)
+
+	_, err = qtx.CreateStripeEvent(ctx, db.CreateStripeEventParams{
+		ID:   event.ID,
+		Type: string(event.Type),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to record stripe event: %w", err)
+	}
+
+	if err := applyStripeEvent(ctx, qtx, event); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return true, nil
+}
+
+// applyStripeEvent updates the subscription of the user the event is about.
+// Other event types and unknown customers are recorded but otherwise ignored.
+func applyStripeEvent(ctx context.Context, queries *db.Queries, event stripe.Event) error {
+	switch event.Type {
+	case stripe.EventTypeCheckoutSessionCompleted:
+		var session stripe.CheckoutSession
+		if err := json.Unmarshal(event.Data.Raw, &session); err != nil {
+			return fmt.Errorf("failed to parse checkout session: %w", err)
+		}
+		if session.Mode != stripe.CheckoutSessionModeSubscription || session.Subscription == nil {
+			return nil
+		}
+		user, err := stripeCustomerUser(ctx, queries, session.Customer)
+		if user == nil || err != nil {
+			return err
+		}
+		return applyCheckoutSession(ctx, queries, user, &session)
+
+	case stripe.EventTypeCustomerSubscriptionUpdated, stripe.EventTypeCustomerSubscriptionDeleted:
+		var subscription stripe.Subscription
+		if err := json.Unmarshal(event.Data.Raw, &subscription); err != nil {
+			return fmt.Errorf("failed to parse subscription: %w", err)
+		}
+		user, err := stripeCustomerUser(ctx, queries, subscription.Customer)
+		if user == nil || err != nil {
+			return err
+		}
+		if !isCurrentSubscription(user, subscription.ID) {
+			return nil
+		}
+
+		tier := TierFree
+		if proSubscriptionStatuses[subscription.Status] && event.Type != stripe.EventTypeCustomerSubscriptionDeleted {
+			tier = TierPro
+		}
+		return updateUserStripeSubscription(ctx, queries, db.UpdateUserStripeSubscriptionParams{
+			Subscriptiontier:             tier,
+			Stripesubscriptionid:         pgtype.Text{String: subscription.ID, Valid: true},
+			Subscriptionstatus:           pgtype.Text{String: string(subscription.Status), Valid: true},
+			Subscriptioncurrentperiodend: unixTimestamp(subscription.CurrentPeriodEnd),
+			Cancelatperiodend:            subscription.CancelAtPeriodEnd && tier == TierPro,
+			ID:                           user.ID,
+		})
+
+	case stripe.EventTypeInvoicePaymentFailed:
+		var invoice stripe.Invoice
+		if err := json.Unmarshal(event.Data.Raw, &invoice); err != nil {
+			return fmt.Errorf("failed to parse invoice: %w", err)
+		}
+		if invoice.Subscription == nil {
+			return nil
+		}
+		user, err := stripeCustomerUser(ctx, queries, invoice.Customer)
+		if user == nil || err != nil {
+			return err
+		}
+		if !isCurrentSubscription(user, invoice.Subscription.ID) {
+			return nil
+		}
+
+		// The tier is kept while Stripe retries, see proSubscriptionStatuses
+		return updateUserStripeSubscription(ctx, queries, db.UpdateUserStripeSubscriptionParams{
+			Subscriptiontier:             user.Subscriptiontier,
+			Stripesubscriptionid:         pgtype.Text{String: invoice.Subscription.ID, Valid: true},
+			Subscriptionstatus:           pgtype.Text{String: string(stripe.SubscriptionStatusPastDue), Valid: true},
+			Subscriptioncurrentperiodend: user.Subscriptioncurrentperiodend,
+			Cancelatperiodend:            user.Cancelatperiodend,
+			ID:                           user.ID,
+		})
+	}
+	return nil
+}
+
+// applyCheckoutSession records the subscription started by a completed
+// Checkout Session. Sent by webhook the subscription is only an ID, its status
+// and period end then follow with the customer.subscription.updated event.
+func applyCheckoutSession(ctx context.Context, queries *db.Queries, user *db.User, session *stripe.CheckoutSession) error {
+	params := db.UpdateUserStripeSubscriptionParams{
+		Subscriptiontier:             user.Subscriptiontier,
+		Stripesubscriptionid:         pgtype.Text{String: session.Subscription.ID, Valid: true},
+		Subscriptionstatus:           pgtype.Text{String: string(stripe.SubscriptionStatusIncomplete), Valid: true},
+		Subscriptioncurrentperiodend: user.Subscriptioncurrentperiodend,
+		ID:                           user.ID,
+	}
+	switch {
+	case session.Subscription.Status != "":
+		params.Subscriptionstatus.String = string(session.Subscription.Status)
+		params.Subscriptioncurrentperiodend = unixTimestamp(session.Subscription.CurrentPeriodEnd)
+		params.Cancelatperiodend = session.Subscription.CancelAtPeriodEnd
+		if proSubscriptionStatuses[session.Subscription.Status] {
+			params.Subscriptiontier = TierPro
+		}
+	case session.PaymentStatus != stripe.CheckoutSessionPaymentStatusUnpaid:
+		params.Subscriptiontier = TierPro
+		params.Subscriptionstatus.String = string(stripe.SubscriptionStatusActive)
+	}
+	return updateUserStripeSubscription(ctx, queries, params)
+}
+
+// stripeCustomerUser finds the user of a Stripe customer, nil if there is none
+func stripeCustomerUser(ctx context.Context, queries *db.Queries, customer *stripe.Customer) (*db.User, error) {
+	if customer == nil || customer.ID == "" {
+		return nil, nil
+	}
+	user, err := queries.GetUserByStripeId(ctx, customer.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user by stripe id: %w", err)
+	}
+	return &user, nil
+}
+
+// isCurrentSubscription ignores events about a subscription the user has
+// since replaced, so that canceling an old one does not downgrade the user
+func isCurrentSubscription(user *db.User, subscriptionId string) bool {
+	return !user.Stripesubscriptionid.Valid || user.Stripesubscriptionid.String == subscriptionId
+}
+
+func updateUserStripeSubscription(ctx context.Context, queries *db.Queries, params db.UpdateUserStripeSubscriptionParams) error {
+	if err := queries.UpdateUserStripeSubscription(ctx, params); err != nil {
+		return fmt.Errorf("failed to update user subscription: %w", err)
+	}
+	return nil
+}
+
+func unixTimestamp(seconds int64) pgtype.Timestamp {
+	if seconds == 0 {
+		return pgtype.Timestamp{Valid: false}
+	}
+	return pgtype.Timestamp{Time: time.Unix(seconds, 0).UTC(), Valid: true}
+}
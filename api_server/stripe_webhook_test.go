@@ -0,0 +1,190 @@
+package api_server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
+	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stripe/stripe-go/v81/webhook"
+)
+
+const testWebhookSecret = "whsec_test"
+
+// stripeWebhookServer is a SubscriptionsServer over one user, recording the
+// events received and the changes made to the user's subscription
+type stripeWebhookServer struct {
+	*SubscriptionsServer
+	events  map[string]bool
+	updates []db.UpdateUserStripeSubscriptionParams
+}
+
+func newStripeWebhookServer(t *testing.T, user db.User) *stripeWebhookServer {
+	server := &stripeWebhookServer{events: map[string]bool{}}
+	fake := newFakeDB(t, map[string]fakeQuery{
+		"CreateStripeEvent": func(args []any) ([]any, error) {
+			id := args[0].(string)
+			if server.events[id] {
+				return nil, pgx.ErrNoRows
+			}
+			server.events[id] = true
+			return []any{id}, nil
+		},
+		"GetUserByStripeId": func(args []any) ([]any, error) {
+			if args[0].(string) != user.Stripeid {
+				return nil, pgx.ErrNoRows
+			}
+			return rowOf(user), nil
+		},
+		"UpdateUserStripeSubscription": func(args []any) ([]any, error) {
+			server.updates = append(server.updates, db.UpdateUserStripeSubscriptionParams{
+				Subscriptiontier:             args[0].(string),
+				Stripesubscriptionid:         args[1].(pgtype.Text),
+				Subscriptionstatus:           args[2].(pgtype.Text),
+				Subscriptioncurrentperiodend: args[3].(pgtype.Timestamp),
+				Cancelatperiodend:            args[4].(bool),
+				ID:                           args[5].(int32),
+			})
+			return nil, nil
+		},
+	})
+	server.SubscriptionsServer = &SubscriptionsServer{
+		DB:            db.New(fake),
+		DBPool:        fake,
+		WebhookSecret: testWebhookSecret,
+	}
+	return server
+}
+
+// signedStripeRequest signs a fixture of testdata/stripe the way Stripe signs
+// its webhooks
+func signedStripeRequest(t *testing.T, fixture string, secret string) api.PostWebhooksStripeRequestObject {
+	payload, err := os.ReadFile(filepath.Join("..", "testdata", "stripe", fixture))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	signed := webhook.GenerateTestSignedPayload(&webhook.UnsignedPayload{
+		Payload: payload,
+		Secret:  secret,
+	})
+	return api.PostWebhooksStripeRequestObject{
+		Params: api.PostWebhooksStripeParams{StripeSignature: signed.Header},
+		Body:   bytes.NewReader(payload),
+	}
+}
+
+// postStripeEvent sends a webhook request and returns whether its event was
+// processed
+func postStripeEvent(t *testing.T, server *stripeWebhookServer, request api.PostWebhooksStripeRequestObject) bool {
+	response, err := server.PostWebhooksStripe(context.Background(), request)
+	if err != nil {
+		t.Fatalf("the webhook failed: %v", err)
+	}
+	data := *response.(api.PostWebhooksStripe200JSONResponse).Data
+	return data["processed"].(bool)
+}
+
+func fixtureUser() db.User {
+	return db.User{
+		ID:                   1,
+		Stripeid:             "cus_fixture",
+		Subscriptiontier:     TierPro,
+		Stripesubscriptionid: pgtype.Text{String: "sub_fixture", Valid: true},
+		Subscriptionstatus:   pgtype.Text{String: "active", Valid: true},
+	}
+}
+
+func TestStripeWebhookFixtures(t *testing.T) {
+	freeUser := fixtureUser()
+	freeUser.Subscriptiontier = TierFree
+	freeUser.Stripesubscriptionid = pgtype.Text{}
+	freeUser.Subscriptionstatus = pgtype.Text{}
+
+	tests := []struct {
+		fixture    string
+		user       db.User
+		wantTier   string
+		wantStatus string
+	}{
+		{"checkout_session_completed.json", freeUser, TierPro, "active"},
+		{"customer_subscription_updated.json", fixtureUser(), TierPro, "active"},
+		{"customer_subscription_deleted.json", fixtureUser(), TierFree, "canceled"},
+		// The tier is kept while Stripe retries the payment
+		{"invoice_payment_failed.json", fixtureUser(), TierPro, "past_due"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			server := newStripeWebhookServer(t, tt.user)
+
+			if !postStripeEvent(t, server, signedStripeRequest(t, tt.fixture, testWebhookSecret)) {
+				t.Fatal("the event was not processed")
+			}
+			if len(server.updates) != 1 {
+				t.Fatalf("the subscription was updated %d times, want once", len(server.updates))
+			}
+			update := server.updates[0]
+			if update.ID != tt.user.ID || update.Stripesubscriptionid.String != "sub_fixture" {
+				t.Fatalf("updated user %d to subscription %q, want user %d on sub_fixture", update.ID, update.Stripesubscriptionid.String, tt.user.ID)
+			}
+			if update.Subscriptiontier != tt.wantTier || update.Subscriptionstatus.String != tt.wantStatus {
+				t.Fatalf("got tier %s with status %s, want %s with %s", update.Subscriptiontier, update.Subscriptionstatus.String, tt.wantTier, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestStripeWebhookBadSignature(t *testing.T) {
+	server := newStripeWebhookServer(t, fixtureUser())
+
+	request := signedStripeRequest(t, "customer_subscription_deleted.json", "whsec_other")
+	_, err := server.PostWebhooksStripe(context.Background(), request)
+	var apiErr *apierror.Error
+	if !errors.As(err, &apiErr) || apiErr.Status != http.StatusBadRequest {
+		t.Fatalf("got %v, want a bad request error", err)
+	}
+	if len(server.events) != 0 || len(server.updates) != 0 {
+		t.Fatal("an event with a bad signature was applied")
+	}
+}
+
+func TestStripeWebhookDuplicateEvent(t *testing.T) {
+	server := newStripeWebhookServer(t, fixtureUser())
+
+	if !postStripeEvent(t, server, signedStripeRequest(t, "customer_subscription_updated.json", testWebhookSecret)) {
+		t.Fatal("the first delivery was not processed")
+	}
+	if postStripeEvent(t, server, signedStripeRequest(t, "customer_subscription_updated.json", testWebhookSecret)) {
+		t.Fatal("the repeated delivery was processed")
+	}
+	if len(server.updates) != 1 {
+		t.Fatalf("the subscription was updated %d times, want once", len(server.updates))
+	}
+}
+
+func TestStripeWebhookStaleSubscription(t *testing.T) {
+	user := fixtureUser()
+	user.Stripesubscriptionid = pgtype.Text{String: "sub_replacement", Valid: true}
+	server := newStripeWebhookServer(t, user)
+
+	// Canceling the subscription the user replaced keeps them on pro
+	if !postStripeEvent(t, server, signedStripeRequest(t, "customer_subscription_deleted.json", testWebhookSecret)) {
+		t.Fatal("the event was not processed")
+	}
+	if len(server.updates) != 0 {
+		t.Fatalf("the user was updated to %+v by a stale subscription", server.updates[0])
+	}
+
+	current := newStripeWebhookServer(t, fixtureUser())
+	postStripeEvent(t, current, signedStripeRequest(t, "customer_subscription_deleted.json", testWebhookSecret))
+	if len(current.updates) != 1 || current.updates[0].Subscriptiontier != TierFree {
+		t.Fatalf("canceling the current subscription made the updates %+v, want a downgrade", current.updates)
+	}
+}
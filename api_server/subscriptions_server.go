@@ -2,78 +2,257 @@ package api_server
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
 
 	"github.com/gameplan-backend/api"
+	"github.com/gameplan-backend/apierror"
 	"github.com/gameplan-backend/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/stripe/stripe-go/v81"
 	"github.com/stripe/stripe-go/v81/client"
 )
 
+// TxBeginner begins transactions, a *pgxpool.Pool in production and a fake in
+// tests
+type TxBeginner interface {
+	Begin(ctx context.Context) (pgx.Tx, error)
+}
+
 type SubscriptionsServer struct {
 	StripeClient *client.API
 	DB           *db.Queries
+	// DBPool begins the transactions Stripe events are applied in
+	DBPool TxBeginner
+	// WebhookSecret is the signing secret of the Stripe webhook endpoint
+	WebhookSecret string
+	// ProPriceID is the Stripe price of the pro plan
+	ProPriceID string
+	// AppURL is where Stripe sends the user back after checkout
+	AppURL string
 }
 
+var errInvalidCheckoutSession = apierror.Validation(apierror.CodeInvalidSession, "The checkout session does not belong to this user or is not complete")
+
 func (s *SubscriptionsServer) PostSubscriptionsHandleSuccessUpgrade(ctx context.Context, request api.PostSubscriptionsHandleSuccessUpgradeRequestObject) (api.PostSubscriptionsHandleSuccessUpgradeResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.ConfirmCheckoutSession(ctx, userID, request.Body.SessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	data := subscriptionDetails(subscription)
 	return api.PostSubscriptionsHandleSuccessUpgrade200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("PostSubscriptionsHandleSuccessUpgrade not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
+		Data:      &data,
+		IsSuccess: Ptr(true),
 	}), nil
 }
 
 func (s *SubscriptionsServer) PostSubscriptionsInitUpdatePaymentMethod(ctx context.Context, request api.PostSubscriptionsInitUpdatePaymentMethodRequestObject) (api.PostSubscriptionsInitUpdatePaymentMethodResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.DB.GetUserSubscription(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	portal, err := s.StripeClient.BillingPortalSessions.New(&stripe.BillingPortalSessionParams{
+		Customer:  stripe.String(subscription.Stripeid),
+		ReturnURL: stripe.String(s.AppURL + "/subscription"),
+	})
+	if err != nil {
+		return nil, apierror.Upstream(apierror.CodeStripeError, "Failed to open the billing portal", err)
+	}
+
 	return api.PostSubscriptionsInitUpdatePaymentMethod200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("PostSubscriptionsInitUpdatePaymentMethod not implemented yet"),
+		Data: &map[string]interface{}{
+			"url": portal.URL,
 		},
-		IsSuccess: Ptr(false),
+		IsSuccess: Ptr(true),
 	}), nil
 }
 
 func (s *SubscriptionsServer) PostSubscriptionsUpgradeUserSubscription(ctx context.Context, request api.PostSubscriptionsUpgradeUserSubscriptionRequestObject) (api.PostSubscriptionsUpgradeUserSubscriptionResponseObject, error) {
+	userID, err := api.UserIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription, err := s.DB.GetUserSubscription(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Subscriptiontier == TierPro {
+		return nil, apierror.Conflict(apierror.CodeAlreadySubscribed, "You are already subscribed to the pro plan")
+	}
+
+	// Stripe replaces {CHECKOUT_SESSION_ID}, which the app then sends to
+	// handleSuccessUpgrade
+	session, err := s.StripeClient.CheckoutSessions.New(&stripe.CheckoutSessionParams{
+		Customer:          stripe.String(subscription.Stripeid),
+		ClientReferenceID: stripe.String(strconv.Itoa(int(userID))),
+		Mode:              stripe.String(string(stripe.CheckoutSessionModeSubscription)),
+		LineItems: []*stripe.CheckoutSessionLineItemParams{
+			{
+				Price:    stripe.String(s.ProPriceID),
+				Quantity: stripe.Int64(1),
+			},
+		},
+		SuccessURL: stripe.String(s.AppURL + "/subscription/success?session_id={CHECKOUT_SESSION_ID}"),
+		CancelURL:  stripe.String(s.AppURL + "/subscription"),
+	})
+	if err != nil {
+		return nil, apierror.Upstream(apierror.CodeStripeError, "Failed to start the checkout", err)
+	}
+
 	return api.PostSubscriptionsUpgradeUserSubscription200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("PostSubscriptionsUpgradeUserSubscription not implemented yet"),
+		Data: &map[string]interface{}{
+			"sessionId": session.ID,
+			"url":       session.URL,
 		},
-		IsSuccess: Ptr(false),
+		IsSuccess: Ptr(true),
 	}), nil
 }
 
-func (s SubscriptionsServer) DeleteUsersUserIdSubscription(ctx context.Context, request api.DeleteUsersUserIdSubscriptionRequestObject) (api.DeleteUsersUserIdSubscriptionResponseObject, error) {
+// CancelSubscription cancels the pro subscription of a user at the end of the
+// period already paid for, the user keeps the pro tier until then. Stripe
+// confirms with the customer.subscription.deleted event once it ends.
+func (s *SubscriptionsServer) CancelSubscription(ctx context.Context, userId int32) (db.GetUserSubscriptionRow, error) {
+	subscription, err := s.DB.GetUserSubscription(ctx, userId)
+	if err != nil {
+		return db.GetUserSubscriptionRow{}, fmt.Errorf("failed to get user subscription: %w", err)
+	}
+	if !cancellationAllowed(subscription) {
+		return db.GetUserSubscriptionRow{}, apierror.Conflict(apierror.CodeConflict, "There is no active pro subscription to cancel")
+	}
+
+	canceled, err := s.StripeClient.Subscriptions.Update(subscription.Stripesubscriptionid.String, &stripe.SubscriptionParams{
+		CancelAtPeriodEnd: stripe.Bool(true),
+	})
+	if err != nil {
+		return db.GetUserSubscriptionRow{}, apierror.Upstream(apierror.CodeStripeError, "Failed to cancel the subscription", err)
+	}
+
+	if err := updateUserStripeSubscription(ctx, s.DB, db.UpdateUserStripeSubscriptionParams{
+		Subscriptiontier:             subscription.Subscriptiontier,
+		Stripesubscriptionid:         pgtype.Text{String: canceled.ID, Valid: true},
+		Subscriptionstatus:           pgtype.Text{String: string(canceled.Status), Valid: true},
+		Subscriptioncurrentperiodend: unixTimestamp(canceled.CurrentPeriodEnd),
+		Cancelatperiodend:            canceled.CancelAtPeriodEnd,
+		ID:                           userId,
+	}); err != nil {
+		return db.GetUserSubscriptionRow{}, err
+	}
+
+	subscription, err = s.DB.GetUserSubscription(ctx, userId)
+	if err != nil {
+		return db.GetUserSubscriptionRow{}, fmt.Errorf("failed to get user subscription: %w", err)
+	}
+	return subscription, nil
+}
+
+func (s *SubscriptionsServer) DeleteUsersUserIdSubscription(ctx context.Context, request api.DeleteUsersUserIdSubscriptionRequestObject) (api.DeleteUsersUserIdSubscriptionResponseObject, error) {
+	subscription, err := s.CancelSubscription(ctx, int32(request.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	data := subscriptionDetails(subscription)
 	return api.DeleteUsersUserIdSubscription200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("DeleteUsersUserIdSubscription not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
+		Data:      &data,
+		IsSuccess: Ptr(true),
 	}), nil
 }
 
 func (s *SubscriptionsServer) GetUsersUserIdSubscription(ctx context.Context, request api.GetUsersUserIdSubscriptionRequestObject) (api.GetUsersUserIdSubscriptionResponseObject, error) {
+	subscription, err := s.DB.GetUserSubscription(ctx, int32(request.UserId))
+	if err != nil {
+		return nil, err
+	}
+
+	data := subscriptionDetails(subscription)
+	quota, err := LoadQuota(ctx, s.DB, int32(request.UserId), PlanForTier(subscription.Subscriptiontier))
+	if err != nil {
+		return nil, err
+	}
+	data["quota"] = quota
 	return api.GetUsersUserIdSubscription200JSONResponse(api.ApiResult{
-		Error: &struct {
-			Code    *string `json:"code,omitempty"`
-			Message *string `json:"message,omitempty"`
-		}{
-			Code:    Ptr("NOT_IMPLEMENTED"),
-			Message: Ptr("GetUsersUserIdSubscription not implemented yet"),
-		},
-		IsSuccess: Ptr(false),
+		Data:      &data,
+		IsSuccess: Ptr(true),
 	}), nil
 }
+
+// ConfirmCheckoutSession applies a completed Checkout Session of the user
+// without waiting for the webhook, and returns the resulting subscription
+func (s *SubscriptionsServer) ConfirmCheckoutSession(ctx context.Context, userId int32, sessionId string) (db.GetUserSubscriptionRow, error) {
+	params := &stripe.CheckoutSessionParams{}
+	params.AddExpand("subscription")
+	session, err := s.StripeClient.CheckoutSessions.Get(sessionId, params)
+	if err != nil {
+		var stripeErr *stripe.Error
+		if errors.As(err, &stripeErr) && stripeErr.HTTPStatusCode == http.StatusNotFound {
+			return db.GetUserSubscriptionRow{}, errInvalidCheckoutSession
+		}
+		return db.GetUserSubscriptionRow{}, apierror.Upstream(apierror.CodeStripeError, "Failed to confirm the checkout session", err)
+	}
+	if session.Customer == nil || session.Subscription == nil || session.Status != stripe.CheckoutSessionStatusComplete {
+		return db.GetUserSubscriptionRow{}, errInvalidCheckoutSession
+	}
+
+	user, err := stripeCustomerUser(ctx, s.DB, session.Customer)
+	if err != nil {
+		return db.GetUserSubscriptionRow{}, err
+	}
+	if user == nil || user.ID != userId {
+		return db.GetUserSubscriptionRow{}, errInvalidCheckoutSession
+	}
+	if err := applyCheckoutSession(ctx, s.DB, user, session); err != nil {
+		return db.GetUserSubscriptionRow{}, err
+	}
+
+	subscription, err := s.DB.GetUserSubscription(ctx, userId)
+	if err != nil {
+		return db.GetUserSubscriptionRow{}, fmt.Errorf("failed to get user subscription: %w", err)
+	}
+	return subscription, nil
+}
+
+// subscriptionDetails describes a subscription as documented for
+// GET /users/{userId}/subscription
+func subscriptionDetails(subscription db.GetUserSubscriptionRow) map[string]interface{} {
+	var status, currentPeriodEnd interface{}
+	if subscription.Subscriptionstatus.Valid {
+		status = subscription.Subscriptionstatus.String
+	}
+	if subscription.Subscriptioncurrentperiodend.Valid {
+		currentPeriodEnd = subscription.Subscriptioncurrentperiodend.Time
+	}
+
+	return map[string]interface{}{
+		"subscriptionTier":    subscription.Subscriptiontier,
+		"subscriptionStatus":  status,
+		"currentPeriodEnd":    currentPeriodEnd,
+		"cancelAtPeriodEnd":   subscription.Cancelatperiodend,
+		"cancellationAllowed": cancellationAllowed(subscription),
+		"features":            PlanForTier(subscription.Subscriptiontier),
+	}
+}
+
+// cancellationAllowed holds while Stripe still bills a pro subscription that
+// is not canceled yet
+func cancellationAllowed(subscription db.GetUserSubscriptionRow) bool {
+	return subscription.Subscriptiontier == TierPro &&
+		subscription.Stripesubscriptionid.Valid &&
+		!subscription.Cancelatperiodend &&
+		proSubscriptionStatuses[stripe.SubscriptionStatus(subscription.Subscriptionstatus.String)]
+}
@@ -2,7 +2,6 @@ package api_server
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/gameplan-backend/api"
 )
@@ -11,16 +10,7 @@ import (
 func (s *MyApiServer) GetUsersUserIdAppsettingsInternal(ctx context.Context, request api.GetUsersUserIdAppsettingsRequestObject) (api.GetUsersUserIdAppsettingsResponseObject, error) {
 	appSettings, err := s.DB.GetUserAppSettings(ctx, int32(request.UserId))
 	if err != nil {
-		return api.GetUsersUserIdAppsettings200JSONResponse(api.ApiResult{
-			Error: &struct {
-				Code    *string `json:"code,omitempty"`
-				Message *string `json:"message,omitempty"`
-			}{
-				Code:    Ptr("DATABASE_ERROR"),
-				Message: Ptr(fmt.Sprintf("Failed to get app settings: %v", err)),
-			},
-			IsSuccess: Ptr(false),
-		}), nil
+		return nil, err
 	}
 
 	responseData := map[string]interface{}{
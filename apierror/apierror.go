@@ -0,0 +1,182 @@
+// Package apierror is the catalog of errors the API reports to clients. An
+// Error carries the HTTP status and the stable code of the ApiResult it is
+// rendered as; anything else returned by a handler is an internal error whose
+// details are logged and never sent.
+package apierror
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Codes shared by every operation
+const (
+	CodeBadRequest      = "BAD_REQUEST"
+	CodeUnauthenticated = "UNAUTHENTICATED"
+	CodeForbidden       = "FORBIDDEN"
+	CodeNotFound        = "NOT_FOUND"
+	CodeConflict        = "CONFLICT"
+	CodeValidation      = "VALIDATION_ERROR"
+	CodeQuotaExceeded   = "QUOTA_EXCEEDED"
+	CodeRateLimited     = "RATE_LIMITED"
+	CodeInternal        = "INTERNAL_ERROR"
+	CodeNotImplemented  = "NOT_IMPLEMENTED"
+)
+
+// Codes of specific domain errors, documented with the operations that return them
+const (
+	CodeInvalidPlayer        = "INVALID_PLAYER"
+	CodeSamePlayer           = "SAME_PLAYER"
+	CodeInvalidScore         = "INVALID_SCORE"
+	CodeFutureResult         = "FUTURE_RESULT"
+	CodeInvalidField         = "INVALID_FIELD"
+	CodeInvalidCustomColumn  = "INVALID_CUSTOM_COLUMN"
+	CodeInvalidCustomValue   = "INVALID_CUSTOM_VALUE"
+	CodeBatchFailed          = "BATCH_FAILED"
+	CodeInvalidSchedule      = "INVALID_SCHEDULE"
+	CodeInvalidBracket       = "INVALID_BRACKET"
+	CodeBracketExists        = "BRACKET_EXISTS"
+	CodeInvalidTiebreakers   = "INVALID_TIEBREAKERS"
+	CodeInvalidRatingOptions = "INVALID_RATING_OPTIONS"
+	CodeInvalidEmail         = "INVALID_EMAIL"
+	CodeDuplicateEmail       = "DUPLICATE_EMAIL"
+	CodeDuplicateName        = "DUPLICATE_NAME"
+	CodeConfirmationRequired = "CONFIRMATION_REQUIRED"
+	CodeWeakPassword         = "WEAK_PASSWORD"
+	CodeInvalidCredentials   = "INVALID_CREDENTIALS"
+	CodeAlreadySubscribed    = "ALREADY_SUBSCRIBED"
+	CodeInvalidSession       = "INVALID_SESSION"
+	CodeInsufficientScope    = "INSUFFICIENT_SCOPE"
+	CodeStripeError          = "STRIPE_ERROR"
+	CodeStytchError          = "STYTCH_ERROR"
+)
+
+// Error is an error meant for the client
+type Error struct {
+	Status  int
+	Code    string
+	Message string
+	// Details is sent as the data of the ApiResult
+	Details map[string]interface{}
+	// Err is the underlying cause, logged but never sent
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return e.Code + ": " + e.Message + ": " + e.Err.Error()
+	}
+	return e.Code + ": " + e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is matches another *Error with the same status and code, so that
+// errors.Is(err, apierror.ErrForbidden) holds for any forbidden error
+func (e *Error) Is(target error) bool {
+	var other *Error
+	if !errors.As(target, &other) {
+		return false
+	}
+	return e.Status == other.Status && e.Code == other.Code
+}
+
+// WithDetails returns a copy of the error with data for the client
+func (e *Error) WithDetails(details map[string]interface{}) *Error {
+	copied := *e
+	copied.Details = details
+	return &copied
+}
+
+// Generic errors, also the targets of errors.Is
+var (
+	ErrUnauthenticated = Unauthenticated("Authentication required")
+	ErrForbidden       = Forbidden("You do not have access to this resource")
+	ErrNotFound        = NotFound("Resource not found")
+)
+
+// New returns an error with any status and code
+func New(status int, code string, message string) *Error {
+	return &Error{Status: status, Code: code, Message: message}
+}
+
+// BadRequest reports a request that does not match the API
+func BadRequest(message string) *Error {
+	return New(http.StatusBadRequest, CodeBadRequest, message)
+}
+
+// Unauthenticated reports a missing or invalid session
+func Unauthenticated(message string) *Error {
+	return New(http.StatusUnauthorized, CodeUnauthenticated, message)
+}
+
+// Forbidden reports a resource that belongs to another user
+func Forbidden(message string) *Error {
+	return New(http.StatusForbidden, CodeForbidden, message)
+}
+
+// NotFound reports a resource that does not exist
+func NotFound(message string) *Error {
+	return New(http.StatusNotFound, CodeNotFound, message)
+}
+
+// Conflict reports a request that clashes with the current state, code is
+// CodeConflict or a more specific one
+func Conflict(code string, message string) *Error {
+	return New(http.StatusConflict, code, message)
+}
+
+// Validation reports a well-formed request that breaks a domain rule, code is
+// CodeValidation or a more specific one
+func Validation(code string, message string) *Error {
+	return New(http.StatusUnprocessableEntity, code, message)
+}
+
+// QuotaExceeded reports an action that goes over a limit of the user's plan
+func QuotaExceeded(message string, details map[string]interface{}) *Error {
+	return New(http.StatusPaymentRequired, CodeQuotaExceeded, message).WithDetails(details)
+}
+
+// RateLimited reports a 429 for an action repeated too often
+func RateLimited(message string) *Error {
+	return New(http.StatusTooManyRequests, CodeRateLimited, message)
+}
+
+// Upstream reports a failure of a third-party service such as Stripe
+func Upstream(code string, message string, err error) *Error {
+	return &Error{Status: http.StatusBadGateway, Code: code, Message: message, Err: err}
+}
+
+// NotImplemented reports an operation that is not available yet
+func NotImplemented(message string) *Error {
+	return New(http.StatusNotImplemented, CodeNotImplemented, message)
+}
+
+// Internal hides an unexpected error behind a generic message
+func Internal(err error) *Error {
+	return &Error{
+		Status:  http.StatusInternalServerError,
+		Code:    CodeInternal,
+		Message: "Something went wrong, please try again later",
+		Err:     err,
+	}
+}
+
+// From returns the *Error in err's chain, or err as an internal error. A
+// lookup that found no row is reported as not found.
+func From(err error) *Error {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		notFound := *ErrNotFound
+		notFound.Err = err
+		return &notFound
+	}
+	return Internal(err)
+}
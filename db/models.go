@@ -8,19 +8,52 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type ApiKey struct {
+	ID         int32
+	Userid     int32
+	Name       string
+	Prefix     string
+	Keyhash    string
+	Scopes     []string
+	Expiresat  pgtype.Timestamp
+	Lastusedat pgtype.Timestamp
+	Revokedat  pgtype.Timestamp
+	Createdat  pgtype.Timestamp
+}
+
+type BracketMatch struct {
+	ID                int32
+	Seasonid          int32
+	Matchid           int32
+	Bracket           string
+	Round             int32
+	Position          int32
+	Winnernextmatchid pgtype.Int4
+	Winnernextslot    pgtype.Int4
+	Losernextmatchid  pgtype.Int4
+	Losernextslot     pgtype.Int4
+}
+
+type MagicLinkRequest struct {
+	ID          int32
+	Email       string
+	Requestedat pgtype.Timestamp
+}
+
 type Match struct {
-	ID              int32
-	Seasonid        pgtype.Int4
-	Playerid1       pgtype.Int4
-	Playerid1points int32
-	Playerid2       pgtype.Int4
-	Playerid2points int32
-	Matchdate       pgtype.Date
-	Winnerid        pgtype.Int4
-	Createdat       pgtype.Timestamp
-	Updatedat       pgtype.Timestamp
-	Isactive        bool
-	Group           int32
+	ID               int32
+	Seasonid         pgtype.Int4
+	Playerid1        pgtype.Int4
+	Playerid1points  int32
+	Playerid2        pgtype.Int4
+	Playerid2points  int32
+	Matchdate        pgtype.Date
+	Winnerid         pgtype.Int4
+	Createdat        pgtype.Timestamp
+	Updatedat        pgtype.Timestamp
+	Isactive         bool
+	Group            int32
+	Calendarsequence int32
 }
 
 type MatchCustomColumn struct {
@@ -33,6 +66,8 @@ type MatchCustomColumn struct {
 	DisplayOrder pgtype.Int4
 	Createdat    pgtype.Timestamp
 	Updatedat    pgtype.Timestamp
+	Userid       int32
+	Options      []string
 }
 
 type MatchCustomValue struct {
@@ -56,6 +91,22 @@ type Player struct {
 	Emailnotificationsenabled bool
 }
 
+type PlayerCalendarCancellation struct {
+	Playerid         int32
+	Matchid          int32
+	Seasonid         pgtype.Int4
+	Matchdate        pgtype.Date
+	Group            int32
+	Calendarsequence int32
+	Cancelledat      pgtype.Timestamp
+}
+
+type PlayerCalendarFeed struct {
+	Playerid  int32
+	Tokenhash string
+	Createdat pgtype.Timestamp
+}
+
 type PlayerCustomColumn struct {
 	ID           int32
 	Name         string
@@ -66,6 +117,8 @@ type PlayerCustomColumn struct {
 	DisplayOrder pgtype.Int4
 	Createdat    pgtype.Timestamp
 	Updatedat    pgtype.Timestamp
+	Userid       int32
+	Options      []string
 }
 
 type PlayerCustomValue struct {
@@ -78,31 +131,75 @@ type PlayerCustomValue struct {
 }
 
 type Season struct {
-	ID         int32
-	Userid     pgtype.Int4
-	Name       string
-	Startdate  pgtype.Date
-	Createdat  pgtype.Timestamp
-	Updatedat  pgtype.Timestamp
-	Isactive   bool
-	Seasontype string
-	Frequency  string
+	ID            int32
+	Userid        pgtype.Int4
+	Name          string
+	Startdate     pgtype.Date
+	Createdat     pgtype.Timestamp
+	Updatedat     pgtype.Timestamp
+	Isactive      bool
+	Seasontype    string
+	Frequency     string
+	Format        string
+	Pointsperwin  int32
+	Pointsperdraw int32
+	Pointsperloss int32
+	Tiebreakers   []string
+}
+
+type SeasonMember struct {
+	ID        int32
+	Seasonid  int32
+	Email     string
+	Role      string
+	Invitedby pgtype.Int4
+	Createdat pgtype.Timestamp
+	Updatedat pgtype.Timestamp
+}
+
+type SeasonShareLink struct {
+	ID        int32
+	Seasonid  int32
+	Tokenhash string
+	Expiresat pgtype.Timestamp
+	Revokedat pgtype.Timestamp
+	Createdat pgtype.Timestamp
+}
+
+type StripeEvent struct {
+	ID        string
+	Type      string
+	Createdat pgtype.Timestamp
 }
 
 type User struct {
-	ID               int32
-	Stytchid         string
-	Stripeid         string
-	Name             string
-	Email            string
-	Phone            pgtype.Text
-	Country          pgtype.Text
-	Birthday         pgtype.Int4
-	Lang             string
-	Createdat        pgtype.Timestamp
-	Updatedat        pgtype.Timestamp
-	Isactive         bool
-	Isverified       bool
-	Subscriptiontier string
-	Jsonsettings     pgtype.Text
+	ID                           int32
+	Stytchid                     string
+	Stripeid                     string
+	Name                         string
+	Email                        string
+	Phone                        pgtype.Text
+	Country                      pgtype.Text
+	Birthday                     pgtype.Int4
+	Lang                         string
+	Createdat                    pgtype.Timestamp
+	Updatedat                    pgtype.Timestamp
+	Isactive                     bool
+	Isverified                   bool
+	Subscriptiontier             string
+	Jsonsettings                 pgtype.Text
+	Stripesubscriptionid         pgtype.Text
+	Subscriptionstatus           pgtype.Text
+	Subscriptioncurrentperiodend pgtype.Timestamp
+	Passwordchangedat            pgtype.Timestamp
+	Cancelatperiodend            bool
+}
+
+type UserSession struct {
+	Sessionid  string
+	Userid     int32
+	Ipaddress  pgtype.Text
+	Useragent  pgtype.Text
+	Createdat  pgtype.Timestamp
+	Lastseenat pgtype.Timestamp
 }
@@ -11,13 +11,228 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+const assignMatchPlayer1 = `-- name: AssignMatchPlayer1 :exec
+UPDATE matches
+SET playerId1 = $1,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $2
+`
+
+type AssignMatchPlayer1Params struct {
+	Playerid1 pgtype.Int4
+	ID        int32
+}
+
+func (q *Queries) AssignMatchPlayer1(ctx context.Context, arg AssignMatchPlayer1Params) error {
+	_, err := q.db.Exec(ctx, assignMatchPlayer1, arg.Playerid1, arg.ID)
+	return err
+}
+
+const assignMatchPlayer2 = `-- name: AssignMatchPlayer2 :exec
+UPDATE matches
+SET playerId2 = $1,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $2
+`
+
+type AssignMatchPlayer2Params struct {
+	Playerid2 pgtype.Int4
+	ID        int32
+}
+
+func (q *Queries) AssignMatchPlayer2(ctx context.Context, arg AssignMatchPlayer2Params) error {
+	_, err := q.db.Exec(ctx, assignMatchPlayer2, arg.Playerid2, arg.ID)
+	return err
+}
+
+const countActiveApiKeys = `-- name: CountActiveApiKeys :one
+SELECT COUNT(*) FROM api_keys
+WHERE userId = $1
+  AND revokedAt IS NULL
+  AND (expiresAt IS NULL OR expiresAt > CURRENT_TIMESTAMP)
+`
+
+func (q *Queries) CountActiveApiKeys(ctx context.Context, userid int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveApiKeys, userid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countActivePlayers = `-- name: CountActivePlayers :one
+SELECT COUNT(*) FROM players
+WHERE userId = $1 AND isActive = true
+`
+
+func (q *Queries) CountActivePlayers(ctx context.Context, userid pgtype.Int4) (int64, error) {
+	row := q.db.QueryRow(ctx, countActivePlayers, userid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countActiveSeasonShareLinks = `-- name: CountActiveSeasonShareLinks :one
+SELECT COUNT(*) FROM season_share_links l
+JOIN seasons s ON s.id = l.seasonId
+WHERE s.userId = $1
+  AND l.revokedAt IS NULL
+  AND (l.expiresAt IS NULL OR l.expiresAt > CURRENT_TIMESTAMP)
+`
+
+func (q *Queries) CountActiveSeasonShareLinks(ctx context.Context, userid pgtype.Int4) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveSeasonShareLinks, userid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countActiveSeasons = `-- name: CountActiveSeasons :one
+SELECT COUNT(*) FROM seasons
+WHERE userId = $1 AND isActive = true
+`
+
+func (q *Queries) CountActiveSeasons(ctx context.Context, userid pgtype.Int4) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveSeasons, userid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countPlayerCustomValuesByColumn = `-- name: CountPlayerCustomValuesByColumn :one
+SELECT COUNT(*) FROM player_custom_values
+WHERE column_id = $1 AND value IS NOT NULL
+`
+
+func (q *Queries) CountPlayerCustomValuesByColumn(ctx context.Context, columnID pgtype.Int4) (int64, error) {
+	row := q.db.QueryRow(ctx, countPlayerCustomValuesByColumn, columnID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRecentMagicLinkRequests = `-- name: CountRecentMagicLinkRequests :one
+SELECT COUNT(*) FROM magic_link_requests
+WHERE email = $1
+  AND requestedAt > CURRENT_TIMESTAMP - interval '1 hour'
+`
+
+func (q *Queries) CountRecentMagicLinkRequests(ctx context.Context, email string) (int64, error) {
+	row := q.db.QueryRow(ctx, countRecentMagicLinkRequests, email)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countUserCustomColumns = `-- name: CountUserCustomColumns :one
+SELECT ((SELECT COUNT(*) FROM player_custom_columns p WHERE p.userId = $1)
+    + (SELECT COUNT(*) FROM match_custom_columns m WHERE m.userId = $1))::bigint AS count
+`
+
+func (q *Queries) CountUserCustomColumns(ctx context.Context, userid int32) (int64, error) {
+	row := q.db.QueryRow(ctx, countUserCustomColumns, userid)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createApiKey = `-- name: CreateApiKey :one
+INSERT INTO api_keys (userId, name, prefix, keyHash, scopes, expiresAt)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, userid, name, prefix, keyhash, scopes, expiresat, lastusedat, revokedat, createdat
+`
+
+type CreateApiKeyParams struct {
+	Userid    int32
+	Name      string
+	Prefix    string
+	Keyhash   string
+	Scopes    []string
+	Expiresat pgtype.Timestamp
+}
+
+func (q *Queries) CreateApiKey(ctx context.Context, arg CreateApiKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, createApiKey,
+		arg.Userid,
+		arg.Name,
+		arg.Prefix,
+		arg.Keyhash,
+		arg.Scopes,
+		arg.Expiresat,
+	)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.Userid,
+		&i.Name,
+		&i.Prefix,
+		&i.Keyhash,
+		&i.Scopes,
+		&i.Expiresat,
+		&i.Lastusedat,
+		&i.Revokedat,
+		&i.Createdat,
+	)
+	return i, err
+}
+
+const createBracketMatch = `-- name: CreateBracketMatch :one
+INSERT INTO bracket_matches (
+    seasonId, matchId, bracket, round, position
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING id, seasonid, matchid, bracket, round, position, winnernextmatchid, winnernextslot, losernextmatchid, losernextslot
+`
+
+type CreateBracketMatchParams struct {
+	Seasonid int32
+	Matchid  int32
+	Bracket  string
+	Round    int32
+	Position int32
+}
+
+func (q *Queries) CreateBracketMatch(ctx context.Context, arg CreateBracketMatchParams) (BracketMatch, error) {
+	row := q.db.QueryRow(ctx, createBracketMatch,
+		arg.Seasonid,
+		arg.Matchid,
+		arg.Bracket,
+		arg.Round,
+		arg.Position,
+	)
+	var i BracketMatch
+	err := row.Scan(
+		&i.ID,
+		&i.Seasonid,
+		&i.Matchid,
+		&i.Bracket,
+		&i.Round,
+		&i.Position,
+		&i.Winnernextmatchid,
+		&i.Winnernextslot,
+		&i.Losernextmatchid,
+		&i.Losernextslot,
+	)
+	return i, err
+}
+
+const createMagicLinkRequest = `-- name: CreateMagicLinkRequest :exec
+INSERT INTO magic_link_requests (email)
+VALUES ($1)
+`
+
+func (q *Queries) CreateMagicLinkRequest(ctx context.Context, email string) error {
+	_, err := q.db.Exec(ctx, createMagicLinkRequest, email)
+	return err
+}
+
 const createMatch = `-- name: CreateMatch :one
 INSERT INTO matches (
     seasonId, playerId1, playerId1Points, playerId2, playerId2Points, matchDate, winnerId, "group"
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8
 )
-RETURNING id, seasonid, playerid1, playerid1points, playerid2, playerid2points, matchdate, winnerid, createdat, updatedat, isactive, "group"
+RETURNING id, seasonid, playerid1, playerid1points, playerid2, playerid2points, matchdate, winnerid, createdat, updatedat, isactive, "group", calendarsequence
 `
 
 type CreateMatchParams struct {
@@ -56,6 +271,53 @@ func (q *Queries) CreateMatch(ctx context.Context, arg CreateMatchParams) (Match
 		&i.Updatedat,
 		&i.Isactive,
 		&i.Group,
+		&i.Calendarsequence,
+	)
+	return i, err
+}
+
+const createMatchCustomColumn = `-- name: CreateMatchCustomColumn :one
+INSERT INTO match_custom_columns (
+    userId, name, field_type, options, description, is_required, display_order
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat, userid, options
+`
+
+type CreateMatchCustomColumnParams struct {
+	Userid       int32
+	Name         string
+	FieldType    string
+	Options      []string
+	Description  pgtype.Text
+	IsRequired   pgtype.Bool
+	DisplayOrder pgtype.Int4
+}
+
+func (q *Queries) CreateMatchCustomColumn(ctx context.Context, arg CreateMatchCustomColumnParams) (MatchCustomColumn, error) {
+	row := q.db.QueryRow(ctx, createMatchCustomColumn,
+		arg.Userid,
+		arg.Name,
+		arg.FieldType,
+		arg.Options,
+		arg.Description,
+		arg.IsRequired,
+		arg.DisplayOrder,
+	)
+	var i MatchCustomColumn
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.FieldType,
+		&i.Description,
+		&i.IsRequired,
+		&i.IsActive,
+		&i.DisplayOrder,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Userid,
+		&i.Options,
 	)
 	return i, err
 }
@@ -102,16 +364,18 @@ func (q *Queries) CreatePlayer(ctx context.Context, arg CreatePlayerParams) (Pla
 
 const createPlayerCustomColumn = `-- name: CreatePlayerCustomColumn :one
 INSERT INTO player_custom_columns (
-    name, field_type, description, is_required, display_order
+    userId, name, field_type, options, description, is_required, display_order
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7
 )
-RETURNING id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat
+RETURNING id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat, userid, options
 `
 
 type CreatePlayerCustomColumnParams struct {
+	Userid       int32
 	Name         string
 	FieldType    string
+	Options      []string
 	Description  pgtype.Text
 	IsRequired   pgtype.Bool
 	DisplayOrder pgtype.Int4
@@ -119,8 +383,10 @@ type CreatePlayerCustomColumnParams struct {
 
 func (q *Queries) CreatePlayerCustomColumn(ctx context.Context, arg CreatePlayerCustomColumnParams) (PlayerCustomColumn, error) {
 	row := q.db.QueryRow(ctx, createPlayerCustomColumn,
+		arg.Userid,
 		arg.Name,
 		arg.FieldType,
+		arg.Options,
 		arg.Description,
 		arg.IsRequired,
 		arg.DisplayOrder,
@@ -136,6 +402,8 @@ func (q *Queries) CreatePlayerCustomColumn(ctx context.Context, arg CreatePlayer
 		&i.DisplayOrder,
 		&i.Createdat,
 		&i.Updatedat,
+		&i.Userid,
+		&i.Options,
 	)
 	return i, err
 }
@@ -146,7 +414,7 @@ INSERT INTO seasons (
 ) VALUES (
     $1, $2, $3, $4, $5
 )
-RETURNING id, userid, name, startdate, createdat, updatedat, isactive, seasontype, frequency
+RETURNING id, userid, name, startdate, createdat, updatedat, isactive, seasontype, frequency, format, pointsperwin, pointsperdraw, pointsperloss, tiebreakers
 `
 
 type CreateSeasonParams struct {
@@ -176,17 +444,100 @@ func (q *Queries) CreateSeason(ctx context.Context, arg CreateSeasonParams) (Sea
 		&i.Isactive,
 		&i.Seasontype,
 		&i.Frequency,
+		&i.Format,
+		&i.Pointsperwin,
+		&i.Pointsperdraw,
+		&i.Pointsperloss,
+		&i.Tiebreakers,
+	)
+	return i, err
+}
+
+const createSeasonMember = `-- name: CreateSeasonMember :one
+INSERT INTO season_members (seasonId, email, role, invitedBy)
+VALUES ($1, $2, $3, $4)
+RETURNING id, seasonid, email, role, invitedby, createdat, updatedat
+`
+
+type CreateSeasonMemberParams struct {
+	Seasonid  int32
+	Email     string
+	Role      string
+	Invitedby pgtype.Int4
+}
+
+func (q *Queries) CreateSeasonMember(ctx context.Context, arg CreateSeasonMemberParams) (SeasonMember, error) {
+	row := q.db.QueryRow(ctx, createSeasonMember,
+		arg.Seasonid,
+		arg.Email,
+		arg.Role,
+		arg.Invitedby,
+	)
+	var i SeasonMember
+	err := row.Scan(
+		&i.ID,
+		&i.Seasonid,
+		&i.Email,
+		&i.Role,
+		&i.Invitedby,
+		&i.Createdat,
+		&i.Updatedat,
+	)
+	return i, err
+}
+
+const createSeasonShareLink = `-- name: CreateSeasonShareLink :one
+INSERT INTO season_share_links (seasonId, tokenHash, expiresAt)
+VALUES ($1, $2, $3)
+RETURNING id, seasonid, tokenhash, expiresat, revokedat, createdat
+`
+
+type CreateSeasonShareLinkParams struct {
+	Seasonid  int32
+	Tokenhash string
+	Expiresat pgtype.Timestamp
+}
+
+func (q *Queries) CreateSeasonShareLink(ctx context.Context, arg CreateSeasonShareLinkParams) (SeasonShareLink, error) {
+	row := q.db.QueryRow(ctx, createSeasonShareLink, arg.Seasonid, arg.Tokenhash, arg.Expiresat)
+	var i SeasonShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.Seasonid,
+		&i.Tokenhash,
+		&i.Expiresat,
+		&i.Revokedat,
+		&i.Createdat,
 	)
 	return i, err
 }
 
+const createStripeEvent = `-- name: CreateStripeEvent :one
+INSERT INTO stripe_events (id, type)
+VALUES ($1, $2)
+ON CONFLICT (id) DO NOTHING
+RETURNING id
+`
+
+type CreateStripeEventParams struct {
+	ID   string
+	Type string
+}
+
+func (q *Queries) CreateStripeEvent(ctx context.Context, arg CreateStripeEventParams) (string, error) {
+	row := q.db.QueryRow(ctx, createStripeEvent, arg.ID, arg.Type)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (
     stytchId, stripeId, name, email, phone, country, birthday, lang, isVerified
 ) VALUES (
     $1, $2, $3, $4, $5, $6, $7, $8, $9
 )
-RETURNING id, stytchid, stripeid, name, email, phone, country, birthday, lang, createdat, updatedat, isactive, isverified, subscriptiontier, jsonsettings
+RETURNING id, stytchid, stripeid, name, email, phone, country, birthday, lang, createdat, updatedat, isactive, isverified, subscriptiontier, jsonsettings, stripesubscriptionid, subscriptionstatus, subscriptioncurrentperiodend, passwordchangedat, cancelatperiodend
 `
 
 type CreateUserParams struct {
@@ -230,17 +581,63 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Isverified,
 		&i.Subscriptiontier,
 		&i.Jsonsettings,
+		&i.Stripesubscriptionid,
+		&i.Subscriptionstatus,
+		&i.Subscriptioncurrentperiodend,
+		&i.Passwordchangedat,
+		&i.Cancelatperiodend,
 	)
 	return i, err
 }
 
+const deleteExpiredMagicLinkRequests = `-- name: DeleteExpiredMagicLinkRequests :exec
+DELETE FROM magic_link_requests
+WHERE requestedAt <= CURRENT_TIMESTAMP - interval '1 hour'
+`
+
+func (q *Queries) DeleteExpiredMagicLinkRequests(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, deleteExpiredMagicLinkRequests)
+	return err
+}
+
 const deleteMatch = `-- name: DeleteMatch :exec
 DELETE FROM matches
 WHERE id = $1
+  AND seasonId IN (SELECT a.seasonId FROM season_access a WHERE a.userId = $2 AND a.role IN ('owner', 'admin'))
+`
+
+type DeleteMatchParams struct {
+	ID     int32
+	Userid pgtype.Int4
+}
+
+func (q *Queries) DeleteMatch(ctx context.Context, arg DeleteMatchParams) error {
+	_, err := q.db.Exec(ctx, deleteMatch, arg.ID, arg.Userid)
+	return err
+}
+
+const deleteMatchCustomColumn = `-- name: DeleteMatchCustomColumn :exec
+DELETE FROM match_custom_columns
+WHERE id = $1 AND userId = $2
+`
+
+type DeleteMatchCustomColumnParams struct {
+	ID     int32
+	Userid int32
+}
+
+func (q *Queries) DeleteMatchCustomColumn(ctx context.Context, arg DeleteMatchCustomColumnParams) error {
+	_, err := q.db.Exec(ctx, deleteMatchCustomColumn, arg.ID, arg.Userid)
+	return err
+}
+
+const deleteMatchCustomValuesByColumn = `-- name: DeleteMatchCustomValuesByColumn :exec
+DELETE FROM match_custom_values
+WHERE column_id = $1
 `
 
-func (q *Queries) DeleteMatch(ctx context.Context, id int32) error {
-	_, err := q.db.Exec(ctx, deleteMatch, id)
+func (q *Queries) DeleteMatchCustomValuesByColumn(ctx context.Context, columnID pgtype.Int4) error {
+	_, err := q.db.Exec(ctx, deleteMatchCustomValuesByColumn, columnID)
 	return err
 }
 
@@ -259,13 +656,38 @@ func (q *Queries) DeletePlayer(ctx context.Context, arg DeletePlayerParams) erro
 	return err
 }
 
+const deletePlayerCalendarFeed = `-- name: DeletePlayerCalendarFeed :exec
+DELETE FROM player_calendar_feeds
+WHERE playerId = $1
+`
+
+func (q *Queries) DeletePlayerCalendarFeed(ctx context.Context, playerid int32) error {
+	_, err := q.db.Exec(ctx, deletePlayerCalendarFeed, playerid)
+	return err
+}
+
 const deletePlayerCustomColumn = `-- name: DeletePlayerCustomColumn :exec
 DELETE FROM player_custom_columns
-WHERE id = $1
+WHERE id = $1 AND userId = $2
+`
+
+type DeletePlayerCustomColumnParams struct {
+	ID     int32
+	Userid int32
+}
+
+func (q *Queries) DeletePlayerCustomColumn(ctx context.Context, arg DeletePlayerCustomColumnParams) error {
+	_, err := q.db.Exec(ctx, deletePlayerCustomColumn, arg.ID, arg.Userid)
+	return err
+}
+
+const deletePlayerCustomValuesByColumn = `-- name: DeletePlayerCustomValuesByColumn :exec
+DELETE FROM player_custom_values
+WHERE column_id = $1
 `
 
-func (q *Queries) DeletePlayerCustomColumn(ctx context.Context, id int32) error {
-	_, err := q.db.Exec(ctx, deletePlayerCustomColumn, id)
+func (q *Queries) DeletePlayerCustomValuesByColumn(ctx context.Context, columnID pgtype.Int4) error {
+	_, err := q.db.Exec(ctx, deletePlayerCustomValuesByColumn, columnID)
 	return err
 }
 
@@ -284,6 +706,63 @@ func (q *Queries) DeleteSeason(ctx context.Context, arg DeleteSeasonParams) erro
 	return err
 }
 
+const deleteSeasonBracket = `-- name: DeleteSeasonBracket :exec
+DELETE FROM matches
+WHERE id IN (SELECT bm.matchId FROM bracket_matches bm WHERE bm.seasonId = $1)
+  AND winnerId IS NULL
+  AND playerId1Points = 0
+  AND playerId2Points = 0
+`
+
+func (q *Queries) DeleteSeasonBracket(ctx context.Context, seasonid int32) error {
+	_, err := q.db.Exec(ctx, deleteSeasonBracket, seasonid)
+	return err
+}
+
+const deleteSeasonMember = `-- name: DeleteSeasonMember :exec
+DELETE FROM season_members
+WHERE id = $1 AND seasonId = $2
+`
+
+type DeleteSeasonMemberParams struct {
+	ID       int32
+	Seasonid int32
+}
+
+func (q *Queries) DeleteSeasonMember(ctx context.Context, arg DeleteSeasonMemberParams) error {
+	_, err := q.db.Exec(ctx, deleteSeasonMember, arg.ID, arg.Seasonid)
+	return err
+}
+
+const deleteStaleUserSessions = `-- name: DeleteStaleUserSessions :exec
+DELETE FROM user_sessions
+WHERE userId = $1
+  AND NOT (sessionId = ANY($2::varchar[]))
+`
+
+type DeleteStaleUserSessionsParams struct {
+	Userid           int32
+	ActiveSessionIds []string
+}
+
+func (q *Queries) DeleteStaleUserSessions(ctx context.Context, arg DeleteStaleUserSessionsParams) error {
+	_, err := q.db.Exec(ctx, deleteStaleUserSessions, arg.Userid, arg.ActiveSessionIds)
+	return err
+}
+
+const deleteUnplayedSeasonMatches = `-- name: DeleteUnplayedSeasonMatches :exec
+DELETE FROM matches
+WHERE seasonId = $1
+  AND winnerId IS NULL
+  AND playerId1Points = 0
+  AND playerId2Points = 0
+`
+
+func (q *Queries) DeleteUnplayedSeasonMatches(ctx context.Context, seasonid pgtype.Int4) error {
+	_, err := q.db.Exec(ctx, deleteUnplayedSeasonMatches, seasonid)
+	return err
+}
+
 const deleteUser = `-- name: DeleteUser :exec
 UPDATE users SET isActive = false, updatedAt = CURRENT_TIMESTAMP WHERE id = $1
 `
@@ -293,6 +772,21 @@ func (q *Queries) DeleteUser(ctx context.Context, id int32) error {
 	return err
 }
 
+const deleteUserSession = `-- name: DeleteUserSession :exec
+DELETE FROM user_sessions
+WHERE sessionId = $1 AND userId = $2
+`
+
+type DeleteUserSessionParams struct {
+	Sessionid string
+	Userid    int32
+}
+
+func (q *Queries) DeleteUserSession(ctx context.Context, arg DeleteUserSessionParams) error {
+	_, err := q.db.Exec(ctx, deleteUserSession, arg.Sessionid, arg.Userid)
+	return err
+}
+
 const deleteUserSubscription = `-- name: DeleteUserSubscription :exec
 UPDATE users
 SET subscriptionTier = 'free',
@@ -306,83 +800,1058 @@ func (q *Queries) DeleteUserSubscription(ctx context.Context, id int32) error {
 	return err
 }
 
-const getMatch = `-- name: GetMatch :one
-SELECT id, seasonid, playerid1, playerid1points, playerid2, playerid2points, matchdate, winnerid, createdat, updatedat, isactive, "group" FROM matches
-WHERE id = $1
+const getAllPlayerCustomColumns = `-- name: GetAllPlayerCustomColumns :many
+SELECT id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat, userid, options FROM player_custom_columns
+WHERE userId = $1
+ORDER BY display_order, id
 `
 
-func (q *Queries) GetMatch(ctx context.Context, id int32) (Match, error) {
-	row := q.db.QueryRow(ctx, getMatch, id)
-	var i Match
-	err := row.Scan(
-		&i.ID,
-		&i.Seasonid,
-		&i.Playerid1,
-		&i.Playerid1points,
-		&i.Playerid2,
-		&i.Playerid2points,
-		&i.Matchdate,
-		&i.Winnerid,
-		&i.Createdat,
-		&i.Updatedat,
-		&i.Isactive,
-		&i.Group,
-	)
-	return i, err
-}
-
-const getPlayer = `-- name: GetPlayer :one
-SELECT id, userid, name, email, createdat, updatedat, preferredmatchgroup, isactive, emailnotificationsenabled FROM players
-WHERE id = $1 AND userId = $2
-`
+func (q *Queries) GetAllPlayerCustomColumns(ctx context.Context, userid int32) ([]PlayerCustomColumn, error) {
+	rows, err := q.db.Query(ctx, getAllPlayerCustomColumns, userid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerCustomColumn
+	for rows.Next() {
+		var i PlayerCustomColumn
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.FieldType,
+			&i.Description,
+			&i.IsRequired,
+			&i.IsActive,
+			&i.DisplayOrder,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.Userid,
+			&i.Options,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getApiKeyByHash = `-- name: GetApiKeyByHash :one
+SELECT k.id, k.userId, k.scopes, u.stytchId FROM api_keys k
+JOIN users u ON u.id = k.userId
+WHERE k.keyHash = $1
+  AND k.revokedAt IS NULL
+  AND (k.expiresAt IS NULL OR k.expiresAt > CURRENT_TIMESTAMP)
+`
+
+type GetApiKeyByHashRow struct {
+	ID       int32
+	Userid   int32
+	Scopes   []string
+	Stytchid string
+}
+
+func (q *Queries) GetApiKeyByHash(ctx context.Context, keyhash string) (GetApiKeyByHashRow, error) {
+	row := q.db.QueryRow(ctx, getApiKeyByHash, keyhash)
+	var i GetApiKeyByHashRow
+	err := row.Scan(
+		&i.ID,
+		&i.Userid,
+		&i.Scopes,
+		&i.Stytchid,
+	)
+	return i, err
+}
+
+const getApiKeys = `-- name: GetApiKeys :many
+SELECT id, userid, name, prefix, keyhash, scopes, expiresat, lastusedat, revokedat, createdat FROM api_keys
+WHERE userId = $1 AND revokedAt IS NULL
+ORDER BY createdAt, id
+`
+
+func (q *Queries) GetApiKeys(ctx context.Context, userid int32) ([]ApiKey, error) {
+	rows, err := q.db.Query(ctx, getApiKeys, userid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ApiKey
+	for rows.Next() {
+		var i ApiKey
+		if err := rows.Scan(
+			&i.ID,
+			&i.Userid,
+			&i.Name,
+			&i.Prefix,
+			&i.Keyhash,
+			&i.Scopes,
+			&i.Expiresat,
+			&i.Lastusedat,
+			&i.Revokedat,
+			&i.Createdat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getBracketMatchByMatchId = `-- name: GetBracketMatchByMatchId :one
+SELECT id, seasonid, matchid, bracket, round, position, winnernextmatchid, winnernextslot, losernextmatchid, losernextslot FROM bracket_matches
+WHERE matchId = $1
+`
+
+func (q *Queries) GetBracketMatchByMatchId(ctx context.Context, matchid int32) (BracketMatch, error) {
+	row := q.db.QueryRow(ctx, getBracketMatchByMatchId, matchid)
+	var i BracketMatch
+	err := row.Scan(
+		&i.ID,
+		&i.Seasonid,
+		&i.Matchid,
+		&i.Bracket,
+		&i.Round,
+		&i.Position,
+		&i.Winnernextmatchid,
+		&i.Winnernextslot,
+		&i.Losernextmatchid,
+		&i.Losernextslot,
+	)
+	return i, err
+}
+
+const getMatch = `-- name: GetMatch :one
+SELECT id, seasonid, playerid1, playerid1points, playerid2, playerid2points, matchdate, winnerid, createdat, updatedat, isactive, "group", calendarsequence FROM matches
+WHERE id = $1
+  AND seasonId IN (SELECT a.seasonId FROM season_access a WHERE a.userId = $2)
+`
+
+type GetMatchParams struct {
+	ID     int32
+	Userid pgtype.Int4
+}
+
+func (q *Queries) GetMatch(ctx context.Context, arg GetMatchParams) (Match, error) {
+	row := q.db.QueryRow(ctx, getMatch, arg.ID, arg.Userid)
+	var i Match
+	err := row.Scan(
+		&i.ID,
+		&i.Seasonid,
+		&i.Playerid1,
+		&i.Playerid1points,
+		&i.Playerid2,
+		&i.Playerid2points,
+		&i.Matchdate,
+		&i.Winnerid,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Isactive,
+		&i.Group,
+		&i.Calendarsequence,
+	)
+	return i, err
+}
+
+const getMatchCustomColumn = `-- name: GetMatchCustomColumn :one
+SELECT id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat, userid, options FROM match_custom_columns
+WHERE id = $1 AND userId = $2
+`
+
+type GetMatchCustomColumnParams struct {
+	ID     int32
+	Userid int32
+}
+
+func (q *Queries) GetMatchCustomColumn(ctx context.Context, arg GetMatchCustomColumnParams) (MatchCustomColumn, error) {
+	row := q.db.QueryRow(ctx, getMatchCustomColumn, arg.ID, arg.Userid)
+	var i MatchCustomColumn
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.FieldType,
+		&i.Description,
+		&i.IsRequired,
+		&i.IsActive,
+		&i.DisplayOrder,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Userid,
+		&i.Options,
+	)
+	return i, err
+}
+
+const getMatchCustomColumns = `-- name: GetMatchCustomColumns :many
+SELECT id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat, userid, options FROM match_custom_columns
+WHERE userId = $1
+ORDER BY display_order, id
+`
+
+func (q *Queries) GetMatchCustomColumns(ctx context.Context, userid int32) ([]MatchCustomColumn, error) {
+	rows, err := q.db.Query(ctx, getMatchCustomColumns, userid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []MatchCustomColumn
+	for rows.Next() {
+		var i MatchCustomColumn
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.FieldType,
+			&i.Description,
+			&i.IsRequired,
+			&i.IsActive,
+			&i.DisplayOrder,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.Userid,
+			&i.Options,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMatchCustomValues = `-- name: GetMatchCustomValues :many
+SELECT mcv.id, mcv.match_id, mcv.column_id, mcv.value, mcv.createdat, mcv.updatedat, mcc.name as column_name, mcc.field_type
+FROM match_custom_values mcv
+JOIN match_custom_columns mcc ON mcv.column_id = mcc.id
+WHERE mcv.match_id = $1 AND mcc.is_active = true
+ORDER BY mcc.display_order, mcc.id
+`
+
+type GetMatchCustomValuesRow struct {
+	ID         int32
+	MatchID    pgtype.Int4
+	ColumnID   pgtype.Int4
+	Value      pgtype.Text
+	Createdat  pgtype.Timestamp
+	Updatedat  pgtype.Timestamp
+	ColumnName string
+	FieldType  string
+}
+
+func (q *Queries) GetMatchCustomValues(ctx context.Context, matchID pgtype.Int4) ([]GetMatchCustomValuesRow, error) {
+	rows, err := q.db.Query(ctx, getMatchCustomValues, matchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetMatchCustomValuesRow
+	for rows.Next() {
+		var i GetMatchCustomValuesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MatchID,
+			&i.ColumnID,
+			&i.Value,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.ColumnName,
+			&i.FieldType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getMatchOwner = `-- name: GetMatchOwner :one
+SELECT s.userId
+FROM matches m
+JOIN seasons s ON s.id = m.seasonId
+WHERE m.id = $1
+`
+
+func (q *Queries) GetMatchOwner(ctx context.Context, id int32) (pgtype.Int4, error) {
+	row := q.db.QueryRow(ctx, getMatchOwner, id)
+	var userid pgtype.Int4
+	err := row.Scan(&userid)
+	return userid, err
+}
+
+const getMatchRole = `-- name: GetMatchRole :one
+SELECT a.role FROM matches m
+LEFT JOIN season_access a ON a.seasonId = m.seasonId AND a.userId = $2
+WHERE m.id = $1
+ORDER BY CASE a.role WHEN 'owner' THEN 4 WHEN 'admin' THEN 3 WHEN 'scorekeeper' THEN 2 WHEN 'viewer' THEN 1 END DESC NULLS LAST
+LIMIT 1
+`
+
+type GetMatchRoleParams struct {
+	ID     int32
+	Userid pgtype.Int4
+}
+
+func (q *Queries) GetMatchRole(ctx context.Context, arg GetMatchRoleParams) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getMatchRole, arg.ID, arg.Userid)
+	var role pgtype.Text
+	err := row.Scan(&role)
+	return role, err
+}
+
+const getPlayer = `-- name: GetPlayer :one
+SELECT id, userid, name, email, createdat, updatedat, preferredmatchgroup, isactive, emailnotificationsenabled FROM players
+WHERE id = $1 AND userId = $2
+`
+
+type GetPlayerParams struct {
+	ID     int32
+	Userid pgtype.Int4
+}
+
+func (q *Queries) GetPlayer(ctx context.Context, arg GetPlayerParams) (Player, error) {
+	row := q.db.QueryRow(ctx, getPlayer, arg.ID, arg.Userid)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.Userid,
+		&i.Name,
+		&i.Email,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Preferredmatchgroup,
+		&i.Isactive,
+		&i.Emailnotificationsenabled,
+	)
+	return i, err
+}
+
+const getPlayerByCalendarTokenHash = `-- name: GetPlayerByCalendarTokenHash :one
+SELECT p.id, p.userid, p.name, p.email, p.createdat, p.updatedat, p.preferredmatchgroup, p.isactive, p.emailnotificationsenabled FROM players p
+JOIN player_calendar_feeds f ON f.playerId = p.id
+WHERE f.tokenHash = $1
+`
+
+func (q *Queries) GetPlayerByCalendarTokenHash(ctx context.Context, tokenhash string) (Player, error) {
+	row := q.db.QueryRow(ctx, getPlayerByCalendarTokenHash, tokenhash)
+	var i Player
+	err := row.Scan(
+		&i.ID,
+		&i.Userid,
+		&i.Name,
+		&i.Email,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Preferredmatchgroup,
+		&i.Isactive,
+		&i.Emailnotificationsenabled,
+	)
+	return i, err
+}
+
+const getPlayerCalendarCancellations = `-- name: GetPlayerCalendarCancellations :many
+SELECT c.playerid, c.matchid, c.seasonid, c.matchdate, c."group", c.calendarsequence, c.cancelledat, s.name AS season_name
+FROM player_calendar_cancellations c
+LEFT JOIN seasons s ON s.id = c.seasonId
+WHERE c.playerId = $1 AND c.cancelledAt > CURRENT_TIMESTAMP - INTERVAL '90 days'
+ORDER BY c.matchDate, c.matchId
+`
+
+type GetPlayerCalendarCancellationsRow struct {
+	Playerid         int32
+	Matchid          int32
+	Seasonid         pgtype.Int4
+	Matchdate        pgtype.Date
+	Group            int32
+	Calendarsequence int32
+	Cancelledat      pgtype.Timestamp
+	SeasonName       pgtype.Text
+}
+
+func (q *Queries) GetPlayerCalendarCancellations(ctx context.Context, playerid int32) ([]GetPlayerCalendarCancellationsRow, error) {
+	rows, err := q.db.Query(ctx, getPlayerCalendarCancellations, playerid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPlayerCalendarCancellationsRow
+	for rows.Next() {
+		var i GetPlayerCalendarCancellationsRow
+		if err := rows.Scan(
+			&i.Playerid,
+			&i.Matchid,
+			&i.Seasonid,
+			&i.Matchdate,
+			&i.Group,
+			&i.Calendarsequence,
+			&i.Cancelledat,
+			&i.SeasonName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerCustomColumn = `-- name: GetPlayerCustomColumn :one
+SELECT id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat, userid, options FROM player_custom_columns
+WHERE id = $1 AND userId = $2
+`
+
+type GetPlayerCustomColumnParams struct {
+	ID     int32
+	Userid int32
+}
+
+func (q *Queries) GetPlayerCustomColumn(ctx context.Context, arg GetPlayerCustomColumnParams) (PlayerCustomColumn, error) {
+	row := q.db.QueryRow(ctx, getPlayerCustomColumn, arg.ID, arg.Userid)
+	var i PlayerCustomColumn
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.FieldType,
+		&i.Description,
+		&i.IsRequired,
+		&i.IsActive,
+		&i.DisplayOrder,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Userid,
+		&i.Options,
+	)
+	return i, err
+}
+
+const getPlayerCustomColumns = `-- name: GetPlayerCustomColumns :many
+SELECT id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat, userid, options FROM player_custom_columns
+WHERE userId = $1 AND is_active = true
+ORDER BY display_order
+`
+
+func (q *Queries) GetPlayerCustomColumns(ctx context.Context, userid int32) ([]PlayerCustomColumn, error) {
+	rows, err := q.db.Query(ctx, getPlayerCustomColumns, userid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []PlayerCustomColumn
+	for rows.Next() {
+		var i PlayerCustomColumn
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.FieldType,
+			&i.Description,
+			&i.IsRequired,
+			&i.IsActive,
+			&i.DisplayOrder,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.Userid,
+			&i.Options,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerCustomValues = `-- name: GetPlayerCustomValues :many
+SELECT pcv.id, pcv.player_id, pcv.column_id, pcv.value, pcv.createdat, pcv.updatedat, pcc.name as column_name, pcc.field_type
+FROM player_custom_values pcv
+JOIN player_custom_columns pcc ON pcv.column_id = pcc.id
+WHERE pcv.player_id = $1
+`
+
+type GetPlayerCustomValuesRow struct {
+	ID         int32
+	PlayerID   pgtype.Int4
+	ColumnID   pgtype.Int4
+	Value      pgtype.Text
+	Createdat  pgtype.Timestamp
+	Updatedat  pgtype.Timestamp
+	ColumnName string
+	FieldType  string
+}
+
+func (q *Queries) GetPlayerCustomValues(ctx context.Context, playerID pgtype.Int4) ([]GetPlayerCustomValuesRow, error) {
+	rows, err := q.db.Query(ctx, getPlayerCustomValues, playerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPlayerCustomValuesRow
+	for rows.Next() {
+		var i GetPlayerCustomValuesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PlayerID,
+			&i.ColumnID,
+			&i.Value,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.ColumnName,
+			&i.FieldType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayerOwner = `-- name: GetPlayerOwner :one
+SELECT userId FROM players
+WHERE id = $1
+`
+
+func (q *Queries) GetPlayerOwner(ctx context.Context, id int32) (pgtype.Int4, error) {
+	row := q.db.QueryRow(ctx, getPlayerOwner, id)
+	var userid pgtype.Int4
+	err := row.Scan(&userid)
+	return userid, err
+}
+
+const getPlayerSchedule = `-- name: GetPlayerSchedule :many
+SELECT m.id, m.seasonid, m.playerid1, m.playerid1points, m.playerid2, m.playerid2points, m.matchdate, m.winnerid, m.createdat, m.updatedat, m.isactive, m."group", m.calendarsequence, s.name AS season_name, o.id AS opponent_id, o.name AS opponent_name
+FROM matches m
+JOIN seasons s ON s.id = m.seasonId
+LEFT JOIN players o ON o.id = CASE WHEN m.playerId1 = $1 THEN m.playerId2 ELSE m.playerId1 END
+WHERE (m.playerId1 = $1 OR m.playerId2 = $1) AND s.isActive = true
+ORDER BY m.matchDate, m.id
+`
+
+type GetPlayerScheduleRow struct {
+	ID               int32
+	Seasonid         pgtype.Int4
+	Playerid1        pgtype.Int4
+	Playerid1points  int32
+	Playerid2        pgtype.Int4
+	Playerid2points  int32
+	Matchdate        pgtype.Date
+	Winnerid         pgtype.Int4
+	Createdat        pgtype.Timestamp
+	Updatedat        pgtype.Timestamp
+	Isactive         bool
+	Group            int32
+	Calendarsequence int32
+	SeasonName       string
+	OpponentID       pgtype.Int4
+	OpponentName     pgtype.Text
+}
+
+func (q *Queries) GetPlayerSchedule(ctx context.Context, playerid1 pgtype.Int4) ([]GetPlayerScheduleRow, error) {
+	rows, err := q.db.Query(ctx, getPlayerSchedule, playerid1)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetPlayerScheduleRow
+	for rows.Next() {
+		var i GetPlayerScheduleRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Seasonid,
+			&i.Playerid1,
+			&i.Playerid1points,
+			&i.Playerid2,
+			&i.Playerid2points,
+			&i.Matchdate,
+			&i.Winnerid,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.Isactive,
+			&i.Group,
+			&i.Calendarsequence,
+			&i.SeasonName,
+			&i.OpponentID,
+			&i.OpponentName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPlayers = `-- name: GetPlayers :many
+SELECT id, userid, name, email, createdat, updatedat, preferredmatchgroup, isactive, emailnotificationsenabled FROM players
+WHERE userId = $1 AND isActive = true
+`
+
+func (q *Queries) GetPlayers(ctx context.Context, userid pgtype.Int4) ([]Player, error) {
+	rows, err := q.db.Query(ctx, getPlayers, userid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Player
+	for rows.Next() {
+		var i Player
+		if err := rows.Scan(
+			&i.ID,
+			&i.Userid,
+			&i.Name,
+			&i.Email,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.Preferredmatchgroup,
+			&i.Isactive,
+			&i.Emailnotificationsenabled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeason = `-- name: GetSeason :one
+SELECT id, userid, name, startdate, createdat, updatedat, isactive, seasontype, frequency, format, pointsperwin, pointsperdraw, pointsperloss, tiebreakers FROM seasons
+WHERE id = $1
+  AND id IN (SELECT a.seasonId FROM season_access a WHERE a.userId = $2)
+`
+
+type GetSeasonParams struct {
+	ID     int32
+	Userid pgtype.Int4
+}
+
+func (q *Queries) GetSeason(ctx context.Context, arg GetSeasonParams) (Season, error) {
+	row := q.db.QueryRow(ctx, getSeason, arg.ID, arg.Userid)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Userid,
+		&i.Name,
+		&i.Startdate,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Isactive,
+		&i.Seasontype,
+		&i.Frequency,
+		&i.Format,
+		&i.Pointsperwin,
+		&i.Pointsperdraw,
+		&i.Pointsperloss,
+		&i.Tiebreakers,
+	)
+	return i, err
+}
+
+const getSeasonBracket = `-- name: GetSeasonBracket :many
+SELECT bm.id, bm.seasonid, bm.matchid, bm.bracket, bm.round, bm.position, bm.winnernextmatchid, bm.winnernextslot, bm.losernextmatchid, bm.losernextslot, m.playerId1, m.playerId2, m.playerId1Points, m.playerId2Points, m.winnerId, m.matchDate
+FROM bracket_matches bm
+JOIN matches m ON m.id = bm.matchId
+WHERE bm.seasonId = $1
+ORDER BY bm.bracket DESC, bm.round, bm.position
+`
+
+type GetSeasonBracketRow struct {
+	ID                int32
+	Seasonid          int32
+	Matchid           int32
+	Bracket           string
+	Round             int32
+	Position          int32
+	Winnernextmatchid pgtype.Int4
+	Winnernextslot    pgtype.Int4
+	Losernextmatchid  pgtype.Int4
+	Losernextslot     pgtype.Int4
+	Playerid1         pgtype.Int4
+	Playerid2         pgtype.Int4
+	Playerid1points   int32
+	Playerid2points   int32
+	Winnerid          pgtype.Int4
+	Matchdate         pgtype.Date
+}
+
+func (q *Queries) GetSeasonBracket(ctx context.Context, seasonid int32) ([]GetSeasonBracketRow, error) {
+	rows, err := q.db.Query(ctx, getSeasonBracket, seasonid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeasonBracketRow
+	for rows.Next() {
+		var i GetSeasonBracketRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Seasonid,
+			&i.Matchid,
+			&i.Bracket,
+			&i.Round,
+			&i.Position,
+			&i.Winnernextmatchid,
+			&i.Winnernextslot,
+			&i.Losernextmatchid,
+			&i.Losernextslot,
+			&i.Playerid1,
+			&i.Playerid2,
+			&i.Playerid1points,
+			&i.Playerid2points,
+			&i.Winnerid,
+			&i.Matchdate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeasonByShareToken = `-- name: GetSeasonByShareToken :one
+SELECT s.id, s.userid, s.name, s.startdate, s.createdat, s.updatedat, s.isactive, s.seasontype, s.frequency, s.format, s.pointsperwin, s.pointsperdraw, s.pointsperloss, s.tiebreakers FROM seasons s
+JOIN season_share_links l ON l.seasonId = s.id
+WHERE l.tokenHash = $1
+  AND l.revokedAt IS NULL
+  AND (l.expiresAt IS NULL OR l.expiresAt > CURRENT_TIMESTAMP)
+`
+
+func (q *Queries) GetSeasonByShareToken(ctx context.Context, tokenhash string) (Season, error) {
+	row := q.db.QueryRow(ctx, getSeasonByShareToken, tokenhash)
+	var i Season
+	err := row.Scan(
+		&i.ID,
+		&i.Userid,
+		&i.Name,
+		&i.Startdate,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Isactive,
+		&i.Seasontype,
+		&i.Frequency,
+		&i.Format,
+		&i.Pointsperwin,
+		&i.Pointsperdraw,
+		&i.Pointsperloss,
+		&i.Tiebreakers,
+	)
+	return i, err
+}
+
+const getSeasonMatchCustomValues = `-- name: GetSeasonMatchCustomValues :many
+SELECT mcv.id, mcv.match_id, mcv.column_id, mcv.value, mcv.createdat, mcv.updatedat, mcc.name as column_name, mcc.field_type
+FROM match_custom_values mcv
+JOIN match_custom_columns mcc ON mcv.column_id = mcc.id
+JOIN matches m ON m.id = mcv.match_id
+WHERE m.seasonId = $1 AND mcc.is_active = true
+ORDER BY mcc.display_order, mcc.id
+`
+
+type GetSeasonMatchCustomValuesRow struct {
+	ID         int32
+	MatchID    pgtype.Int4
+	ColumnID   pgtype.Int4
+	Value      pgtype.Text
+	Createdat  pgtype.Timestamp
+	Updatedat  pgtype.Timestamp
+	ColumnName string
+	FieldType  string
+}
+
+func (q *Queries) GetSeasonMatchCustomValues(ctx context.Context, seasonid pgtype.Int4) ([]GetSeasonMatchCustomValuesRow, error) {
+	rows, err := q.db.Query(ctx, getSeasonMatchCustomValues, seasonid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeasonMatchCustomValuesRow
+	for rows.Next() {
+		var i GetSeasonMatchCustomValuesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.MatchID,
+			&i.ColumnID,
+			&i.Value,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.ColumnName,
+			&i.FieldType,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeasonMatches = `-- name: GetSeasonMatches :many
+SELECT id, seasonid, playerid1, playerid1points, playerid2, playerid2points, matchdate, winnerid, createdat, updatedat, isactive, "group", calendarsequence FROM matches
+WHERE seasonId = $1
+ORDER BY matchDate ASC, id ASC
+`
+
+func (q *Queries) GetSeasonMatches(ctx context.Context, seasonid pgtype.Int4) ([]Match, error) {
+	rows, err := q.db.Query(ctx, getSeasonMatches, seasonid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Match
+	for rows.Next() {
+		var i Match
+		if err := rows.Scan(
+			&i.ID,
+			&i.Seasonid,
+			&i.Playerid1,
+			&i.Playerid1points,
+			&i.Playerid2,
+			&i.Playerid2points,
+			&i.Matchdate,
+			&i.Winnerid,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.Isactive,
+			&i.Group,
+			&i.Calendarsequence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeasonMember = `-- name: GetSeasonMember :one
+SELECT id, seasonid, email, role, invitedby, createdat, updatedat FROM season_members
+WHERE id = $1 AND seasonId = $2
+`
+
+type GetSeasonMemberParams struct {
+	ID       int32
+	Seasonid int32
+}
+
+func (q *Queries) GetSeasonMember(ctx context.Context, arg GetSeasonMemberParams) (SeasonMember, error) {
+	row := q.db.QueryRow(ctx, getSeasonMember, arg.ID, arg.Seasonid)
+	var i SeasonMember
+	err := row.Scan(
+		&i.ID,
+		&i.Seasonid,
+		&i.Email,
+		&i.Role,
+		&i.Invitedby,
+		&i.Createdat,
+		&i.Updatedat,
+	)
+	return i, err
+}
+
+const getSeasonMembers = `-- name: GetSeasonMembers :many
+SELECT m.id, m.seasonid, m.email, m.role, m.invitedby, m.createdat, m.updatedat, u.id AS member_user_id, u.name AS member_name FROM season_members m
+LEFT JOIN users u ON lower(u.email) = m.email AND u.isVerified AND u.isActive
+WHERE m.seasonId = $1
+ORDER BY m.createdAt, m.id
+`
+
+type GetSeasonMembersRow struct {
+	ID           int32
+	Seasonid     int32
+	Email        string
+	Role         string
+	Invitedby    pgtype.Int4
+	Createdat    pgtype.Timestamp
+	Updatedat    pgtype.Timestamp
+	MemberUserID pgtype.Int4
+	MemberName   pgtype.Text
+}
+
+func (q *Queries) GetSeasonMembers(ctx context.Context, seasonid int32) ([]GetSeasonMembersRow, error) {
+	rows, err := q.db.Query(ctx, getSeasonMembers, seasonid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeasonMembersRow
+	for rows.Next() {
+		var i GetSeasonMembersRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Seasonid,
+			&i.Email,
+			&i.Role,
+			&i.Invitedby,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.MemberUserID,
+			&i.MemberName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeasonOwner = `-- name: GetSeasonOwner :one
+SELECT userId FROM seasons
+WHERE id = $1
+`
+
+func (q *Queries) GetSeasonOwner(ctx context.Context, id int32) (pgtype.Int4, error) {
+	row := q.db.QueryRow(ctx, getSeasonOwner, id)
+	var userid pgtype.Int4
+	err := row.Scan(&userid)
+	return userid, err
+}
+
+const getSeasonParticipants = `-- name: GetSeasonParticipants :many
+SELECT p.id, p.name FROM players p
+WHERE p.id IN (
+    SELECT m.playerId1 FROM matches m WHERE m.seasonId = $1
+    UNION
+    SELECT m.playerId2 FROM matches m WHERE m.seasonId = $1
+)
+ORDER BY p.id
+`
+
+type GetSeasonParticipantsRow struct {
+	ID   int32
+	Name string
+}
+
+func (q *Queries) GetSeasonParticipants(ctx context.Context, seasonid pgtype.Int4) ([]GetSeasonParticipantsRow, error) {
+	rows, err := q.db.Query(ctx, getSeasonParticipants, seasonid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetSeasonParticipantsRow
+	for rows.Next() {
+		var i GetSeasonParticipantsRow
+		if err := rows.Scan(&i.ID, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
 
-type GetPlayerParams struct {
+const getSeasonRatedMatches = `-- name: GetSeasonRatedMatches :many
+SELECT m.id, m.seasonid, m.playerid1, m.playerid1points, m.playerid2, m.playerid2points, m.matchdate, m.winnerid, m.createdat, m.updatedat, m.isactive, m."group", m.calendarsequence FROM matches m
+JOIN seasons s ON s.id = m.seasonId
+WHERE m.seasonId = $1
+  AND m.playerId1 IS NOT NULL AND m.playerId2 IS NOT NULL
+  AND (m.winnerId IS NOT NULL OR m.playerId1Points <> 0 OR m.playerId2Points <> 0)
+ORDER BY m.matchDate ASC, m.id ASC
+`
+
+func (q *Queries) GetSeasonRatedMatches(ctx context.Context, seasonid pgtype.Int4) ([]Match, error) {
+	rows, err := q.db.Query(ctx, getSeasonRatedMatches, seasonid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Match
+	for rows.Next() {
+		var i Match
+		if err := rows.Scan(
+			&i.ID,
+			&i.Seasonid,
+			&i.Playerid1,
+			&i.Playerid1points,
+			&i.Playerid2,
+			&i.Playerid2points,
+			&i.Matchdate,
+			&i.Winnerid,
+			&i.Createdat,
+			&i.Updatedat,
+			&i.Isactive,
+			&i.Group,
+			&i.Calendarsequence,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSeasonRole = `-- name: GetSeasonRole :one
+SELECT a.role FROM seasons s
+LEFT JOIN season_access a ON a.seasonId = s.id AND a.userId = $2
+WHERE s.id = $1
+ORDER BY CASE a.role WHEN 'owner' THEN 4 WHEN 'admin' THEN 3 WHEN 'scorekeeper' THEN 2 WHEN 'viewer' THEN 1 END DESC NULLS LAST
+LIMIT 1
+`
+
+type GetSeasonRoleParams struct {
 	ID     int32
 	Userid pgtype.Int4
 }
 
-func (q *Queries) GetPlayer(ctx context.Context, arg GetPlayerParams) (Player, error) {
-	row := q.db.QueryRow(ctx, getPlayer, arg.ID, arg.Userid)
-	var i Player
-	err := row.Scan(
-		&i.ID,
-		&i.Userid,
-		&i.Name,
-		&i.Email,
-		&i.Createdat,
-		&i.Updatedat,
-		&i.Preferredmatchgroup,
-		&i.Isactive,
-		&i.Emailnotificationsenabled,
-	)
-	return i, err
+func (q *Queries) GetSeasonRole(ctx context.Context, arg GetSeasonRoleParams) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getSeasonRole, arg.ID, arg.Userid)
+	var role pgtype.Text
+	err := row.Scan(&role)
+	return role, err
 }
 
-const getPlayerCustomColumns = `-- name: GetPlayerCustomColumns :many
-SELECT id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat FROM player_custom_columns
-WHERE is_active = true
-ORDER BY display_order
+const getSeasonShareLinks = `-- name: GetSeasonShareLinks :many
+SELECT id, seasonid, tokenhash, expiresat, revokedat, createdat FROM season_share_links
+WHERE seasonId = $1 AND revokedAt IS NULL
+ORDER BY createdAt, id
 `
 
-func (q *Queries) GetPlayerCustomColumns(ctx context.Context) ([]PlayerCustomColumn, error) {
-	rows, err := q.db.Query(ctx, getPlayerCustomColumns)
+func (q *Queries) GetSeasonShareLinks(ctx context.Context, seasonid int32) ([]SeasonShareLink, error) {
+	rows, err := q.db.Query(ctx, getSeasonShareLinks, seasonid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []PlayerCustomColumn
+	var items []SeasonShareLink
 	for rows.Next() {
-		var i PlayerCustomColumn
+		var i SeasonShareLink
 		if err := rows.Scan(
 			&i.ID,
-			&i.Name,
-			&i.FieldType,
-			&i.Description,
-			&i.IsRequired,
-			&i.IsActive,
-			&i.DisplayOrder,
+			&i.Seasonid,
+			&i.Tokenhash,
+			&i.Expiresat,
+			&i.Revokedat,
 			&i.Createdat,
-			&i.Updatedat,
 		); err != nil {
 			return nil, err
 		}
@@ -394,42 +1863,36 @@ func (q *Queries) GetPlayerCustomColumns(ctx context.Context) ([]PlayerCustomCol
 	return items, nil
 }
 
-const getPlayerCustomValues = `-- name: GetPlayerCustomValues :many
-SELECT pcv.id, pcv.player_id, pcv.column_id, pcv.value, pcv.createdat, pcv.updatedat, pcc.name as column_name, pcc.field_type
-FROM player_custom_values pcv
-JOIN player_custom_columns pcc ON pcv.column_id = pcc.id
-WHERE pcv.player_id = $1
+const getSeasonUpcomingMatches = `-- name: GetSeasonUpcomingMatches :many
+SELECT id, seasonid, playerid1, playerid1points, playerid2, playerid2points, matchdate, winnerid, createdat, updatedat, isactive, "group", calendarsequence FROM matches
+WHERE seasonId = $1 AND matchDate > CURRENT_TIMESTAMP
+ORDER BY matchDate ASC
+LIMIT 5
 `
 
-type GetPlayerCustomValuesRow struct {
-	ID         int32
-	PlayerID   pgtype.Int4
-	ColumnID   pgtype.Int4
-	Value      pgtype.Text
-	Createdat  pgtype.Timestamp
-	Updatedat  pgtype.Timestamp
-	ColumnName string
-	FieldType  string
-}
-
-func (q *Queries) GetPlayerCustomValues(ctx context.Context, playerID pgtype.Int4) ([]GetPlayerCustomValuesRow, error) {
-	rows, err := q.db.Query(ctx, getPlayerCustomValues, playerID)
+func (q *Queries) GetSeasonUpcomingMatches(ctx context.Context, seasonid pgtype.Int4) ([]Match, error) {
+	rows, err := q.db.Query(ctx, getSeasonUpcomingMatches, seasonid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []GetPlayerCustomValuesRow
+	var items []Match
 	for rows.Next() {
-		var i GetPlayerCustomValuesRow
+		var i Match
 		if err := rows.Scan(
 			&i.ID,
-			&i.PlayerID,
-			&i.ColumnID,
-			&i.Value,
+			&i.Seasonid,
+			&i.Playerid1,
+			&i.Playerid1points,
+			&i.Playerid2,
+			&i.Playerid2points,
+			&i.Matchdate,
+			&i.Winnerid,
 			&i.Createdat,
 			&i.Updatedat,
-			&i.ColumnName,
-			&i.FieldType,
+			&i.Isactive,
+			&i.Group,
+			&i.Calendarsequence,
 		); err != nil {
 			return nil, err
 		}
@@ -441,30 +1904,56 @@ func (q *Queries) GetPlayerCustomValues(ctx context.Context, playerID pgtype.Int
 	return items, nil
 }
 
-const getPlayers = `-- name: GetPlayers :many
-SELECT id, userid, name, email, createdat, updatedat, preferredmatchgroup, isactive, emailnotificationsenabled FROM players
-WHERE userId = $1 AND isActive = true
+const getSeasons = `-- name: GetSeasons :many
+SELECT s.id, s.userid, s.name, s.startdate, s.createdat, s.updatedat, s.isactive, s.seasontype, s.frequency, s.format, s.pointsperwin, s.pointsperdraw, s.pointsperloss, s.tiebreakers, a.role FROM seasons s
+JOIN season_access a ON a.seasonId = s.id
+WHERE a.userId = $1 AND s.isActive = true
+ORDER BY s.id
 `
 
-func (q *Queries) GetPlayers(ctx context.Context, userid pgtype.Int4) ([]Player, error) {
-	rows, err := q.db.Query(ctx, getPlayers, userid)
+type GetSeasonsRow struct {
+	ID            int32
+	Userid        pgtype.Int4
+	Name          string
+	Startdate     pgtype.Date
+	Createdat     pgtype.Timestamp
+	Updatedat     pgtype.Timestamp
+	Isactive      bool
+	Seasontype    string
+	Frequency     string
+	Format        string
+	Pointsperwin  int32
+	Pointsperdraw int32
+	Pointsperloss int32
+	Tiebreakers   []string
+	Role          string
+}
+
+func (q *Queries) GetSeasons(ctx context.Context, userid pgtype.Int4) ([]GetSeasonsRow, error) {
+	rows, err := q.db.Query(ctx, getSeasons, userid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Player
+	var items []GetSeasonsRow
 	for rows.Next() {
-		var i Player
+		var i GetSeasonsRow
 		if err := rows.Scan(
 			&i.ID,
 			&i.Userid,
 			&i.Name,
-			&i.Email,
+			&i.Startdate,
 			&i.Createdat,
 			&i.Updatedat,
-			&i.Preferredmatchgroup,
 			&i.Isactive,
-			&i.Emailnotificationsenabled,
+			&i.Seasontype,
+			&i.Frequency,
+			&i.Format,
+			&i.Pointsperwin,
+			&i.Pointsperdraw,
+			&i.Pointsperloss,
+			&i.Tiebreakers,
+			&i.Role,
 		); err != nil {
 			return nil, err
 		}
@@ -476,82 +1965,149 @@ func (q *Queries) GetPlayers(ctx context.Context, userid pgtype.Int4) ([]Player,
 	return items, nil
 }
 
-const getSeason = `-- name: GetSeason :one
-SELECT id, userid, name, startdate, createdat, updatedat, isactive, seasontype, frequency FROM seasons
-WHERE id = $1 AND userId = $2
+const getUserAppSettings = `-- name: GetUserAppSettings :one
+SELECT jsonSettings FROM users
+WHERE id = $1
 `
 
-type GetSeasonParams struct {
-	ID     int32
-	Userid pgtype.Int4
+func (q *Queries) GetUserAppSettings(ctx context.Context, id int32) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getUserAppSettings, id)
+	var jsonsettings pgtype.Text
+	err := row.Scan(&jsonsettings)
+	return jsonsettings, err
 }
 
-func (q *Queries) GetSeason(ctx context.Context, arg GetSeasonParams) (Season, error) {
-	row := q.db.QueryRow(ctx, getSeason, arg.ID, arg.Userid)
-	var i Season
+const getUserByEmail = `-- name: GetUserByEmail :one
+
+SELECT id, email, isVerified
+FROM users
+WHERE email = $1
+`
+
+type GetUserByEmailRow struct {
+	ID         int32
+	Email      string
+	Isverified bool
+}
+
+// query.sql with PostgreSQL parameter syntax
+func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
+	row := q.db.QueryRow(ctx, getUserByEmail, email)
+	var i GetUserByEmailRow
+	err := row.Scan(&i.ID, &i.Email, &i.Isverified)
+	return i, err
+}
+
+const getUserById = `-- name: GetUserById :one
+SELECT id, stytchid, stripeid, name, email, phone, country, birthday, lang, createdat, updatedat, isactive, isverified, subscriptiontier, jsonsettings, stripesubscriptionid, subscriptionstatus, subscriptioncurrentperiodend, passwordchangedat, cancelatperiodend FROM users
+WHERE id = $1
+`
+
+func (q *Queries) GetUserById(ctx context.Context, id int32) (User, error) {
+	row := q.db.QueryRow(ctx, getUserById, id)
+	var i User
 	err := row.Scan(
 		&i.ID,
-		&i.Userid,
+		&i.Stytchid,
+		&i.Stripeid,
 		&i.Name,
-		&i.Startdate,
+		&i.Email,
+		&i.Phone,
+		&i.Country,
+		&i.Birthday,
+		&i.Lang,
 		&i.Createdat,
 		&i.Updatedat,
 		&i.Isactive,
-		&i.Seasontype,
-		&i.Frequency,
+		&i.Isverified,
+		&i.Subscriptiontier,
+		&i.Jsonsettings,
+		&i.Stripesubscriptionid,
+		&i.Subscriptionstatus,
+		&i.Subscriptioncurrentperiodend,
+		&i.Passwordchangedat,
+		&i.Cancelatperiodend,
 	)
 	return i, err
 }
 
-const getSeasonScoreboard = `-- name: GetSeasonScoreboard :many
-SELECT p.id as player_id, p.name as player_name, COUNT(m.winnerId) as wins
-FROM players p
-LEFT JOIN matches m ON p.id = m.winnerId AND m.seasonId = $1
-WHERE p.userId = (SELECT s.userId FROM seasons s WHERE s.id = $2)
-GROUP BY p.id, p.name
-ORDER BY wins DESC
+const getUserByStripeId = `-- name: GetUserByStripeId :one
+SELECT id, stytchid, stripeid, name, email, phone, country, birthday, lang, createdat, updatedat, isactive, isverified, subscriptiontier, jsonsettings, stripesubscriptionid, subscriptionstatus, subscriptioncurrentperiodend, passwordchangedat, cancelatperiodend FROM users
+WHERE stripeId = $1
 `
 
-type GetSeasonScoreboardParams struct {
-	Seasonid pgtype.Int4
-	ID       int32
+func (q *Queries) GetUserByStripeId(ctx context.Context, stripeid string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByStripeId, stripeid)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Stytchid,
+		&i.Stripeid,
+		&i.Name,
+		&i.Email,
+		&i.Phone,
+		&i.Country,
+		&i.Birthday,
+		&i.Lang,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Isactive,
+		&i.Isverified,
+		&i.Subscriptiontier,
+		&i.Jsonsettings,
+		&i.Stripesubscriptionid,
+		&i.Subscriptionstatus,
+		&i.Subscriptioncurrentperiodend,
+		&i.Passwordchangedat,
+		&i.Cancelatperiodend,
+	)
+	return i, err
 }
 
-type GetSeasonScoreboardRow struct {
-	PlayerID   int32
-	PlayerName string
-	Wins       int64
-}
+const getUserByStytchId = `-- name: GetUserByStytchId :one
+SELECT id, stytchid, stripeid, name, email, phone, country, birthday, lang, createdat, updatedat, isactive, isverified, subscriptiontier, jsonsettings, stripesubscriptionid, subscriptionstatus, subscriptioncurrentperiodend, passwordchangedat, cancelatperiodend FROM users
+WHERE stytchId = $1
+`
 
-func (q *Queries) GetSeasonScoreboard(ctx context.Context, arg GetSeasonScoreboardParams) ([]GetSeasonScoreboardRow, error) {
-	rows, err := q.db.Query(ctx, getSeasonScoreboard, arg.Seasonid, arg.ID)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []GetSeasonScoreboardRow
-	for rows.Next() {
-		var i GetSeasonScoreboardRow
-		if err := rows.Scan(&i.PlayerID, &i.PlayerName, &i.Wins); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
+func (q *Queries) GetUserByStytchId(ctx context.Context, stytchid string) (User, error) {
+	row := q.db.QueryRow(ctx, getUserByStytchId, stytchid)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Stytchid,
+		&i.Stripeid,
+		&i.Name,
+		&i.Email,
+		&i.Phone,
+		&i.Country,
+		&i.Birthday,
+		&i.Lang,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Isactive,
+		&i.Isverified,
+		&i.Subscriptiontier,
+		&i.Jsonsettings,
+		&i.Stripesubscriptionid,
+		&i.Subscriptionstatus,
+		&i.Subscriptioncurrentperiodend,
+		&i.Passwordchangedat,
+		&i.Cancelatperiodend,
+	)
+	return i, err
 }
 
-const getSeasonUpcomingMatches = `-- name: GetSeasonUpcomingMatches :many
-SELECT id, seasonid, playerid1, playerid1points, playerid2, playerid2points, matchdate, winnerid, createdat, updatedat, isactive, "group" FROM matches
-WHERE seasonId = $1 AND matchDate > CURRENT_TIMESTAMP
-ORDER BY matchDate ASC
-LIMIT 5
+const getUserRatedMatches = `-- name: GetUserRatedMatches :many
+SELECT m.id, m.seasonid, m.playerid1, m.playerid1points, m.playerid2, m.playerid2points, m.matchdate, m.winnerid, m.createdat, m.updatedat, m.isactive, m."group", m.calendarsequence FROM matches m
+JOIN seasons s ON s.id = m.seasonId
+WHERE s.userId = $1
+  AND m.playerId1 IS NOT NULL AND m.playerId2 IS NOT NULL
+  AND (m.winnerId IS NOT NULL OR m.playerId1Points <> 0 OR m.playerId2Points <> 0)
+ORDER BY m.matchDate ASC, m.id ASC
 `
 
-func (q *Queries) GetSeasonUpcomingMatches(ctx context.Context, seasonid pgtype.Int4) ([]Match, error) {
-	rows, err := q.db.Query(ctx, getSeasonUpcomingMatches, seasonid)
+func (q *Queries) GetUserRatedMatches(ctx context.Context, userid pgtype.Int4) ([]Match, error) {
+	rows, err := q.db.Query(ctx, getUserRatedMatches, userid)
 	if err != nil {
 		return nil, err
 	}
@@ -572,6 +2128,7 @@ func (q *Queries) GetSeasonUpcomingMatches(ctx context.Context, seasonid pgtype.
 			&i.Updatedat,
 			&i.Isactive,
 			&i.Group,
+			&i.Calendarsequence,
 		); err != nil {
 			return nil, err
 		}
@@ -583,30 +2140,27 @@ func (q *Queries) GetSeasonUpcomingMatches(ctx context.Context, seasonid pgtype.
 	return items, nil
 }
 
-const getSeasons = `-- name: GetSeasons :many
-SELECT id, userid, name, startdate, createdat, updatedat, isactive, seasontype, frequency FROM seasons
-WHERE userId = $1 AND isActive = true
+const getUserSessions = `-- name: GetUserSessions :many
+SELECT sessionid, userid, ipaddress, useragent, createdat, lastseenat FROM user_sessions
+WHERE userId = $1
 `
 
-func (q *Queries) GetSeasons(ctx context.Context, userid pgtype.Int4) ([]Season, error) {
-	rows, err := q.db.Query(ctx, getSeasons, userid)
+func (q *Queries) GetUserSessions(ctx context.Context, userid int32) ([]UserSession, error) {
+	rows, err := q.db.Query(ctx, getUserSessions, userid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Season
+	var items []UserSession
 	for rows.Next() {
-		var i Season
+		var i UserSession
 		if err := rows.Scan(
-			&i.ID,
+			&i.Sessionid,
 			&i.Userid,
-			&i.Name,
-			&i.Startdate,
+			&i.Ipaddress,
+			&i.Useragent,
 			&i.Createdat,
-			&i.Updatedat,
-			&i.Isactive,
-			&i.Seasontype,
-			&i.Frequency,
+			&i.Lastseenat,
 		); err != nil {
 			return nil, err
 		}
@@ -618,67 +2172,141 @@ func (q *Queries) GetSeasons(ctx context.Context, userid pgtype.Int4) ([]Season,
 	return items, nil
 }
 
-const getUserAppSettings = `-- name: GetUserAppSettings :one
+const getUserSubscription = `-- name: GetUserSubscription :one
+SELECT subscriptionTier, stripeId, stripeSubscriptionId, subscriptionStatus, subscriptionCurrentPeriodEnd, cancelAtPeriodEnd
+FROM users
+WHERE id = $1
+`
+
+type GetUserSubscriptionRow struct {
+	Subscriptiontier             string
+	Stripeid                     string
+	Stripesubscriptionid         pgtype.Text
+	Subscriptionstatus           pgtype.Text
+	Subscriptioncurrentperiodend pgtype.Timestamp
+	Cancelatperiodend            bool
+}
+
+func (q *Queries) GetUserSubscription(ctx context.Context, id int32) (GetUserSubscriptionRow, error) {
+	row := q.db.QueryRow(ctx, getUserSubscription, id)
+	var i GetUserSubscriptionRow
+	err := row.Scan(
+		&i.Subscriptiontier,
+		&i.Stripeid,
+		&i.Stripesubscriptionid,
+		&i.Subscriptionstatus,
+		&i.Subscriptioncurrentperiodend,
+		&i.Cancelatperiodend,
+	)
+	return i, err
+}
+
+const getUserUserSettings = `-- name: GetUserUserSettings :one
 SELECT jsonSettings FROM users
 WHERE id = $1
 `
 
-func (q *Queries) GetUserAppSettings(ctx context.Context, id int32) (pgtype.Text, error) {
-	row := q.db.QueryRow(ctx, getUserAppSettings, id)
+func (q *Queries) GetUserUserSettings(ctx context.Context, id int32) (pgtype.Text, error) {
+	row := q.db.QueryRow(ctx, getUserUserSettings, id)
 	var jsonsettings pgtype.Text
 	err := row.Scan(&jsonsettings)
 	return jsonsettings, err
 }
 
-const getUserByEmail = `-- name: GetUserByEmail :one
-
-SELECT id, email, isVerified
-FROM users
-WHERE email = $1
+const revokeApiKey = `-- name: RevokeApiKey :one
+UPDATE api_keys
+SET revokedAt = COALESCE(revokedAt, CURRENT_TIMESTAMP)
+WHERE id = $1 AND userId = $2 AND revokedAt IS NULL
+RETURNING id, userid, name, prefix, keyhash, scopes, expiresat, lastusedat, revokedat, createdat
 `
 
-type GetUserByEmailRow struct {
-	ID         int32
-	Email      string
-	Isverified bool
+type RevokeApiKeyParams struct {
+	ID     int32
+	Userid int32
 }
 
-// query.sql with PostgreSQL parameter syntax
-func (q *Queries) GetUserByEmail(ctx context.Context, email string) (GetUserByEmailRow, error) {
-	row := q.db.QueryRow(ctx, getUserByEmail, email)
-	var i GetUserByEmailRow
-	err := row.Scan(&i.ID, &i.Email, &i.Isverified)
+func (q *Queries) RevokeApiKey(ctx context.Context, arg RevokeApiKeyParams) (ApiKey, error) {
+	row := q.db.QueryRow(ctx, revokeApiKey, arg.ID, arg.Userid)
+	var i ApiKey
+	err := row.Scan(
+		&i.ID,
+		&i.Userid,
+		&i.Name,
+		&i.Prefix,
+		&i.Keyhash,
+		&i.Scopes,
+		&i.Expiresat,
+		&i.Lastusedat,
+		&i.Revokedat,
+		&i.Createdat,
+	)
 	return i, err
 }
 
-const getUserSubscription = `-- name: GetUserSubscription :one
-SELECT subscriptionTier, stripeId
-FROM users
-WHERE id = $1
+const revokeSeasonShareLink = `-- name: RevokeSeasonShareLink :one
+UPDATE season_share_links
+SET revokedAt = COALESCE(revokedAt, CURRENT_TIMESTAMP)
+WHERE id = $1 AND seasonId = $2
+RETURNING id, seasonid, tokenhash, expiresat, revokedat, createdat
 `
 
-type GetUserSubscriptionRow struct {
-	Subscriptiontier string
-	Stripeid         string
+type RevokeSeasonShareLinkParams struct {
+	ID       int32
+	Seasonid int32
 }
 
-func (q *Queries) GetUserSubscription(ctx context.Context, id int32) (GetUserSubscriptionRow, error) {
-	row := q.db.QueryRow(ctx, getUserSubscription, id)
-	var i GetUserSubscriptionRow
-	err := row.Scan(&i.Subscriptiontier, &i.Stripeid)
+func (q *Queries) RevokeSeasonShareLink(ctx context.Context, arg RevokeSeasonShareLinkParams) (SeasonShareLink, error) {
+	row := q.db.QueryRow(ctx, revokeSeasonShareLink, arg.ID, arg.Seasonid)
+	var i SeasonShareLink
+	err := row.Scan(
+		&i.ID,
+		&i.Seasonid,
+		&i.Tokenhash,
+		&i.Expiresat,
+		&i.Revokedat,
+		&i.Createdat,
+	)
 	return i, err
 }
 
-const getUserUserSettings = `-- name: GetUserUserSettings :one
-SELECT jsonSettings FROM users
+const setBracketMatchLinks = `-- name: SetBracketMatchLinks :exec
+UPDATE bracket_matches
+SET winnerNextMatchId = $1,
+    winnerNextSlot = $2,
+    loserNextMatchId = $3,
+    loserNextSlot = $4
+WHERE matchId = $5
+`
+
+type SetBracketMatchLinksParams struct {
+	Winnernextmatchid pgtype.Int4
+	Winnernextslot    pgtype.Int4
+	Losernextmatchid  pgtype.Int4
+	Losernextslot     pgtype.Int4
+	Matchid           int32
+}
+
+func (q *Queries) SetBracketMatchLinks(ctx context.Context, arg SetBracketMatchLinksParams) error {
+	_, err := q.db.Exec(ctx, setBracketMatchLinks,
+		arg.Winnernextmatchid,
+		arg.Winnernextslot,
+		arg.Losernextmatchid,
+		arg.Losernextslot,
+		arg.Matchid,
+	)
+	return err
+}
+
+const touchApiKey = `-- name: TouchApiKey :exec
+UPDATE api_keys
+SET lastUsedAt = CURRENT_TIMESTAMP
 WHERE id = $1
+  AND (lastUsedAt IS NULL OR lastUsedAt <= CURRENT_TIMESTAMP - interval '1 minute')
 `
 
-func (q *Queries) GetUserUserSettings(ctx context.Context, id int32) (pgtype.Text, error) {
-	row := q.db.QueryRow(ctx, getUserUserSettings, id)
-	var jsonsettings pgtype.Text
-	err := row.Scan(&jsonsettings)
-	return jsonsettings, err
+func (q *Queries) TouchApiKey(ctx context.Context, id int32) error {
+	_, err := q.db.Exec(ctx, touchApiKey, id)
+	return err
 }
 
 const updateMatch = `-- name: UpdateMatch :one
@@ -694,7 +2322,8 @@ SET seasonId = $1,
     isActive = $9,
     updatedAt = CURRENT_TIMESTAMP
 WHERE id = $10
-RETURNING id, seasonid, playerid1, playerid1points, playerid2, playerid2points, matchdate, winnerid, createdat, updatedat, isactive, "group"
+  AND seasonId IN (SELECT a.seasonId FROM season_access a WHERE a.userId = $11 AND a.role IN ('owner', 'admin', 'scorekeeper'))
+RETURNING id, seasonid, playerid1, playerid1points, playerid2, playerid2points, matchdate, winnerid, createdat, updatedat, isactive, "group", calendarsequence
 `
 
 type UpdateMatchParams struct {
@@ -708,6 +2337,7 @@ type UpdateMatchParams struct {
 	Group           int32
 	Isactive        bool
 	ID              int32
+	Userid          pgtype.Int4
 }
 
 func (q *Queries) UpdateMatch(ctx context.Context, arg UpdateMatchParams) (Match, error) {
@@ -722,6 +2352,7 @@ func (q *Queries) UpdateMatch(ctx context.Context, arg UpdateMatchParams) (Match
 		arg.Group,
 		arg.Isactive,
 		arg.ID,
+		arg.Userid,
 	)
 	var i Match
 	err := row.Scan(
@@ -737,6 +2368,62 @@ func (q *Queries) UpdateMatch(ctx context.Context, arg UpdateMatchParams) (Match
 		&i.Updatedat,
 		&i.Isactive,
 		&i.Group,
+		&i.Calendarsequence,
+	)
+	return i, err
+}
+
+const updateMatchCustomColumn = `-- name: UpdateMatchCustomColumn :one
+UPDATE match_custom_columns
+SET name = $3,
+    field_type = $4,
+    options = $5,
+    description = $6,
+    is_required = $7,
+    is_active = $8,
+    display_order = $9,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $1 AND userId = $2
+RETURNING id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat, userid, options
+`
+
+type UpdateMatchCustomColumnParams struct {
+	ID           int32
+	Userid       int32
+	Name         string
+	FieldType    string
+	Options      []string
+	Description  pgtype.Text
+	IsRequired   pgtype.Bool
+	IsActive     pgtype.Bool
+	DisplayOrder pgtype.Int4
+}
+
+func (q *Queries) UpdateMatchCustomColumn(ctx context.Context, arg UpdateMatchCustomColumnParams) (MatchCustomColumn, error) {
+	row := q.db.QueryRow(ctx, updateMatchCustomColumn,
+		arg.ID,
+		arg.Userid,
+		arg.Name,
+		arg.FieldType,
+		arg.Options,
+		arg.Description,
+		arg.IsRequired,
+		arg.IsActive,
+		arg.DisplayOrder,
+	)
+	var i MatchCustomColumn
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.FieldType,
+		&i.Description,
+		&i.IsRequired,
+		&i.IsActive,
+		&i.DisplayOrder,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Userid,
+		&i.Options,
 	)
 	return i, err
 }
@@ -809,6 +2496,79 @@ func (q *Queries) UpdatePlayer(ctx context.Context, arg UpdatePlayerParams) (Pla
 	return i, err
 }
 
+const updatePlayerCustomColumn = `-- name: UpdatePlayerCustomColumn :one
+UPDATE player_custom_columns
+SET name = $3,
+    field_type = $4,
+    options = $5,
+    description = $6,
+    is_required = $7,
+    is_active = $8,
+    display_order = $9,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $1 AND userId = $2
+RETURNING id, name, field_type, description, is_required, is_active, display_order, createdat, updatedat, userid, options
+`
+
+type UpdatePlayerCustomColumnParams struct {
+	ID           int32
+	Userid       int32
+	Name         string
+	FieldType    string
+	Options      []string
+	Description  pgtype.Text
+	IsRequired   pgtype.Bool
+	IsActive     pgtype.Bool
+	DisplayOrder pgtype.Int4
+}
+
+func (q *Queries) UpdatePlayerCustomColumn(ctx context.Context, arg UpdatePlayerCustomColumnParams) (PlayerCustomColumn, error) {
+	row := q.db.QueryRow(ctx, updatePlayerCustomColumn,
+		arg.ID,
+		arg.Userid,
+		arg.Name,
+		arg.FieldType,
+		arg.Options,
+		arg.Description,
+		arg.IsRequired,
+		arg.IsActive,
+		arg.DisplayOrder,
+	)
+	var i PlayerCustomColumn
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.FieldType,
+		&i.Description,
+		&i.IsRequired,
+		&i.IsActive,
+		&i.DisplayOrder,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Userid,
+		&i.Options,
+	)
+	return i, err
+}
+
+const updatePlayerCustomColumnOrder = `-- name: UpdatePlayerCustomColumnOrder :exec
+UPDATE player_custom_columns
+SET display_order = $3,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $1 AND userId = $2
+`
+
+type UpdatePlayerCustomColumnOrderParams struct {
+	ID           int32
+	Userid       int32
+	DisplayOrder pgtype.Int4
+}
+
+func (q *Queries) UpdatePlayerCustomColumnOrder(ctx context.Context, arg UpdatePlayerCustomColumnOrderParams) error {
+	_, err := q.db.Exec(ctx, updatePlayerCustomColumnOrder, arg.ID, arg.Userid, arg.DisplayOrder)
+	return err
+}
+
 const updateSeason = `-- name: UpdateSeason :one
 UPDATE seasons
 SET name = $1,
@@ -816,19 +2576,28 @@ SET name = $1,
     seasonType = $3,
     frequency = $4,
     isActive = $5,
+    pointsPerWin = $6,
+    pointsPerDraw = $7,
+    pointsPerLoss = $8,
+    tiebreakers = $9,
     updatedAt = CURRENT_TIMESTAMP
-WHERE id = $6 AND userId = $7
-RETURNING id, userid, name, startdate, createdat, updatedat, isactive, seasontype, frequency
+WHERE id = $10
+  AND id IN (SELECT a.seasonId FROM season_access a WHERE a.userId = $11 AND a.role IN ('owner', 'admin'))
+RETURNING id, userid, name, startdate, createdat, updatedat, isactive, seasontype, frequency, format, pointsperwin, pointsperdraw, pointsperloss, tiebreakers
 `
 
 type UpdateSeasonParams struct {
-	Name       string
-	Startdate  pgtype.Date
-	Seasontype string
-	Frequency  string
-	Isactive   bool
-	ID         int32
-	Userid     pgtype.Int4
+	Name          string
+	Startdate     pgtype.Date
+	Seasontype    string
+	Frequency     string
+	Isactive      bool
+	Pointsperwin  int32
+	Pointsperdraw int32
+	Pointsperloss int32
+	Tiebreakers   []string
+	ID            int32
+	Userid        pgtype.Int4
 }
 
 func (q *Queries) UpdateSeason(ctx context.Context, arg UpdateSeasonParams) (Season, error) {
@@ -838,6 +2607,10 @@ func (q *Queries) UpdateSeason(ctx context.Context, arg UpdateSeasonParams) (Sea
 		arg.Seasontype,
 		arg.Frequency,
 		arg.Isactive,
+		arg.Pointsperwin,
+		arg.Pointsperdraw,
+		arg.Pointsperloss,
+		arg.Tiebreakers,
 		arg.ID,
 		arg.Userid,
 	)
@@ -852,6 +2625,57 @@ func (q *Queries) UpdateSeason(ctx context.Context, arg UpdateSeasonParams) (Sea
 		&i.Isactive,
 		&i.Seasontype,
 		&i.Frequency,
+		&i.Format,
+		&i.Pointsperwin,
+		&i.Pointsperdraw,
+		&i.Pointsperloss,
+		&i.Tiebreakers,
+	)
+	return i, err
+}
+
+const updateSeasonFormat = `-- name: UpdateSeasonFormat :exec
+UPDATE seasons
+SET format = $1,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $2
+`
+
+type UpdateSeasonFormatParams struct {
+	Format string
+	ID     int32
+}
+
+func (q *Queries) UpdateSeasonFormat(ctx context.Context, arg UpdateSeasonFormatParams) error {
+	_, err := q.db.Exec(ctx, updateSeasonFormat, arg.Format, arg.ID)
+	return err
+}
+
+const updateSeasonMemberRole = `-- name: UpdateSeasonMemberRole :one
+UPDATE season_members
+SET role = $1,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $2 AND seasonId = $3
+RETURNING id, seasonid, email, role, invitedby, createdat, updatedat
+`
+
+type UpdateSeasonMemberRoleParams struct {
+	Role     string
+	ID       int32
+	Seasonid int32
+}
+
+func (q *Queries) UpdateSeasonMemberRole(ctx context.Context, arg UpdateSeasonMemberRoleParams) (SeasonMember, error) {
+	row := q.db.QueryRow(ctx, updateSeasonMemberRole, arg.Role, arg.ID, arg.Seasonid)
+	var i SeasonMember
+	err := row.Scan(
+		&i.ID,
+		&i.Seasonid,
+		&i.Email,
+		&i.Role,
+		&i.Invitedby,
+		&i.Createdat,
+		&i.Updatedat,
 	)
 	return i, err
 }
@@ -875,12 +2699,45 @@ func (q *Queries) UpdateUserAppSettings(ctx context.Context, arg UpdateUserAppSe
 
 const updateUserPassword = `-- name: UpdateUserPassword :exec
 UPDATE users
-SET updatedAt = CURRENT_TIMESTAMP
-WHERE email = $1
+SET passwordChangedAt = CURRENT_TIMESTAMP,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE stytchId = $1
+`
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, stytchid string) error {
+	_, err := q.db.Exec(ctx, updateUserPassword, stytchid)
+	return err
+}
+
+const updateUserStripeSubscription = `-- name: UpdateUserStripeSubscription :exec
+UPDATE users
+SET subscriptionTier = $1,
+    stripeSubscriptionId = $2,
+    subscriptionStatus = $3,
+    subscriptionCurrentPeriodEnd = $4,
+    cancelAtPeriodEnd = $5,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $6
 `
 
-func (q *Queries) UpdateUserPassword(ctx context.Context, email string) error {
-	_, err := q.db.Exec(ctx, updateUserPassword, email)
+type UpdateUserStripeSubscriptionParams struct {
+	Subscriptiontier             string
+	Stripesubscriptionid         pgtype.Text
+	Subscriptionstatus           pgtype.Text
+	Subscriptioncurrentperiodend pgtype.Timestamp
+	Cancelatperiodend            bool
+	ID                           int32
+}
+
+func (q *Queries) UpdateUserStripeSubscription(ctx context.Context, arg UpdateUserStripeSubscriptionParams) error {
+	_, err := q.db.Exec(ctx, updateUserStripeSubscription,
+		arg.Subscriptiontier,
+		arg.Stripesubscriptionid,
+		arg.Subscriptionstatus,
+		arg.Subscriptioncurrentperiodend,
+		arg.Cancelatperiodend,
+		arg.ID,
+	)
 	return err
 }
 
@@ -901,6 +2758,56 @@ func (q *Queries) UpdateUserUserSettings(ctx context.Context, arg UpdateUserUser
 	return err
 }
 
+const upsertMatchCustomValue = `-- name: UpsertMatchCustomValue :one
+INSERT INTO match_custom_values (match_id, column_id, value)
+VALUES ($1, $2, $3)
+ON CONFLICT(match_id, column_id) DO UPDATE SET
+    value = excluded.value,
+    updatedAt = CURRENT_TIMESTAMP
+RETURNING id, match_id, column_id, value, createdat, updatedat
+`
+
+type UpsertMatchCustomValueParams struct {
+	MatchID  pgtype.Int4
+	ColumnID pgtype.Int4
+	Value    pgtype.Text
+}
+
+func (q *Queries) UpsertMatchCustomValue(ctx context.Context, arg UpsertMatchCustomValueParams) (MatchCustomValue, error) {
+	row := q.db.QueryRow(ctx, upsertMatchCustomValue, arg.MatchID, arg.ColumnID, arg.Value)
+	var i MatchCustomValue
+	err := row.Scan(
+		&i.ID,
+		&i.MatchID,
+		&i.ColumnID,
+		&i.Value,
+		&i.Createdat,
+		&i.Updatedat,
+	)
+	return i, err
+}
+
+const upsertPlayerCalendarFeed = `-- name: UpsertPlayerCalendarFeed :one
+INSERT INTO player_calendar_feeds (playerId, tokenHash)
+VALUES ($1, $2)
+ON CONFLICT (playerId) DO UPDATE SET
+    tokenHash = excluded.tokenHash,
+    createdAt = CURRENT_TIMESTAMP
+RETURNING playerid, tokenhash, createdat
+`
+
+type UpsertPlayerCalendarFeedParams struct {
+	Playerid  int32
+	Tokenhash string
+}
+
+func (q *Queries) UpsertPlayerCalendarFeed(ctx context.Context, arg UpsertPlayerCalendarFeedParams) (PlayerCalendarFeed, error) {
+	row := q.db.QueryRow(ctx, upsertPlayerCalendarFeed, arg.Playerid, arg.Tokenhash)
+	var i PlayerCalendarFeed
+	err := row.Scan(&i.Playerid, &i.Tokenhash, &i.Createdat)
+	return i, err
+}
+
 const upsertPlayerCustomValue = `-- name: UpsertPlayerCustomValue :one
 INSERT INTO player_custom_values (player_id, column_id, value)
 VALUES ($1, $2, $3)
@@ -929,3 +2836,65 @@ func (q *Queries) UpsertPlayerCustomValue(ctx context.Context, arg UpsertPlayerC
 	)
 	return i, err
 }
+
+const upsertUserSession = `-- name: UpsertUserSession :exec
+INSERT INTO user_sessions (sessionId, userId, ipAddress, userAgent)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (sessionId) DO UPDATE
+SET ipAddress = EXCLUDED.ipAddress,
+    userAgent = EXCLUDED.userAgent,
+    lastSeenAt = CURRENT_TIMESTAMP
+`
+
+type UpsertUserSessionParams struct {
+	Sessionid string
+	Userid    int32
+	Ipaddress pgtype.Text
+	Useragent pgtype.Text
+}
+
+func (q *Queries) UpsertUserSession(ctx context.Context, arg UpsertUserSessionParams) error {
+	_, err := q.db.Exec(ctx, upsertUserSession,
+		arg.Sessionid,
+		arg.Userid,
+		arg.Ipaddress,
+		arg.Useragent,
+	)
+	return err
+}
+
+const verifyUserByStytchId = `-- name: VerifyUserByStytchId :one
+UPDATE users
+SET isVerified = true,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE stytchId = $1
+RETURNING id, stytchid, stripeid, name, email, phone, country, birthday, lang, createdat, updatedat, isactive, isverified, subscriptiontier, jsonsettings, stripesubscriptionid, subscriptionstatus, subscriptioncurrentperiodend, passwordchangedat, cancelatperiodend
+`
+
+func (q *Queries) VerifyUserByStytchId(ctx context.Context, stytchid string) (User, error) {
+	row := q.db.QueryRow(ctx, verifyUserByStytchId, stytchid)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Stytchid,
+		&i.Stripeid,
+		&i.Name,
+		&i.Email,
+		&i.Phone,
+		&i.Country,
+		&i.Birthday,
+		&i.Lang,
+		&i.Createdat,
+		&i.Updatedat,
+		&i.Isactive,
+		&i.Isverified,
+		&i.Subscriptiontier,
+		&i.Jsonsettings,
+		&i.Stripesubscriptionid,
+		&i.Subscriptionstatus,
+		&i.Subscriptioncurrentperiodend,
+		&i.Passwordchangedat,
+		&i.Cancelatperiodend,
+	)
+	return i, err
+}
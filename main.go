@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/gameplan-backend/api"
 	"github.com/gameplan-backend/api_server"
 	"github.com/gameplan-backend/db"
+	"github.com/gameplan-backend/migrations"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/mailgun/mailgun-go/v4"
 
@@ -21,7 +24,17 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(os.Args[2:]); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	e := echo.New()
+	// Errors from handlers, middlewares and routing are rendered as an ApiResult
+	e.HTTPErrorHandler = api.HTTPErrorHandler
 
 	// Middleware
 	e.Use(middleware.Logger())
@@ -36,8 +49,13 @@ func main() {
 		e.Logger.Fatal("OpenAPI spec validation failed:", err)
 	}
 
-	// Add OpenAPI validator middleware
-	e.Use(echomiddleware.OapiRequestValidator(swagger))
+	// Add OpenAPI validator middleware. The Stripe webhook is skipped: its
+	// signature covers the raw body, which is checked by the handler instead.
+	e.Use(echomiddleware.OapiRequestValidatorWithOptions(swagger, &echomiddleware.Options{
+		Skipper: func(c echo.Context) bool {
+			return c.Path() == "/webhooks/stripe"
+		},
+	}))
 
 	// Initialize Stytch client
 	stytchProjectID := os.Getenv("STYTCH_PROJECT_ID")
@@ -74,44 +92,95 @@ func main() {
 	}
 	dbQueries := db.New(dbPool)
 
+	// Refuse to serve with a schema older than the queries expect
+	migrator, err := migrations.New(dbPool)
+	if err != nil {
+		panic(err)
+	}
+	if err := migrator.Check(context.Background()); err != nil {
+		panic(fmt.Sprintf("%v, run gameplan migrate up", err))
+	}
+
 	// Initialize Stripe client
 	stripeKey := os.Getenv("STRIPE_SECRET_KEY")
 	if stripeKey == "" {
 		panic("STRIPE_SECRET_KEY environment variable must be set")
 	}
 	stripeClient := client.New(stripeKey, nil)
+	stripeWebhookSecret := os.Getenv("STRIPE_WEBHOOK_SECRET")
+	stripeProPriceID := os.Getenv("STRIPE_PRO_PRICE_ID")
+	if stripeWebhookSecret == "" || stripeProPriceID == "" {
+		panic("STRIPE_WEBHOOK_SECRET and STRIPE_PRO_PRICE_ID environment variables must be set")
+	}
+
+	appURL := os.Getenv("APP_URL")
+	if appURL == "" {
+		panic("APP_URL environment variable must be set")
+	}
+
+	// Optional, calendar feed and public links are relative to the API without it
+	apiURL := strings.TrimSuffix(os.Getenv("API_URL"), "/")
+
+	shareLinkSecret := os.Getenv("SHARE_LINK_SECRET")
+	if len(shareLinkSecret) < 32 {
+		panic("SHARE_LINK_SECRET environment variable must be set to at least 32 characters")
+	}
+
+	// Season events are relayed between instances through Postgres
+	seasonEvents, err := api_server.NewSeasonEvents(dbPool, e.Logger)
+	if err != nil {
+		panic(err)
+	}
 
 	// Initialize all API servers with shared dependencies
+	// Authenticated tokens are trusted for a short while without asking Stytch
+	sessionCache := api.NewSessionCache(30 * time.Second)
+
 	authServer := &api_server.AuthServer{
 		StytchClient: stytchClient,
 		StripeClient: stripeClient,
 		DB:           dbQueries,
 		Emailer:      mg,
+		MagicLinks:   api_server.StytchMagicLinks{Client: stytchClient},
+		AppURL:       strings.TrimSuffix(appURL, "/"),
+		Sessions:     sessionCache,
+		Logger:       e.Logger,
 	}
 
 	matchesServer := &api_server.MatchesServer{
 		StytchClient: stytchClient,
 		StripeClient: stripeClient,
 		DB:           dbQueries,
+		DBPool:       dbPool,
 		Emailer:      mg,
+		Events:       seasonEvents,
 	}
 
 	playersServer := &api_server.PlayersServer{
-		DB: dbQueries,
+		DB:     dbQueries,
+		DBPool: dbPool,
+		APIURL: apiURL,
 	}
 
 	seasonsServer := &api_server.SeasonsServer{
-		DB: dbQueries,
+		DB:              dbQueries,
+		DBPool:          dbPool,
+		ShareLinkSecret: []byte(shareLinkSecret),
+		APIURL:          apiURL,
+		Events:          seasonEvents,
 	}
+	seasonEvents.Scoreboard = seasonsServer.SeasonScoreboard
+	go seasonEvents.Listen(context.Background())
 
 	subscriptionsServer := &api_server.SubscriptionsServer{
-		StripeClient: stripeClient,
-		DB:           dbQueries,
+		StripeClient:  stripeClient,
+		DB:            dbQueries,
+		DBPool:        dbPool,
+		WebhookSecret: stripeWebhookSecret,
+		ProPriceID:    stripeProPriceID,
+		AppURL:        strings.TrimSuffix(appURL, "/"),
 	}
 
-	// Authentication middleware
-	e.Use(api.AuthMiddleware(stytchClient))
-
 	myApi := api_server.MyApiServer{
 		StytchClient:        stytchClient,
 		StripeClient:        stripeClient,
@@ -123,8 +192,13 @@ func main() {
 		SeasonsServer:       seasonsServer,
 		MatchesServer:       matchesServer,
 	}
-	// Register the strict handlers generated by oapi-codegen
-	strictHandler := api.NewStrictHandler(myApi, nil)
+	// Register the strict handlers generated by oapi-codegen. Authentication runs
+	// as a strict middleware so it can see the security scopes of each operation.
+	// Strict middlewares wrap in reverse order: the last one runs first.
+	strictHandler := api.NewStrictHandler(myApi, []api.StrictMiddlewareFunc{
+		api.AuthorizationMiddleware(dbQueries),
+		api.AuthMiddleware(stytchClient, dbQueries, sessionCache),
+	})
 	api.RegisterHandlers(e, strictHandler)
 
 	// Start server
@@ -0,0 +1,8 @@
+DROP TABLE match_custom_values;
+DROP TABLE match_custom_columns;
+DROP TABLE player_custom_values;
+DROP TABLE player_custom_columns;
+DROP TABLE matches;
+DROP TABLE seasons;
+DROP TABLE players;
+DROP TABLE users;
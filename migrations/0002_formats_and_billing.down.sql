@@ -0,0 +1,12 @@
+DROP TABLE stripe_events;
+DROP TABLE bracket_matches;
+
+ALTER TABLE seasons DROP COLUMN tiebreakers;
+ALTER TABLE seasons DROP COLUMN pointsPerLoss;
+ALTER TABLE seasons DROP COLUMN pointsPerDraw;
+ALTER TABLE seasons DROP COLUMN pointsPerWin;
+ALTER TABLE seasons DROP COLUMN format;
+
+ALTER TABLE users DROP COLUMN subscriptionCurrentPeriodEnd;
+ALTER TABLE users DROP COLUMN subscriptionStatus;
+ALTER TABLE users DROP COLUMN stripeSubscriptionId;
@@ -0,0 +1,40 @@
+-- Schema added before migrations existed but after the baseline: season
+-- formats and their brackets, the points and tiebreakers of standings, and
+-- the Stripe subscription of users with the webhook events already applied.
+-- A database created from schema.sql in between may already have it, hence
+-- IF NOT EXISTS.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS stripeSubscriptionId varchar(255);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS subscriptionStatus varchar(20);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS subscriptionCurrentPeriodEnd timestamp;
+
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS format varchar(20) NOT NULL DEFAULT 'league' CHECK (
+    format IN (
+        'league',
+        'single_elimination',
+        'double_elimination'
+    )
+);
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS pointsPerWin integer NOT NULL DEFAULT 3;
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS pointsPerDraw integer NOT NULL DEFAULT 1;
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS pointsPerLoss integer NOT NULL DEFAULT 0;
+ALTER TABLE seasons ADD COLUMN IF NOT EXISTS tiebreakers text[] NOT NULL DEFAULT '{head_to_head,point_differential,points_for}';
+
+CREATE TABLE IF NOT EXISTS bracket_matches (
+    id SERIAL PRIMARY KEY,
+    seasonId integer NOT NULL REFERENCES seasons (id),
+    matchId integer NOT NULL REFERENCES matches (id) ON DELETE CASCADE,
+    bracket varchar(10) NOT NULL CHECK (bracket IN ('winners', 'losers', 'final')),
+    round integer NOT NULL,
+    position integer NOT NULL,
+    winnerNextMatchId integer REFERENCES matches (id) ON DELETE SET NULL,
+    winnerNextSlot integer CHECK (winnerNextSlot IN (1, 2)),
+    loserNextMatchId integer REFERENCES matches (id) ON DELETE SET NULL,
+    loserNextSlot integer CHECK (loserNextSlot IN (1, 2)),
+    UNIQUE (matchId)
+);
+
+CREATE TABLE IF NOT EXISTS stripe_events (
+    id varchar(255) PRIMARY KEY,
+    type varchar(100) NOT NULL,
+    createdAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
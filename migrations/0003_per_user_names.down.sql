@@ -0,0 +1,47 @@
+-- The copies of a custom column are merged back into the oldest one. Fails if
+-- two users have a player or a season with the same name.
+UPDATE match_custom_values v
+SET column_id = keep.id
+FROM match_custom_columns c, (
+    SELECT name, MIN(id) AS id FROM match_custom_columns GROUP BY name
+) keep
+WHERE v.column_id = c.id AND keep.name = c.name AND keep.id <> c.id
+    AND NOT EXISTS (
+        SELECT 1 FROM match_custom_values other
+        WHERE other.match_id = v.match_id AND other.column_id = keep.id
+    );
+DELETE FROM match_custom_values v
+USING match_custom_columns c
+WHERE v.column_id = c.id AND c.id <> (SELECT MIN(id) FROM match_custom_columns WHERE name = c.name);
+DELETE FROM match_custom_columns c
+WHERE c.id <> (SELECT MIN(id) FROM match_custom_columns WHERE name = c.name);
+
+ALTER TABLE match_custom_columns DROP CONSTRAINT match_custom_columns_userid_name_key;
+ALTER TABLE match_custom_columns DROP COLUMN userId;
+ALTER TABLE match_custom_columns ADD CONSTRAINT match_custom_columns_name_key UNIQUE (name);
+
+UPDATE player_custom_values v
+SET column_id = keep.id
+FROM player_custom_columns c, (
+    SELECT name, MIN(id) AS id FROM player_custom_columns GROUP BY name
+) keep
+WHERE v.column_id = c.id AND keep.name = c.name AND keep.id <> c.id
+    AND NOT EXISTS (
+        SELECT 1 FROM player_custom_values other
+        WHERE other.player_id = v.player_id AND other.column_id = keep.id
+    );
+DELETE FROM player_custom_values v
+USING player_custom_columns c
+WHERE v.column_id = c.id AND c.id <> (SELECT MIN(id) FROM player_custom_columns WHERE name = c.name);
+DELETE FROM player_custom_columns c
+WHERE c.id <> (SELECT MIN(id) FROM player_custom_columns WHERE name = c.name);
+
+ALTER TABLE player_custom_columns DROP CONSTRAINT player_custom_columns_userid_name_key;
+ALTER TABLE player_custom_columns DROP COLUMN userId;
+ALTER TABLE player_custom_columns ADD CONSTRAINT player_custom_columns_name_key UNIQUE (name);
+
+ALTER TABLE seasons DROP CONSTRAINT seasons_userid_name_key;
+ALTER TABLE seasons ADD CONSTRAINT seasons_name_key UNIQUE (name);
+
+ALTER TABLE players DROP CONSTRAINT players_userid_name_key;
+ALTER TABLE players ADD CONSTRAINT players_name_key UNIQUE (name);
@@ -0,0 +1,65 @@
+-- Names are unique per organizer instead of across every organizer
+ALTER TABLE players DROP CONSTRAINT players_name_key;
+ALTER TABLE players ADD CONSTRAINT players_userid_name_key UNIQUE (userId, name);
+
+ALTER TABLE seasons DROP CONSTRAINT seasons_name_key;
+ALTER TABLE seasons ADD CONSTRAINT seasons_userid_name_key UNIQUE (userId, name);
+
+-- Custom columns were shared by every user: each user gets a copy of every
+-- column and the values are moved to the copy of the user owning them
+ALTER TABLE player_custom_columns DROP CONSTRAINT player_custom_columns_name_key;
+ALTER TABLE player_custom_columns ADD COLUMN userId INTEGER REFERENCES users (id);
+
+INSERT INTO player_custom_columns (
+    userId, name, field_type, description, is_required, is_active, display_order, createdAt, updatedAt
+)
+SELECT u.id, c.name, c.field_type, c.description, c.is_required, c.is_active, c.display_order, c.createdAt, c.updatedAt
+FROM player_custom_columns c
+CROSS JOIN users u
+WHERE c.userId IS NULL;
+
+UPDATE player_custom_values v
+SET column_id = copy.id
+FROM player_custom_columns shared, players p, player_custom_columns copy
+WHERE v.column_id = shared.id
+    AND shared.userId IS NULL
+    AND p.id = v.player_id
+    AND copy.userId = p.userId
+    AND copy.name = shared.name;
+
+DELETE FROM player_custom_values v
+USING player_custom_columns c
+WHERE v.column_id = c.id AND c.userId IS NULL;
+DELETE FROM player_custom_columns WHERE userId IS NULL;
+
+ALTER TABLE player_custom_columns ALTER COLUMN userId SET NOT NULL;
+ALTER TABLE player_custom_columns ADD CONSTRAINT player_custom_columns_userid_name_key UNIQUE (userId, name);
+
+ALTER TABLE match_custom_columns DROP CONSTRAINT match_custom_columns_name_key;
+ALTER TABLE match_custom_columns ADD COLUMN userId INTEGER REFERENCES users (id);
+
+INSERT INTO match_custom_columns (
+    userId, name, field_type, description, is_required, is_active, display_order, createdAt, updatedAt
+)
+SELECT u.id, c.name, c.field_type, c.description, c.is_required, c.is_active, c.display_order, c.createdAt, c.updatedAt
+FROM match_custom_columns c
+CROSS JOIN users u
+WHERE c.userId IS NULL;
+
+UPDATE match_custom_values v
+SET column_id = copy.id
+FROM match_custom_columns shared, matches m, seasons s, match_custom_columns copy
+WHERE v.column_id = shared.id
+    AND shared.userId IS NULL
+    AND m.id = v.match_id
+    AND s.id = m.seasonId
+    AND copy.userId = s.userId
+    AND copy.name = shared.name;
+
+DELETE FROM match_custom_values v
+USING match_custom_columns c
+WHERE v.column_id = c.id AND c.userId IS NULL;
+DELETE FROM match_custom_columns WHERE userId IS NULL;
+
+ALTER TABLE match_custom_columns ALTER COLUMN userId SET NOT NULL;
+ALTER TABLE match_custom_columns ADD CONSTRAINT match_custom_columns_userid_name_key UNIQUE (userId, name);
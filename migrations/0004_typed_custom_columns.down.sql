@@ -0,0 +1,11 @@
+ALTER TABLE player_custom_columns DROP CONSTRAINT player_custom_columns_field_type_check;
+UPDATE player_custom_columns SET field_type = 'number' WHERE field_type IN ('integer', 'decimal');
+UPDATE player_custom_columns SET field_type = 'select' WHERE field_type IN ('single_select', 'multi_select');
+UPDATE player_custom_columns SET field_type = 'text' WHERE field_type IN ('email', 'phone');
+ALTER TABLE player_custom_columns DROP COLUMN options;
+
+ALTER TABLE match_custom_columns DROP CONSTRAINT match_custom_columns_field_type_check;
+UPDATE match_custom_columns SET field_type = 'number' WHERE field_type IN ('integer', 'decimal');
+UPDATE match_custom_columns SET field_type = 'select' WHERE field_type IN ('single_select', 'multi_select');
+UPDATE match_custom_columns SET field_type = 'text' WHERE field_type IN ('email', 'phone');
+ALTER TABLE match_custom_columns DROP COLUMN options;
@@ -0,0 +1,35 @@
+-- Custom columns get a fixed set of field types, select types declare their
+-- options. Existing select columns get the values already in use as options.
+ALTER TABLE player_custom_columns ADD COLUMN options text[] NOT NULL DEFAULT '{}';
+
+UPDATE player_custom_columns SET field_type = 'decimal' WHERE field_type = 'number';
+UPDATE player_custom_columns c
+SET field_type = 'single_select',
+    options = COALESCE((
+        SELECT array_agg(DISTINCT v.value ORDER BY v.value) FROM player_custom_values v
+        WHERE v.column_id = c.id AND v.value IS NOT NULL AND v.value <> ''
+    ), '{}')
+WHERE field_type = 'select';
+UPDATE player_custom_columns SET field_type = 'text'
+WHERE field_type NOT IN ('text', 'integer', 'decimal', 'boolean', 'date', 'email', 'phone', 'single_select', 'multi_select');
+
+ALTER TABLE player_custom_columns ADD CONSTRAINT player_custom_columns_field_type_check CHECK (
+    field_type IN ('text', 'integer', 'decimal', 'boolean', 'date', 'email', 'phone', 'single_select', 'multi_select')
+);
+
+ALTER TABLE match_custom_columns ADD COLUMN options text[] NOT NULL DEFAULT '{}';
+
+UPDATE match_custom_columns SET field_type = 'decimal' WHERE field_type = 'number';
+UPDATE match_custom_columns c
+SET field_type = 'single_select',
+    options = COALESCE((
+        SELECT array_agg(DISTINCT v.value ORDER BY v.value) FROM match_custom_values v
+        WHERE v.column_id = c.id AND v.value IS NOT NULL AND v.value <> ''
+    ), '{}')
+WHERE field_type = 'select';
+UPDATE match_custom_columns SET field_type = 'text'
+WHERE field_type NOT IN ('text', 'integer', 'decimal', 'boolean', 'date', 'email', 'phone', 'single_select', 'multi_select');
+
+ALTER TABLE match_custom_columns ADD CONSTRAINT match_custom_columns_field_type_check CHECK (
+    field_type IN ('text', 'integer', 'decimal', 'boolean', 'date', 'email', 'phone', 'single_select', 'multi_select')
+);
@@ -0,0 +1,10 @@
+DROP TRIGGER matches_calendar_delete ON matches;
+DROP TRIGGER matches_calendar_update ON matches;
+DROP FUNCTION track_match_calendar();
+DROP FUNCTION cancel_player_match(integer, matches);
+
+DROP TABLE player_calendar_cancellations;
+
+ALTER TABLE matches DROP COLUMN calendarSequence;
+
+DROP TABLE player_calendar_feeds;
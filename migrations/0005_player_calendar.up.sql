@@ -0,0 +1,73 @@
+-- Players can subscribe to their matches as an iCalendar feed at a URL holding
+-- the token of player_calendar_feeds. The feed needs to tell calendars about
+-- matches that changed or went away: calendarSequence counts the revisions of
+-- a match, and player_calendar_cancellations remembers the matches a player
+-- was taken off.
+CREATE TABLE player_calendar_feeds (
+    playerId integer PRIMARY KEY REFERENCES players (id) ON DELETE CASCADE,
+    token varchar(64) NOT NULL UNIQUE,
+    createdAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+ALTER TABLE matches ADD COLUMN calendarSequence integer NOT NULL DEFAULT 0;
+
+CREATE TABLE player_calendar_cancellations (
+    playerId integer NOT NULL,
+    matchId integer NOT NULL,
+    seasonId integer,
+    matchDate date NOT NULL,
+    "group" integer NOT NULL,
+    calendarSequence integer NOT NULL,
+    cancelledAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (playerId, matchId)
+);
+
+CREATE FUNCTION cancel_player_match(player integer, match matches) RETURNS void AS $$
+BEGIN
+    IF player IS NULL THEN
+        RETURN;
+    END IF;
+    INSERT INTO player_calendar_cancellations (playerId, matchId, seasonId, matchDate, "group", calendarSequence)
+    VALUES (player, match.id, match.seasonId, match.matchDate, match."group", match.calendarSequence + 1)
+    ON CONFLICT (playerId, matchId) DO UPDATE SET
+        seasonId = excluded.seasonId,
+        matchDate = excluded.matchDate,
+        "group" = excluded."group",
+        calendarSequence = excluded.calendarSequence,
+        cancelledAt = CURRENT_TIMESTAMP;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION track_match_calendar() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM cancel_player_match(OLD.playerId1, OLD);
+        PERFORM cancel_player_match(OLD.playerId2, OLD);
+        RETURN OLD;
+    END IF;
+
+    IF ROW(NEW.matchDate, NEW."group", NEW.playerId1, NEW.playerId2, NEW.playerId1Points, NEW.playerId2Points, NEW.winnerId, NEW.isActive)
+        IS DISTINCT FROM
+        ROW(OLD.matchDate, OLD."group", OLD.playerId1, OLD.playerId2, OLD.playerId1Points, OLD.playerId2Points, OLD.winnerId, OLD.isActive) THEN
+        NEW.calendarSequence := OLD.calendarSequence + 1;
+    END IF;
+
+    IF OLD.playerId1 IS DISTINCT FROM NEW.playerId1 AND OLD.playerId1 IS DISTINCT FROM NEW.playerId2 THEN
+        PERFORM cancel_player_match(OLD.playerId1, OLD);
+    END IF;
+    IF OLD.playerId2 IS DISTINCT FROM NEW.playerId1 AND OLD.playerId2 IS DISTINCT FROM NEW.playerId2 THEN
+        PERFORM cancel_player_match(OLD.playerId2, OLD);
+    END IF;
+
+    -- A player put back on the match gets the event again
+    DELETE FROM player_calendar_cancellations
+    WHERE matchId = NEW.id AND playerId IN (NEW.playerId1, NEW.playerId2);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER matches_calendar_update BEFORE UPDATE ON matches
+FOR EACH ROW EXECUTE FUNCTION track_match_calendar();
+
+CREATE TRIGGER matches_calendar_delete AFTER DELETE ON matches
+FOR EACH ROW EXECUTE FUNCTION track_match_calendar();
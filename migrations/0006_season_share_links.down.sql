@@ -0,0 +1 @@
+DROP TABLE season_share_links;
@@ -0,0 +1,12 @@
+-- Public schedule links share a season read-only through a random token. Only
+-- its HMAC is stored, so the tokens cannot be read back from the database.
+CREATE TABLE season_share_links (
+    id SERIAL PRIMARY KEY,
+    seasonId integer NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+    tokenHash varchar(64) NOT NULL UNIQUE,
+    expiresAt timestamp,
+    revokedAt timestamp,
+    createdAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX season_share_links_season_id_idx ON season_share_links (seasonId);
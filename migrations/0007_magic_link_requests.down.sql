@@ -0,0 +1 @@
+DROP TABLE magic_link_requests;
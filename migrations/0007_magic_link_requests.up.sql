@@ -0,0 +1,8 @@
+-- Magic link emails sent to each address, kept for the rate limit
+CREATE TABLE magic_link_requests (
+    id SERIAL PRIMARY KEY,
+    email varchar(255) NOT NULL,
+    requestedAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX magic_link_requests_email_idx ON magic_link_requests (email, requestedAt);
@@ -0,0 +1,2 @@
+-- When the password was last reset or changed, null when never since sign up
+ALTER TABLE users ADD COLUMN passwordChangedAt timestamp;
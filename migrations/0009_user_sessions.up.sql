@@ -0,0 +1,12 @@
+-- The device and address Stytch sessions are used from, recorded by
+-- AuthMiddleware. The sessions themselves live in Stytch.
+CREATE TABLE user_sessions (
+    sessionId varchar(64) PRIMARY KEY,
+    userId integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    ipAddress varchar(45),
+    userAgent text,
+    createdAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    lastSeenAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX user_sessions_user_id_idx ON user_sessions (userId);
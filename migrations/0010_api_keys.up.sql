@@ -0,0 +1,16 @@
+-- Personal API keys authenticate scripts through the X-API-Key header. Only
+-- the SHA-256 of a key is stored; its prefix is kept to tell keys apart.
+CREATE TABLE api_keys (
+    id SERIAL PRIMARY KEY,
+    userId integer NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+    name varchar(100) NOT NULL,
+    prefix varchar(16) NOT NULL,
+    keyHash varchar(64) NOT NULL UNIQUE,
+    scopes text[] NOT NULL,
+    expiresAt timestamp,
+    lastUsedAt timestamp,
+    revokedAt timestamp,
+    createdAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX api_keys_user_id_idx ON api_keys (userId);
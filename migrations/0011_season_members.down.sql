@@ -0,0 +1,3 @@
+DROP VIEW season_access;
+DROP INDEX users_lower_email_idx;
+DROP TABLE season_members;
@@ -0,0 +1,28 @@
+-- Co-organizers of a season, invited by email by its owner (seasons.userId).
+-- An invitation grants its role to the account using that email once the
+-- email is verified, so it can be sent before the account exists.
+CREATE TABLE season_members (
+    id SERIAL PRIMARY KEY,
+    seasonId integer NOT NULL REFERENCES seasons (id) ON DELETE CASCADE,
+    email varchar(255) NOT NULL,
+    role varchar(20) NOT NULL CHECK (role IN ('admin', 'scorekeeper', 'viewer')),
+    invitedBy integer REFERENCES users (id) ON DELETE SET NULL,
+    createdAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updatedAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (seasonId, email)
+);
+
+CREATE INDEX season_members_email_idx ON season_members (email);
+CREATE INDEX users_lower_email_idx ON users (lower(email));
+
+-- Every user with access to a season and their role, the owner included.
+-- Member emails are stored in lower case.
+CREATE VIEW season_access AS
+SELECT s.id AS seasonId, s.userId, 'owner'::varchar(20) AS role
+FROM seasons s
+WHERE s.userId IS NOT NULL
+UNION ALL
+SELECT m.seasonId, u.id AS userId, m.role
+FROM season_members m
+JOIN users u ON lower(u.email) = m.email
+WHERE u.isVerified AND u.isActive;
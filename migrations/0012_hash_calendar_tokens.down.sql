@@ -0,0 +1,4 @@
+-- Hashed tokens cannot be recovered, the feeds are revoked
+DELETE FROM player_calendar_feeds;
+
+ALTER TABLE player_calendar_feeds RENAME COLUMN tokenHash TO token;
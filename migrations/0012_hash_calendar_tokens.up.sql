@@ -0,0 +1,7 @@
+-- Calendar feed tokens are stored as their SHA-256, like API keys, so the
+-- database does not hold the credential of any feed. Existing tokens are
+-- hashed in place and their URLs keep working.
+ALTER TABLE player_calendar_feeds RENAME COLUMN token TO tokenHash;
+
+UPDATE player_calendar_feeds
+SET tokenHash = encode(sha256(convert_to(tokenHash, 'UTF8')), 'hex');
@@ -0,0 +1,9 @@
+CREATE OR REPLACE VIEW season_access AS
+SELECT s.id AS seasonId, s.userId, 'owner'::varchar(20) AS role
+FROM seasons s
+WHERE s.userId IS NOT NULL
+UNION ALL
+SELECT m.seasonId, u.id AS userId, m.role
+FROM season_members m
+JOIN users u ON lower(u.email) = m.email
+WHERE u.isVerified AND u.isActive;
@@ -0,0 +1,13 @@
+-- An owner whose email was also invited to their season had two rows in
+-- season_access, which made their role depend on the row read first. Owners
+-- keep the owner role only.
+CREATE OR REPLACE VIEW season_access AS
+SELECT s.id AS seasonId, s.userId, 'owner'::varchar(20) AS role
+FROM seasons s
+WHERE s.userId IS NOT NULL
+UNION ALL
+SELECT m.seasonId, u.id AS userId, m.role
+FROM season_members m
+JOIN seasons s ON s.id = m.seasonId
+JOIN users u ON lower(u.email) = m.email
+WHERE u.isVerified AND u.isActive AND s.userId IS DISTINCT FROM u.id;
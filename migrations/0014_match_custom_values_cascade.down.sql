@@ -0,0 +1,3 @@
+ALTER TABLE match_custom_values DROP CONSTRAINT match_custom_values_match_id_fkey;
+ALTER TABLE match_custom_values ADD CONSTRAINT match_custom_values_match_id_fkey
+    FOREIGN KEY (match_id) REFERENCES matches (id);
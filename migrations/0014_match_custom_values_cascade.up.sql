@@ -0,0 +1,5 @@
+-- Custom values go with their match, deleting a match that has some no longer
+-- fails on the foreign key
+ALTER TABLE match_custom_values DROP CONSTRAINT match_custom_values_match_id_fkey;
+ALTER TABLE match_custom_values ADD CONSTRAINT match_custom_values_match_id_fkey
+    FOREIGN KEY (match_id) REFERENCES matches (id) ON DELETE CASCADE;
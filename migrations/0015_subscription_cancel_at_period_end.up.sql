@@ -0,0 +1,3 @@
+-- Set once the user cancels, the subscription then stays active until the end
+-- of the period it was paid for
+ALTER TABLE users ADD COLUMN cancelAtPeriodEnd boolean NOT NULL DEFAULT false;
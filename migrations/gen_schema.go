@@ -0,0 +1,27 @@
+//go:build ignore
+
+// gen_schema writes ../schema.sql from the up migrations, run it with
+// go generate ./migrations after adding a migration
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/gameplan-backend/migrations"
+)
+
+const header = `-- Code generated by go generate ./migrations from migrations/*.up.sql. DO NOT EDIT.
+-- Add a migration instead, see README.md.
+
+`
+
+func main() {
+	schema, err := migrations.Schema()
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("../schema.sql", []byte(header+schema), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
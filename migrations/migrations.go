@@ -0,0 +1,281 @@
+// Package migrations holds the numbered SQL migrations of the database and
+// applies them. Each version has a NNNN_name.up.sql and a NNNN_name.down.sql
+// file, both embedded in the binary. The applied versions are recorded in the
+// schema_migrations table.
+package migrations
+
+//go:generate go run gen_schema.go
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+//go:embed *.sql
+var files embed.FS
+
+// lockID serializes concurrent runs through a Postgres advisory lock
+const lockID = 7465232
+
+const createMigrationsTable = `CREATE TABLE IF NOT EXISTS schema_migrations (
+    version integer PRIMARY KEY,
+    name varchar(255) NOT NULL,
+    appliedAt timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP
+)`
+
+// Migration is one version of the schema
+type Migration struct {
+	Version int
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is a migration and when it was applied, nil if it is pending
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+// All returns the embedded migrations ordered by version
+func All() ([]Migration, error) {
+	names, err := fs.Glob(files, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[int]*Migration{}
+	for _, file := range names {
+		base, direction, ok := strings.Cut(strings.TrimSuffix(file, ".sql"), ".")
+		if !ok || (direction != "up" && direction != "down") {
+			return nil, fmt.Errorf("migration %s: expected NNNN_name.up.sql or NNNN_name.down.sql", file)
+		}
+		number, name, ok := strings.Cut(base, "_")
+		version, err := strconv.Atoi(number)
+		if !ok || err != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: expected a positive version before the name", file)
+		}
+		content, err := files.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name}
+			byVersion[version] = migration
+		}
+		if migration.Name != name {
+			return nil, fmt.Errorf("migration %d is named both %s and %s", version, migration.Name, name)
+		}
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d needs both an up and a down file", migration.Version)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Schema returns the up migrations one after another, the schema sqlc reads
+func Schema() (string, error) {
+	migrations, err := All()
+	if err != nil {
+		return "", err
+	}
+	var schema strings.Builder
+	for i, migration := range migrations {
+		if i > 0 {
+			schema.WriteString("\n")
+		}
+		fmt.Fprintf(&schema, "-- %s\n", fileName(migration, "up"))
+		schema.WriteString(strings.TrimSpace(migration.Up))
+		schema.WriteString("\n")
+	}
+	return schema.String(), nil
+}
+
+// Migrator applies the migrations to a database
+type Migrator struct {
+	Pool       *pgxpool.Pool
+	Migrations []Migration
+}
+
+// New returns a Migrator for the embedded migrations
+func New(pool *pgxpool.Pool) (*Migrator, error) {
+	migrations, err := All()
+	if err != nil {
+		return nil, err
+	}
+	return &Migrator{Pool: pool, Migrations: migrations}, nil
+}
+
+// Up applies every pending migration in order and returns them
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+	err := m.locked(ctx, func(conn *pgxpool.Conn, versions map[int]time.Time) error {
+		for _, migration := range m.Migrations {
+			if _, ok := versions[migration.Version]; ok {
+				continue
+			}
+			err := runInTx(ctx, conn, migration.Up, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, migration.Version, migration.Name)
+			if err != nil {
+				return fmt.Errorf("failed to apply %s: %w", fileName(migration, "up"), err)
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Down reverts the last steps applied migrations and returns them
+func (m *Migrator) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+	err := m.locked(ctx, func(conn *pgxpool.Conn, versions map[int]time.Time) error {
+		for i := len(m.Migrations) - 1; i >= 0 && len(reverted) < steps; i-- {
+			migration := m.Migrations[i]
+			if _, ok := versions[migration.Version]; !ok {
+				continue
+			}
+			err := runInTx(ctx, conn, migration.Down, `DELETE FROM schema_migrations WHERE version = $1`, migration.Version)
+			if err != nil {
+				return fmt.Errorf("failed to revert %s: %w", fileName(migration, "down"), err)
+			}
+			reverted = append(reverted, migration)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+// Baseline records the migrations up to version as applied without running
+// them, for a database created before migrations were tracked
+func (m *Migrator) Baseline(ctx context.Context, version int) error {
+	return m.locked(ctx, func(conn *pgxpool.Conn, versions map[int]time.Time) error {
+		if len(versions) > 0 {
+			return errors.New("the database already has applied migrations")
+		}
+		for _, migration := range m.Migrations {
+			if migration.Version > version {
+				break
+			}
+			_, err := conn.Exec(ctx, `INSERT INTO schema_migrations (version, name) VALUES ($1, $2)`, migration.Version, migration.Name)
+			if err != nil {
+				return fmt.Errorf("failed to record migration %d: %w", migration.Version, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Status reports every migration and whether it is applied
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	var statuses []Status
+	err := m.locked(ctx, func(conn *pgxpool.Conn, versions map[int]time.Time) error {
+		for _, migration := range m.Migrations {
+			status := Status{Migration: migration}
+			if appliedAt, ok := versions[migration.Version]; ok {
+				status.AppliedAt = &appliedAt
+			}
+			statuses = append(statuses, status)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+// Check returns an error naming the pending migrations, if any
+func (m *Migrator) Check(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+	var pending []string
+	for _, status := range statuses {
+		if status.AppliedAt == nil {
+			pending = append(pending, fileName(status.Migration, "up"))
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("the database schema is behind, pending migrations: %s", strings.Join(pending, ", "))
+	}
+	return nil
+}
+
+// locked runs fn on one connection holding the migrations lock, with the
+// versions applied so far
+func (m *Migrator) locked(ctx context.Context, fn func(conn *pgxpool.Conn, versions map[int]time.Time) error) error {
+	conn, err := m.Pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, `SELECT pg_advisory_lock($1)`, lockID); err != nil {
+		return fmt.Errorf("failed to lock migrations: %w", err)
+	}
+	defer conn.Exec(context.Background(), `SELECT pg_advisory_unlock($1)`, lockID)
+
+	if _, err := conn.Exec(ctx, createMigrationsTable); err != nil {
+		return fmt.Errorf("failed to create schema_migrations: %w", err)
+	}
+	rows, err := conn.Query(ctx, `SELECT version, appliedAt FROM schema_migrations`)
+	if err != nil {
+		return fmt.Errorf("failed to get applied migrations: %w", err)
+	}
+	versions := map[int]time.Time{}
+	var version int
+	var appliedAt time.Time
+	_, err = pgx.ForEachRow(rows, []any{&version, &appliedAt}, func() error {
+		versions[version] = appliedAt
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get applied migrations: %w", err)
+	}
+
+	return fn(conn, versions)
+}
+
+// runInTx runs a migration and the statement recording it in one transaction
+func runInTx(ctx context.Context, conn *pgxpool.Conn, migration string, record string, args ...any) error {
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, migration); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(ctx, record, args...); err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
+func fileName(migration Migration, direction string) string {
+	return fmt.Sprintf("%04d_%s.%s.sql", migration.Version, migration.Name, direction)
+}
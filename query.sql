@@ -5,6 +5,14 @@ SELECT id, email, isVerified
 FROM users
 WHERE email = $1;
 
+-- name: GetUserByStytchId :one
+SELECT * FROM users
+WHERE stytchId = $1;
+
+-- name: GetUserById :one
+SELECT * FROM users
+WHERE id = $1;
+
 -- name: CreateUser :one
 INSERT INTO users (
     stytchId, stripeId, name, email, phone, country, birthday, lang, isVerified
@@ -15,8 +23,9 @@ RETURNING *;
 
 -- name: UpdateUserPassword :exec
 UPDATE users
-SET updatedAt = CURRENT_TIMESTAMP
-WHERE email = $1;
+SET passwordChangedAt = CURRENT_TIMESTAMP,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE stytchId = $1;
 
 -- name: GetPlayers :many
 SELECT * FROM players
@@ -50,8 +59,10 @@ DELETE FROM players
 WHERE id = $1 AND userId = $2;
 
 -- name: GetSeasons :many
-SELECT * FROM seasons
-WHERE userId = $1 AND isActive = true;
+SELECT s.*, a.role FROM seasons s
+JOIN season_access a ON a.seasonId = s.id
+WHERE a.userId = $1 AND s.isActive = true
+ORDER BY s.id;
 
 -- name: CreateSeason :one
 INSERT INTO seasons (
@@ -63,7 +74,8 @@ RETURNING *;
 
 -- name: GetSeason :one
 SELECT * FROM seasons
-WHERE id = $1 AND userId = $2;
+WHERE id = $1
+  AND id IN (SELECT a.seasonId FROM season_access a WHERE a.userId = $2);
 
 -- name: UpdateSeason :one
 UPDATE seasons
@@ -72,14 +84,26 @@ SET name = $1,
     seasonType = $3,
     frequency = $4,
     isActive = $5,
+    pointsPerWin = $6,
+    pointsPerDraw = $7,
+    pointsPerLoss = $8,
+    tiebreakers = $9,
     updatedAt = CURRENT_TIMESTAMP
-WHERE id = $6 AND userId = $7
+WHERE id = $10
+  AND id IN (SELECT a.seasonId FROM season_access a WHERE a.userId = $11 AND a.role IN ('owner', 'admin'))
 RETURNING *;
 
 -- name: DeleteSeason :exec
 DELETE FROM seasons
 WHERE id = $1 AND userId = $2;
 
+-- name: DeleteUnplayedSeasonMatches :exec
+DELETE FROM matches
+WHERE seasonId = $1
+  AND winnerId IS NULL
+  AND playerId1Points = 0
+  AND playerId2Points = 0;
+
 -- name: CreateMatch :one
 INSERT INTO matches (
     seasonId, playerId1, playerId1Points, playerId2, playerId2Points, matchDate, winnerId, "group"
@@ -101,23 +125,46 @@ SET seasonId = $1,
     isActive = $9,
     updatedAt = CURRENT_TIMESTAMP
 WHERE id = $10
+  AND seasonId IN (SELECT a.seasonId FROM season_access a WHERE a.userId = $11 AND a.role IN ('owner', 'admin', 'scorekeeper'))
 RETURNING *;
 
 -- name: DeleteMatch :exec
 DELETE FROM matches
-WHERE id = $1;
+WHERE id = $1
+  AND seasonId IN (SELECT a.seasonId FROM season_access a WHERE a.userId = $2 AND a.role IN ('owner', 'admin'));
 
 -- name: GetMatch :one
 SELECT * FROM matches
+WHERE id = $1
+  AND seasonId IN (SELECT a.seasonId FROM season_access a WHERE a.userId = $2);
+
+-- name: GetMatchOwner :one
+SELECT s.userId
+FROM matches m
+JOIN seasons s ON s.id = m.seasonId
+WHERE m.id = $1;
+
+-- name: GetSeasonOwner :one
+SELECT userId FROM seasons
 WHERE id = $1;
 
--- name: GetSeasonScoreboard :many
-SELECT p.id as player_id, p.name as player_name, COUNT(m.winnerId) as wins
-FROM players p
-LEFT JOIN matches m ON p.id = m.winnerId AND m.seasonId = $1
-WHERE p.userId = (SELECT s.userId FROM seasons s WHERE s.id = $2)
-GROUP BY p.id, p.name
-ORDER BY wins DESC;
+-- name: GetPlayerOwner :one
+SELECT userId FROM players
+WHERE id = $1;
+
+-- name: GetSeasonMatches :many
+SELECT * FROM matches
+WHERE seasonId = $1
+ORDER BY matchDate ASC, id ASC;
+
+-- name: GetSeasonParticipants :many
+SELECT p.id, p.name FROM players p
+WHERE p.id IN (
+    SELECT m.playerId1 FROM matches m WHERE m.seasonId = $1
+    UNION
+    SELECT m.playerId2 FROM matches m WHERE m.seasonId = $1
+)
+ORDER BY p.id;
 
 -- name: GetSeasonUpcomingMatches :many
 SELECT * FROM matches
@@ -125,16 +172,93 @@ WHERE seasonId = $1 AND matchDate > CURRENT_TIMESTAMP
 ORDER BY matchDate ASC
 LIMIT 5;
 
+-- name: GetSeasonRatedMatches :many
+SELECT m.* FROM matches m
+JOIN seasons s ON s.id = m.seasonId
+WHERE m.seasonId = $1
+  AND m.playerId1 IS NOT NULL AND m.playerId2 IS NOT NULL
+  AND (m.winnerId IS NOT NULL OR m.playerId1Points <> 0 OR m.playerId2Points <> 0)
+ORDER BY m.matchDate ASC, m.id ASC;
+
+-- name: GetUserRatedMatches :many
+SELECT m.* FROM matches m
+JOIN seasons s ON s.id = m.seasonId
+WHERE s.userId = $1
+  AND m.playerId1 IS NOT NULL AND m.playerId2 IS NOT NULL
+  AND (m.winnerId IS NOT NULL OR m.playerId1Points <> 0 OR m.playerId2Points <> 0)
+ORDER BY m.matchDate ASC, m.id ASC;
+
+-- name: UpdateSeasonFormat :exec
+UPDATE seasons
+SET format = $1,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $2;
+
+-- name: CreateBracketMatch :one
+INSERT INTO bracket_matches (
+    seasonId, matchId, bracket, round, position
+) VALUES (
+    $1, $2, $3, $4, $5
+)
+RETURNING *;
+
+-- name: SetBracketMatchLinks :exec
+UPDATE bracket_matches
+SET winnerNextMatchId = $1,
+    winnerNextSlot = $2,
+    loserNextMatchId = $3,
+    loserNextSlot = $4
+WHERE matchId = $5;
+
+-- name: GetBracketMatchByMatchId :one
+SELECT * FROM bracket_matches
+WHERE matchId = $1;
+
+-- name: GetSeasonBracket :many
+SELECT bm.*, m.playerId1, m.playerId2, m.playerId1Points, m.playerId2Points, m.winnerId, m.matchDate
+FROM bracket_matches bm
+JOIN matches m ON m.id = bm.matchId
+WHERE bm.seasonId = $1
+ORDER BY bm.bracket DESC, bm.round, bm.position;
+
+-- name: DeleteSeasonBracket :exec
+DELETE FROM matches
+WHERE id IN (SELECT bm.matchId FROM bracket_matches bm WHERE bm.seasonId = $1)
+  AND winnerId IS NULL
+  AND playerId1Points = 0
+  AND playerId2Points = 0;
+
+-- name: AssignMatchPlayer1 :exec
+UPDATE matches
+SET playerId1 = $1,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $2;
+
+-- name: AssignMatchPlayer2 :exec
+UPDATE matches
+SET playerId2 = $1,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $2;
+
 -- name: GetPlayerCustomColumns :many
 SELECT * FROM player_custom_columns
-WHERE is_active = true
+WHERE userId = $1 AND is_active = true
 ORDER BY display_order;
 
+-- name: GetAllPlayerCustomColumns :many
+SELECT * FROM player_custom_columns
+WHERE userId = $1
+ORDER BY display_order, id;
+
+-- name: GetPlayerCustomColumn :one
+SELECT * FROM player_custom_columns
+WHERE id = $1 AND userId = $2;
+
 -- name: CreatePlayerCustomColumn :one
 INSERT INTO player_custom_columns (
-    name, field_type, description, is_required, display_order
+    userId, name, field_type, options, description, is_required, display_order
 ) VALUES (
-    $1, $2, $3, $4, $5
+    $1, $2, $3, $4, $5, $6, $7
 )
 RETURNING *;
 
@@ -152,9 +276,109 @@ ON CONFLICT(player_id, column_id) DO UPDATE SET
     updatedAt = CURRENT_TIMESTAMP
 RETURNING *;
 
+-- name: UpdatePlayerCustomColumn :one
+UPDATE player_custom_columns
+SET name = $3,
+    field_type = $4,
+    options = $5,
+    description = $6,
+    is_required = $7,
+    is_active = $8,
+    display_order = $9,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $1 AND userId = $2
+RETURNING *;
+
+-- name: UpdatePlayerCustomColumnOrder :exec
+UPDATE player_custom_columns
+SET display_order = $3,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $1 AND userId = $2;
+
+-- name: CountPlayerCustomValuesByColumn :one
+SELECT COUNT(*) FROM player_custom_values
+WHERE column_id = $1 AND value IS NOT NULL;
+
+-- name: DeletePlayerCustomValuesByColumn :exec
+DELETE FROM player_custom_values
+WHERE column_id = $1;
+
 -- name: DeletePlayerCustomColumn :exec
 DELETE FROM player_custom_columns
-WHERE id = $1;
+WHERE id = $1 AND userId = $2;
+
+-- name: GetMatchCustomColumns :many
+SELECT * FROM match_custom_columns
+WHERE userId = $1
+ORDER BY display_order, id;
+
+-- name: GetMatchCustomColumn :one
+SELECT * FROM match_custom_columns
+WHERE id = $1 AND userId = $2;
+
+-- name: CreateMatchCustomColumn :one
+INSERT INTO match_custom_columns (
+    userId, name, field_type, options, description, is_required, display_order
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7
+)
+RETURNING *;
+
+-- name: UpdateMatchCustomColumn :one
+UPDATE match_custom_columns
+SET name = $3,
+    field_type = $4,
+    options = $5,
+    description = $6,
+    is_required = $7,
+    is_active = $8,
+    display_order = $9,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $1 AND userId = $2
+RETURNING *;
+
+-- name: DeleteMatchCustomValuesByColumn :exec
+DELETE FROM match_custom_values
+WHERE column_id = $1;
+
+-- name: DeleteMatchCustomColumn :exec
+DELETE FROM match_custom_columns
+WHERE id = $1 AND userId = $2;
+
+-- name: GetMatchCustomValues :many
+SELECT mcv.*, mcc.name as column_name, mcc.field_type
+FROM match_custom_values mcv
+JOIN match_custom_columns mcc ON mcv.column_id = mcc.id
+WHERE mcv.match_id = $1 AND mcc.is_active = true
+ORDER BY mcc.display_order, mcc.id;
+
+-- name: GetSeasonMatchCustomValues :many
+SELECT mcv.*, mcc.name as column_name, mcc.field_type
+FROM match_custom_values mcv
+JOIN match_custom_columns mcc ON mcv.column_id = mcc.id
+JOIN matches m ON m.id = mcv.match_id
+WHERE m.seasonId = $1 AND mcc.is_active = true
+ORDER BY mcc.display_order, mcc.id;
+
+-- name: UpsertMatchCustomValue :one
+INSERT INTO match_custom_values (match_id, column_id, value)
+VALUES ($1, $2, $3)
+ON CONFLICT(match_id, column_id) DO UPDATE SET
+    value = excluded.value,
+    updatedAt = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: CountActiveSeasons :one
+SELECT COUNT(*) FROM seasons
+WHERE userId = $1 AND isActive = true;
+
+-- name: CountActivePlayers :one
+SELECT COUNT(*) FROM players
+WHERE userId = $1 AND isActive = true;
+
+-- name: CountUserCustomColumns :one
+SELECT ((SELECT COUNT(*) FROM player_custom_columns p WHERE p.userId = $1)
+    + (SELECT COUNT(*) FROM match_custom_columns m WHERE m.userId = $1))::bigint AS count;
 
 -- name: GetUserAppSettings :one
 SELECT jsonSettings FROM users
@@ -177,7 +401,7 @@ SET jsonSettings = $1,
 WHERE id = $2;
 
 -- name: GetUserSubscription :one
-SELECT subscriptionTier, stripeId
+SELECT subscriptionTier, stripeId, stripeSubscriptionId, subscriptionStatus, subscriptionCurrentPeriodEnd, cancelAtPeriodEnd
 FROM users
 WHERE id = $1;
 
@@ -188,6 +412,26 @@ SET subscriptionTier = 'free',
     updatedAt = CURRENT_TIMESTAMP
 WHERE id = $1;
 
+-- name: GetUserByStripeId :one
+SELECT * FROM users
+WHERE stripeId = $1;
+
+-- name: UpdateUserStripeSubscription :exec
+UPDATE users
+SET subscriptionTier = $1,
+    stripeSubscriptionId = $2,
+    subscriptionStatus = $3,
+    subscriptionCurrentPeriodEnd = $4,
+    cancelAtPeriodEnd = $5,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $6;
+
+-- name: CreateStripeEvent :one
+INSERT INTO stripe_events (id, type)
+VALUES ($1, $2)
+ON CONFLICT (id) DO NOTHING
+RETURNING id;
+
 -- name: DeleteUser :exec
 UPDATE users SET isActive = false, updatedAt = CURRENT_TIMESTAMP WHERE id = $1;
 
@@ -204,4 +448,182 @@ SET seasonId = m.seasonId,
     isActive = m.isActive,
     updatedAt = CURRENT_TIMESTAMP
 FROM (SELECT * FROM UNNEST ($1::matches[])) AS m
-WHERE matches.id = m.id;
\ No newline at end of file
+WHERE matches.id = m.id;
+
+-- name: GetPlayerSchedule :many
+SELECT m.*, s.name AS season_name, o.id AS opponent_id, o.name AS opponent_name
+FROM matches m
+JOIN seasons s ON s.id = m.seasonId
+LEFT JOIN players o ON o.id = CASE WHEN m.playerId1 = $1 THEN m.playerId2 ELSE m.playerId1 END
+WHERE (m.playerId1 = $1 OR m.playerId2 = $1) AND s.isActive = true
+ORDER BY m.matchDate, m.id;
+
+-- name: GetPlayerCalendarCancellations :many
+SELECT c.*, s.name AS season_name
+FROM player_calendar_cancellations c
+LEFT JOIN seasons s ON s.id = c.seasonId
+WHERE c.playerId = $1 AND c.cancelledAt > CURRENT_TIMESTAMP - INTERVAL '90 days'
+ORDER BY c.matchDate, c.matchId;
+
+-- name: GetPlayerByCalendarTokenHash :one
+SELECT p.* FROM players p
+JOIN player_calendar_feeds f ON f.playerId = p.id
+WHERE f.tokenHash = $1;
+
+-- name: UpsertPlayerCalendarFeed :one
+INSERT INTO player_calendar_feeds (playerId, tokenHash)
+VALUES ($1, $2)
+ON CONFLICT (playerId) DO UPDATE SET
+    tokenHash = excluded.tokenHash,
+    createdAt = CURRENT_TIMESTAMP
+RETURNING *;
+
+-- name: DeletePlayerCalendarFeed :exec
+DELETE FROM player_calendar_feeds
+WHERE playerId = $1;
+
+-- name: CreateSeasonShareLink :one
+INSERT INTO season_share_links (seasonId, tokenHash, expiresAt)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetSeasonShareLinks :many
+SELECT * FROM season_share_links
+WHERE seasonId = $1 AND revokedAt IS NULL
+ORDER BY createdAt, id;
+
+-- name: RevokeSeasonShareLink :one
+UPDATE season_share_links
+SET revokedAt = COALESCE(revokedAt, CURRENT_TIMESTAMP)
+WHERE id = $1 AND seasonId = $2
+RETURNING *;
+
+-- name: CountActiveSeasonShareLinks :one
+SELECT COUNT(*) FROM season_share_links l
+JOIN seasons s ON s.id = l.seasonId
+WHERE s.userId = $1
+  AND l.revokedAt IS NULL
+  AND (l.expiresAt IS NULL OR l.expiresAt > CURRENT_TIMESTAMP);
+
+-- name: GetSeasonByShareToken :one
+SELECT s.* FROM seasons s
+JOIN season_share_links l ON l.seasonId = s.id
+WHERE l.tokenHash = $1
+  AND l.revokedAt IS NULL
+  AND (l.expiresAt IS NULL OR l.expiresAt > CURRENT_TIMESTAMP);
+
+-- name: CreateMagicLinkRequest :exec
+INSERT INTO magic_link_requests (email)
+VALUES ($1);
+
+-- name: CountRecentMagicLinkRequests :one
+SELECT COUNT(*) FROM magic_link_requests
+WHERE email = $1
+  AND requestedAt > CURRENT_TIMESTAMP - interval '1 hour';
+
+-- name: DeleteExpiredMagicLinkRequests :exec
+DELETE FROM magic_link_requests
+WHERE requestedAt <= CURRENT_TIMESTAMP - interval '1 hour';
+
+-- name: VerifyUserByStytchId :one
+UPDATE users
+SET isVerified = true,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE stytchId = $1
+RETURNING *;
+
+-- name: UpsertUserSession :exec
+INSERT INTO user_sessions (sessionId, userId, ipAddress, userAgent)
+VALUES ($1, $2, $3, $4)
+ON CONFLICT (sessionId) DO UPDATE
+SET ipAddress = EXCLUDED.ipAddress,
+    userAgent = EXCLUDED.userAgent,
+    lastSeenAt = CURRENT_TIMESTAMP;
+
+-- name: GetUserSessions :many
+SELECT * FROM user_sessions
+WHERE userId = $1;
+
+-- name: DeleteUserSession :exec
+DELETE FROM user_sessions
+WHERE sessionId = $1 AND userId = $2;
+
+-- name: DeleteStaleUserSessions :exec
+DELETE FROM user_sessions
+WHERE userId = $1
+  AND NOT (sessionId = ANY(sqlc.arg(active_session_ids)::varchar[]));
+
+-- name: CreateApiKey :one
+INSERT INTO api_keys (userId, name, prefix, keyHash, scopes, expiresAt)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetApiKeys :many
+SELECT * FROM api_keys
+WHERE userId = $1 AND revokedAt IS NULL
+ORDER BY createdAt, id;
+
+-- name: CountActiveApiKeys :one
+SELECT COUNT(*) FROM api_keys
+WHERE userId = $1
+  AND revokedAt IS NULL
+  AND (expiresAt IS NULL OR expiresAt > CURRENT_TIMESTAMP);
+
+-- name: GetApiKeyByHash :one
+SELECT k.id, k.userId, k.scopes, u.stytchId FROM api_keys k
+JOIN users u ON u.id = k.userId
+WHERE k.keyHash = $1
+  AND k.revokedAt IS NULL
+  AND (k.expiresAt IS NULL OR k.expiresAt > CURRENT_TIMESTAMP);
+
+-- name: TouchApiKey :exec
+UPDATE api_keys
+SET lastUsedAt = CURRENT_TIMESTAMP
+WHERE id = $1
+  AND (lastUsedAt IS NULL OR lastUsedAt <= CURRENT_TIMESTAMP - interval '1 minute');
+
+-- name: RevokeApiKey :one
+UPDATE api_keys
+SET revokedAt = COALESCE(revokedAt, CURRENT_TIMESTAMP)
+WHERE id = $1 AND userId = $2 AND revokedAt IS NULL
+RETURNING *;
+
+-- name: GetSeasonRole :one
+SELECT a.role FROM seasons s
+LEFT JOIN season_access a ON a.seasonId = s.id AND a.userId = $2
+WHERE s.id = $1
+ORDER BY CASE a.role WHEN 'owner' THEN 4 WHEN 'admin' THEN 3 WHEN 'scorekeeper' THEN 2 WHEN 'viewer' THEN 1 END DESC NULLS LAST
+LIMIT 1;
+
+-- name: GetMatchRole :one
+SELECT a.role FROM matches m
+LEFT JOIN season_access a ON a.seasonId = m.seasonId AND a.userId = $2
+WHERE m.id = $1
+ORDER BY CASE a.role WHEN 'owner' THEN 4 WHEN 'admin' THEN 3 WHEN 'scorekeeper' THEN 2 WHEN 'viewer' THEN 1 END DESC NULLS LAST
+LIMIT 1;
+
+-- name: CreateSeasonMember :one
+INSERT INTO season_members (seasonId, email, role, invitedBy)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetSeasonMembers :many
+SELECT m.*, u.id AS member_user_id, u.name AS member_name FROM season_members m
+LEFT JOIN users u ON lower(u.email) = m.email AND u.isVerified AND u.isActive
+WHERE m.seasonId = $1
+ORDER BY m.createdAt, m.id;
+
+-- name: GetSeasonMember :one
+SELECT * FROM season_members
+WHERE id = $1 AND seasonId = $2;
+
+-- name: UpdateSeasonMemberRole :one
+UPDATE season_members
+SET role = $1,
+    updatedAt = CURRENT_TIMESTAMP
+WHERE id = $2 AND seasonId = $3
+RETURNING *;
+
+-- name: DeleteSeasonMember :exec
+DELETE FROM season_members
+WHERE id = $1 AND seasonId = $2;